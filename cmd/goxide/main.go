@@ -0,0 +1,86 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package main. goxide is the goxide command line tool.
+//
+// Usage:
+//
+//	goxide gen [-type Name]... [-funcs] [-package name] [-o file] [package]
+//
+// gen generates Result-returning adapters for an existing package: a <Name>Result wrapper type for each
+// selected interface (every exported interface by default), and with -funcs a wrapper function for each
+// exported package-level function returning an error. The package defaults to the current directory and
+// the output to standard output. For example, as a go:generate directive next to the interface:
+//
+//	//go:generate goxide gen -type UserRepo -o user_repo_result.go
+package main
+
+import (
+	"cmp"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/seyedali-dev/goxide/internal/resultgen"
+	"github.com/seyedali-dev/goxide/rusty/flagx"
+	"github.com/seyedali-dev/goxide/rusty/iox"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// genFlags are the flags of the gen subcommand.
+type genFlags struct {
+	Types   []string `flag:"type" usage:"interface to wrap; repeatable or comma-separated (default: all exported interfaces)"`
+	Funcs   bool     `flag:"funcs" usage:"also wrap exported package-level functions returning an error"`
+	Package string   `flag:"package" usage:"output package name (default: the source package)"`
+	Output  string   `flag:"o" usage:"output file (default: standard output)"`
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "gen" {
+		fmt.Fprintln(os.Stderr, "usage: goxide gen [flags] [package]")
+		os.Exit(2)
+	}
+	if res := gen(os.Args[2:]); res.IsErr() {
+		if !errors.Is(res.Err(), flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "goxide gen:", res.Err())
+		}
+		os.Exit(1)
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// gen runs the gen subcommand with args.
+func gen(args []string) (res result.Result[types.Unit]) {
+	defer result.Catch(&res)
+
+	fs := flag.NewFlagSet("goxide gen", flag.ContinueOnError)
+	flags := flagx.Bind[genFlags](fs, args).BubbleUp()
+	if fs.NArg() > 1 {
+		return result.Err[types.Unit](fmt.Errorf("expected at most one package, got %d", fs.NArg()))
+	}
+
+	var names []string
+	for _, t := range flags.Types {
+		names = append(names, strings.Split(t, ",")...)
+	}
+	src := resultgen.Generate(resultgen.Config{
+		Pattern: cmp.Or(fs.Arg(0), "."),
+		Types:   names,
+		Funcs:   flags.Funcs,
+		Package: flags.Package,
+	}).BubbleUp()
+
+	if flags.Output == "" {
+		_, err := os.Stdout.Write(src)
+		return result.Wrap(types.Unit{}, err)
+	}
+	return iox.WriteFile(flags.Output, src, 0o644)
+}
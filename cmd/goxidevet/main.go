@@ -0,0 +1,22 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package main. goxidevet bundles the goxide analyzers into a single checker. It runs standalone or as a
+// go vet tool:
+//
+//	goxidevet ./...
+//	go vet -vettool=$(which goxidevet) ./...
+package main
+
+import (
+	"github.com/seyedali-dev/goxide/rusty/analysis/bubbleup"
+	"github.com/seyedali-dev/goxide/rusty/analysis/resultcheck"
+	"golang.org/x/tools/go/analysis/multichecker"
+)
+
+func main() {
+	multichecker.Main(
+		bubbleup.Analyzer,
+		resultcheck.Analyzer,
+	)
+}
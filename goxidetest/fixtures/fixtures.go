@@ -0,0 +1,266 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package fixtures seeds database tables from YAML/JSON fixture files or from db-tagged structs,
+// returning a cleanup function that deletes exactly the seeded rows again.
+//
+// A fixture file maps table names to lists of rows; tables are inserted in document order, so parents
+// can precede the children referencing them. JSON files use the same layout.
+//
+//	# testdata/users.yaml
+//	users:
+//	  - id: 1
+//	    email: ali@example.com
+//	orders:
+//	  - id: 10
+//	    user_id: 1
+//	    items: ["pen"]   # nested values are stored as JSON
+//
+// Example - Seeding a test:
+//
+//	pg := goxidetest.NewPostgres(t)
+//	cleanup := fixtures.Load(ctx, pg.DB, "testdata/users.yaml").Expect("seed users")
+//	t.Cleanup(func() { _ = cleanup(ctx) })
+package fixtures
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	goxreflect "github.com/seyedali-dev/goxide/reflect"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"gopkg.in/yaml.v3"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Execer is the subset of *sql.DB, *sql.Conn and *sql.Tx used to seed fixtures.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// CleanupFunc deletes the rows inserted by Load or Seed, in reverse insertion order.
+type CleanupFunc func(ctx context.Context) error
+
+// Option configures Load and Seed.
+type Option func(*config)
+
+// config holds the settings applied by Option.
+type config struct {
+	placeholder func(n int) string
+	keys        map[string][]string
+}
+
+// seeder inserts rows and remembers them for cleanup.
+type seeder struct {
+	db       Execer
+	cfg      config
+	inserted []seededRow
+}
+
+// seededRow is one inserted row, kept to delete it again.
+type seededRow struct {
+	table string
+	row   map[string]any
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Placeholders sets how the n-th (1-based) query parameter is written. The default is PostgreSQL's $n;
+// use func(int) string { return "?" } for MySQL and SQLite.
+func Placeholders(fn func(n int) string) Option {
+	return func(c *config) { c.placeholder = fn }
+}
+
+// KeyColumns sets the columns identifying a row of table for cleanup. By default rows are deleted by their
+// "id" column, or by all their non-null columns when they have none.
+func KeyColumns(table string, columns ...string) Option {
+	return func(c *config) { c.keys[table] = columns }
+}
+
+// Load inserts the rows of the fixture file at path (YAML or JSON) into db. On failure the rows
+// inserted so far are deleted again and the Err names the table and row.
+func Load(ctx context.Context, db Execer, path string, opts ...Option) (res result.Result[CleanupFunc]) {
+	s := newSeeder(db, opts)
+	defer s.rollbackOnErr(ctx, &res)
+
+	tables, err := readFixtureFile(path)
+	if err != nil {
+		return result.Err[CleanupFunc](fmt.Errorf("fixtures: fixture %s: %w", path, err))
+	}
+	for _, table := range tables {
+		for i, row := range table.rows {
+			if err := s.insert(ctx, table.name, row); err != nil {
+				return result.Err[CleanupFunc](fmt.Errorf("fixtures: fixture %s: %s row %d: %w", path, table.name, i, err))
+			}
+		}
+	}
+	return result.Ok[CleanupFunc](s.cleanup)
+}
+
+// Seed inserts rows into table, mapping struct fields to columns by their db tags as dbx does.
+// Untagged fields are skipped.
+//
+// Example:
+//
+//	type User struct {
+//	    ID    int    `db:"id"`
+//	    Email string `db:"email"`
+//	}
+//	cleanup := fixtures.Seed(ctx, pg.DB, "users", []User{{ID: 1, Email: "ali@example.com"}}).Expect("seed users")
+func Seed[T any](ctx context.Context, db Execer, table string, rows []T, opts ...Option) (res result.Result[CleanupFunc]) {
+	s := newSeeder(db, opts)
+	defer s.rollbackOnErr(ctx, &res)
+
+	fields := goxreflect.FieldsByTag(reflect.TypeFor[T](), "db")
+	for i, row := range rows {
+		v := reflect.ValueOf(row)
+		values := make(map[string]any, len(fields))
+		for column, index := range fields {
+			values[column] = v.FieldByIndex(index).Interface()
+		}
+		if err := s.insert(ctx, table, values); err != nil {
+			return result.Err[CleanupFunc](fmt.Errorf("fixtures: seed %s row %d: %w", table, i, err))
+		}
+	}
+	return result.Ok[CleanupFunc](s.cleanup)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// fixtureTable is one table section of a fixture file.
+type fixtureTable struct {
+	name string
+	rows []map[string]any
+}
+
+// newSeeder applies opts over the defaults.
+func newSeeder(db Execer, opts []Option) *seeder {
+	cfg := config{
+		placeholder: func(n int) string { return fmt.Sprintf("$%d", n) },
+		keys:        make(map[string][]string),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &seeder{db: db, cfg: cfg}
+}
+
+// readFixtureFile parses a fixture file, keeping its tables in document order. JSON is parsed as YAML,
+// of which it is a subset.
+func readFixtureFile(path string) ([]fixtureTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil // empty file
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("expected a mapping of table names to rows")
+	}
+
+	tables := make([]fixtureTable, 0, len(root.Content)/2)
+	for i := 0; i < len(root.Content); i += 2 {
+		table := fixtureTable{name: root.Content[i].Value}
+		if err := root.Content[i+1].Decode(&table.rows); err != nil {
+			return nil, fmt.Errorf("table %s: %w", table.name, err)
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// insert inserts one row, storing nested maps and lists as JSON.
+func (s *seeder) insert(ctx context.Context, table string, row map[string]any) error {
+	columns := slices.Sorted(maps.Keys(row))
+	args := make([]any, len(columns))
+	for i, column := range columns {
+		value, err := columnValue(row[column])
+		if err != nil {
+			return fmt.Errorf("column %s: %w", column, err)
+		}
+		args[i] = value
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, strings.Join(columns, ", "), s.placeholders(len(columns)))
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+	s.inserted = append(s.inserted, seededRow{table: table, row: row})
+	return nil
+}
+
+// cleanup deletes every inserted row in reverse order, so children go before their parents.
+func (s *seeder) cleanup(ctx context.Context) error {
+	for _, seeded := range slices.Backward(s.inserted) {
+		columns := s.keyColumns(seeded)
+		conds := make([]string, len(columns))
+		args := make([]any, len(columns))
+		for i, column := range columns {
+			conds[i] = column + " = " + s.cfg.placeholder(i+1)
+			args[i], _ = columnValue(seeded.row[column]) // encoded successfully on insert
+		}
+		query := fmt.Sprintf("DELETE FROM %s WHERE %s", seeded.table, strings.Join(conds, " AND "))
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("fixtures: delete from %s: %w", seeded.table, err)
+		}
+	}
+	s.inserted = nil
+	return nil
+}
+
+// rollbackOnErr deletes the rows inserted so far when *res is an Err.
+func (s *seeder) rollbackOnErr(ctx context.Context, res *result.Result[CleanupFunc]) {
+	if res.IsErr() {
+		_ = s.cleanup(ctx)
+	}
+}
+
+// keyColumns returns the columns identifying seeded for deletion.
+func (s *seeder) keyColumns(seeded seededRow) []string {
+	if keys, ok := s.cfg.keys[seeded.table]; ok {
+		return keys
+	}
+	if _, ok := seeded.row["id"]; ok {
+		return []string{"id"}
+	}
+	var columns []string
+	for _, column := range slices.Sorted(maps.Keys(seeded.row)) {
+		if seeded.row[column] != nil {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// placeholders returns a comma-separated list of n placeholders.
+func (s *seeder) placeholders(n int) string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = s.cfg.placeholder(i + 1)
+	}
+	return strings.Join(out, ", ")
+}
+
+// columnValue converts a decoded fixture value into a driver argument, encoding maps and lists as JSON.
+func columnValue(v any) (any, error) {
+	switch v.(type) {
+	case map[string]any, []any:
+		data, err := json.Marshal(v)
+		return string(data), err
+	}
+	return v, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package fixtures_test. fixtures_test verifies fixture seeding against a driver that records statements.
+package fixtures_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/goxidetest/fixtures"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+// recorder is a database/sql connector recording every executed statement as "query [args]".
+type recorder struct {
+	mu     sync.Mutex
+	execs  []string
+	failOn string // statements containing failOn fail
+}
+
+type recorderConn struct{ r *recorder }
+
+func (r *recorder) Connect(context.Context) (driver.Conn, error) { return recorderConn{r}, nil }
+func (r *recorder) Driver() driver.Driver                        { return nil }
+
+func (c recorderConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c recorderConn) Close() error                        { return nil }
+func (c recorderConn) Begin() (driver.Tx, error)           { return recorderTx(c), nil }
+
+type recorderTx recorderConn
+
+func (tx recorderTx) Commit() error   { return tx.record("COMMIT") }
+func (tx recorderTx) Rollback() error { return tx.record("ROLLBACK") }
+
+func (tx recorderTx) record(stmt string) error {
+	tx.r.mu.Lock()
+	defer tx.r.mu.Unlock()
+	tx.r.execs = append(tx.r.execs, stmt)
+	return nil
+}
+
+func (c recorderConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.r.mu.Lock()
+	defer c.r.mu.Unlock()
+	if c.r.failOn != "" && strings.Contains(query, c.r.failOn) {
+		return nil, errors.New("constraint violation")
+	}
+	values := make([]any, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	c.r.execs = append(c.r.execs, fmt.Sprintf("%s %v", query, values))
+	return driver.RowsAffected(1), nil
+}
+
+func openRecorder(failOn string) (*sql.DB, *recorder) {
+	r := &recorder{failOn: failOn}
+	return sql.OpenDB(r), r
+}
+
+type user struct {
+	ID    int64  `db:"id"`
+	Email string `db:"email"`
+	Notes string
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestLoadFixtures_YAML(t *testing.T) {
+	db, rec := openRecorder("")
+	ctx := context.Background()
+
+	cleanup := fixtures.Load(ctx, db, "testdata/shop.yaml").Unwrap()
+	want := []string{
+		"INSERT INTO users (email, id) VALUES ($1, $2) [ali@example.com 1]",
+		`INSERT INTO orders (id, items, user_id) VALUES ($1, $2, $3) [10 ["pen","ink"] 1]`,
+		"INSERT INTO orders (id, note, user_id) VALUES ($1, $2, $3) [11 <nil> 1]",
+	}
+	if !slices.Equal(rec.execs, want) {
+		t.Fatalf("expected %q, got %q", want, rec.execs)
+	}
+
+	rec.execs = nil
+	if err := cleanup(ctx); err != nil {
+		t.Fatalf("cleanup: %v", err)
+	}
+	want = []string{
+		"DELETE FROM orders WHERE id = $1 [11]",
+		"DELETE FROM orders WHERE id = $1 [10]",
+		"DELETE FROM users WHERE id = $1 [1]",
+	}
+	if !slices.Equal(rec.execs, want) {
+		t.Fatalf("expected %q, got %q", want, rec.execs)
+	}
+}
+
+func TestLoadFixtures_JSONWithOptions(t *testing.T) {
+	db, rec := openRecorder("")
+	ctx := context.Background()
+
+	cleanup := fixtures.Load(ctx, db, "testdata/tags.json",
+		fixtures.Placeholders(func(int) string { return "?" }),
+		fixtures.KeyColumns("users", "email"),
+	).Unwrap()
+	rec.execs = nil
+	if err := cleanup(ctx); err != nil {
+		t.Fatalf("cleanup: %v", err)
+	}
+	want := []string{
+		"DELETE FROM users WHERE email = ? [sara@example.com]",
+		"DELETE FROM tags WHERE color = ? AND name = ? [green new]",
+	}
+	if !slices.Equal(rec.execs, want) {
+		t.Fatalf("expected %q, got %q", want, rec.execs)
+	}
+}
+
+func TestLoadFixtures_FailureDeletesSeededRows(t *testing.T) {
+	db, rec := openRecorder("INSERT INTO orders")
+	res := fixtures.Load(context.Background(), db, "testdata/shop.yaml")
+
+	if res.IsOk() || !strings.Contains(res.Err().Error(), "orders row 0") {
+		t.Fatalf("expected error naming orders row 0, got %v", res.Err())
+	}
+	if last := rec.execs[len(rec.execs)-1]; last != "DELETE FROM users WHERE id = $1 [1]" {
+		t.Fatalf("expected seeded user to be deleted, got %q", rec.execs)
+	}
+}
+
+func TestSeed_DBTags(t *testing.T) {
+	db, rec := openRecorder("")
+	fixtures.Seed(context.Background(), db, "users", []user{{ID: 7, Email: "a@b.c", Notes: "skipped"}}).Unwrap()
+
+	want := []string{"INSERT INTO users (email, id) VALUES ($1, $2) [a@b.c 7]"}
+	if !slices.Equal(rec.execs, want) {
+		t.Fatalf("expected %q, got %q", want, rec.execs)
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/seyedali-dev/goxide/goxidetest/fixtures
+
+go 1.25.0
+
+require (
+	github.com/seyedali-dev/goxide v0.0.0-20261016191056-12d6c9fcf26a
+	gopkg.in/yaml.v3 v3.0.1
+)
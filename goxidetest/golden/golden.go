@@ -0,0 +1,174 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package golden. golden provides snapshot testing: Assert compares a value's rendering with a file under
+// testdata/golden, and `GOXIDE_UPDATE_GOLDEN=1 go test` rewrites those files with the current output. golden
+// registers no flags of its own; a test binary that defines its own -update flag drives it as well.
+//
+// Values are rendered as follows, so snapshots stay readable in code review:
+//   - string and []byte: as is
+//   - result.Result: Ok(<value>) or Err(<error chain>); option.Option: Some(<value>) or None
+//   - error: its chain, as goxerrors.FormatChain prints it
+//   - fmt.Stringer: its String()
+//   - anything else: indented JSON, or %+v if it cannot be encoded
+//
+// Example - Snapshotting an error response:
+//
+//	func TestProblem_NotFound(t *testing.T) {
+//	    rec := httptest.NewRecorder()
+//	    goxerrors.WriteProblem(rec, r, ErrUserNotFound)
+//	    golden.Assert(t, "problem_not_found", rec.Body.String()) // testdata/golden/problem_not_found.golden
+//	}
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+)
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// Dir is the directory, relative to the package under test, holding the golden files.
+const Dir = "testdata/golden"
+
+// UpdateEnv is the environment variable that, when set to a true value, makes Assert rewrite golden files.
+const UpdateEnv = "GOXIDE_UPDATE_GOLDEN"
+
+const (
+	resultPkg = "github.com/seyedali-dev/goxide/rusty/result"
+	optionPkg = "github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Assert fails tb if the rendering of got differs from the golden file for name, reporting the first
+// differing line. When updating (see UpdateEnv) it writes the file instead. name may contain slashes to group files.
+func Assert(tb testing.TB, name string, got any) {
+	tb.Helper()
+	path := Path(name)
+	actual := Render(got)
+
+	if updating() {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			tb.Fatalf("golden: %v", err)
+		}
+		if err := os.WriteFile(path, actual, 0o644); err != nil {
+			tb.Fatalf("golden: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		tb.Fatalf("golden: %s does not exist; run with %s=1 to create it", path, UpdateEnv)
+	}
+	if err != nil {
+		tb.Fatalf("golden: %v", err)
+	}
+	if !bytes.Equal(actual, want) {
+		tb.Errorf("golden: %s differs (run with %s=1 to accept):\n%s", path, UpdateEnv, firstDiff(want, actual))
+	}
+}
+
+// Path returns the golden file path for name.
+func Path(name string) string {
+	return filepath.Join(Dir, filepath.FromSlash(name)+".golden")
+}
+
+// Render returns the snapshot rendering of v used by Assert.
+func Render(v any) []byte {
+	return []byte(render(v) + "\n")
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// updating reports whether golden files should be rewritten: UpdateEnv is set to a true value, or the test
+// binary defines its own -update flag and it is set. The flag is looked up on every call, never defined here.
+func updating() bool {
+	if value, ok := os.LookupEnv(UpdateEnv); ok {
+		update, _ := strconv.ParseBool(value)
+		return update
+	}
+	if f := flag.Lookup("update"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			update, _ := getter.Get().(bool)
+			return update
+		}
+	}
+	return false
+}
+
+// render formats v as documented on the package.
+func render(v any) string {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSuffix(v, "\n")
+	case []byte:
+		return strings.TrimSuffix(string(v), "\n")
+	case error:
+		return goxerrors.FormatChain(v)
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	if s, ok := renderRusty(reflect.ValueOf(v)); ok {
+		return s
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+	return string(data)
+}
+
+// renderRusty renders Result and Option values through their methods, since their fields are unexported.
+func renderRusty(v reflect.Value) (string, bool) {
+	if !v.IsValid() || v.Kind() != reflect.Struct {
+		return "", false
+	}
+	t := v.Type()
+	call := func(method string) any { return v.MethodByName(method).Call(nil)[0].Interface() }
+
+	switch {
+	case t.PkgPath() == resultPkg && strings.HasPrefix(t.Name(), "Result["):
+		if call("IsErr").(bool) {
+			return "Err(" + render(call("Err")) + ")", true
+		}
+		return "Ok(" + render(call("Unwrap")) + ")", true
+	case t.PkgPath() == optionPkg && strings.HasPrefix(t.Name(), "Option["):
+		if call("IsNone").(bool) {
+			return "None", true
+		}
+		return "Some(" + render(call("Unwrap")) + ")", true
+	}
+	return "", false
+}
+
+// firstDiff describes the first line where want and got differ.
+func firstDiff(want, got []byte) string {
+	wantLines, gotLines := strings.Split(string(want), "\n"), strings.Split(string(got), "\n")
+	for i := range max(len(wantLines), len(gotLines)) {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d:\n  want: %q\n  got:  %q", i+1, w, g)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package golden_test. golden_test verifies rendering, comparison and rewriting in update mode.
+package golden_test
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/goxidetest/golden"
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+// recorder captures failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+type user struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+var errNotFound = errors.New("user not found")
+
+// update is the package's own golden flag, which golden must neither collide with nor ignore.
+var update = flag.Bool("update", false, "rewrite golden files")
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestRender(t *testing.T) {
+	cases := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{"string", "plain\n", "plain\n"},
+		{"ok", result.Ok(user{ID: 1, Name: "ali"}), "Ok({\n  \"id\": 1,\n  \"name\": \"ali\"\n})\n"},
+		{"err", result.Err[int](fmt.Errorf("load: %w", errNotFound)), "Err(load\nuser not found)\n"},
+		{"some", option.Some(42), "Some(42)\n"},
+		{"none", option.None[string](), "None\n"},
+	}
+	for _, tc := range cases {
+		if got := string(golden.Render(tc.v)); got != tc.want {
+			t.Fatalf("%s: expected %q, got %q", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestAssert(t *testing.T) {
+	golden.Assert(t, "users", []user{{ID: 1, Name: "ali"}, {ID: 2, Name: "sara"}})
+
+	rec := &recorder{TB: t}
+	golden.Assert(rec, "users", []user{{ID: 1, Name: "ali"}, {ID: 2, Name: "reza"}})
+	if len(rec.failures) != 1 || !strings.Contains(rec.failures[0], `line 8:`) {
+		t.Fatalf("expected a diff at line 8, got %q", rec.failures)
+	}
+}
+
+func TestAssert_Update(t *testing.T) {
+	t.Setenv(golden.UpdateEnv, "1")
+	t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(golden.Path("tmp/updated"))) })
+
+	golden.Assert(t, "tmp/updated", option.Some("v1"))
+	data, err := os.ReadFile(golden.Path("tmp/updated"))
+	if err != nil || string(data) != "Some(v1)\n" {
+		t.Fatalf("expected %q, got %q (%v)", "Some(v1)\n", data, err)
+	}
+}
+
+func TestAssert_UpdateFlag(t *testing.T) {
+	*update = true
+	t.Cleanup(func() {
+		*update = false
+		_ = os.RemoveAll(filepath.Dir(golden.Path("tmp/flagged")))
+	})
+
+	golden.Assert(t, "tmp/flagged", option.Some("v2"))
+	data, err := os.ReadFile(golden.Path("tmp/flagged"))
+	if err != nil || string(data) != "Some(v2)\n" {
+		t.Fatalf("expected %q, got %q (%v)", "Some(v2)\n", data, err)
+	}
+}
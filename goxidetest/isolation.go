@@ -0,0 +1,110 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package goxidetest. isolation gives each test its own view of a shared database, either as a dedicated
+// schema or as a transaction rolled back when the test ends, so parallel tests no longer need to TRUNCATE
+// tables between runs.
+//
+// Example - Traditional vs isolated tests:
+//
+//	// Traditional: serial tests wiping shared tables
+//	_, _ = db.ExecContext(ctx, "TRUNCATE TABLE users RESTART IDENTITY")
+//
+//	// Schema per test: full DDL freedom, safe with t.Parallel()
+//	db := pg.Schema(t)
+//	_, _ = db.ExecContext(ctx, "CREATE TABLE users (...)")
+//
+//	// Transaction per test: cheapest, for tables created once in TestMain
+//	tx := goxidetest.Tx(t, pg.DB)
+//	repo := NewUserRepo(tx)
+package goxidetest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/dbx"
+)
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// databaseSeq numbers the schemas handed out per test, keeping names unique across tests.
+var databaseSeq atomic.Int64
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Schema creates a schema private to tb, named after the test, and returns a *sql.DB whose connections use
+// it as their search_path, so unqualified table names resolve inside it. The handle is closed and the
+// schema dropped (with everything in it) when tb finishes.
+func (pg *Postgres) Schema(tb testing.TB) *sql.DB {
+	tb.Helper()
+	ctx := context.Background()
+	name := schemaName(tb.Name())
+
+	if _, err := pg.DB.ExecContext(ctx, "CREATE SCHEMA "+name); err != nil {
+		tb.Fatalf("goxidetest: create schema %s: %v", name, err)
+	}
+	db, err := sql.Open("postgres", withSearchPath(pg.DSN, name))
+	if err != nil {
+		tb.Fatalf("goxidetest: open schema %s: %v", name, err)
+	}
+	tb.Cleanup(func() {
+		_ = db.Close()
+		if _, err := pg.DB.ExecContext(ctx, "DROP SCHEMA "+name+" CASCADE"); err != nil {
+			tb.Errorf("goxidetest: drop schema %s: %v", name, err)
+		}
+	})
+	return db
+}
+
+// Tx begins a transaction on db and rolls it back when tb finishes, discarding everything the test wrote.
+// Code under test must run its statements on the returned *sql.Tx, and must not commit it.
+func Tx(tb testing.TB, db dbx.TxBeginner) *sql.Tx {
+	tb.Helper()
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		tb.Fatalf("goxidetest: begin transaction: %v", err)
+	}
+	tb.Cleanup(func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			tb.Errorf("goxidetest: roll back transaction: %v", err)
+		}
+	})
+	return tx
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// schemaName derives a unique, valid PostgreSQL identifier (at most 63 bytes, lower case) from a test name.
+func schemaName(testName string) string {
+	suffix := fmt.Sprintf("_%d", databaseSeq.Add(1))
+	name := []byte("t_")
+	for _, r := range testName {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			name = append(name, byte(r))
+		case r >= 'A' && r <= 'Z':
+			name = append(name, byte(r-'A'+'a'))
+		default:
+			name = append(name, '_')
+		}
+	}
+	return string(name[:min(len(name), 63-len(suffix))]) + suffix
+}
+
+// withSearchPath adds a search_path run-time parameter to a postgres:// DSN.
+func withSearchPath(dsn, schema string) string {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return dsn
+	}
+	q := u.Query()
+	q.Set("search_path", schema)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
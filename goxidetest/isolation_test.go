@@ -0,0 +1,46 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package goxidetest_test. isolation_test verifies per-test transactions and schemas; schemas need Docker.
+package goxidetest_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/goxidetest"
+)
+
+func TestTx_RolledBackAfterTest(t *testing.T) {
+	db, rec := openRecorder("")
+
+	t.Run("inner", func(t *testing.T) {
+		tx := goxidetest.Tx(t, db)
+		if _, err := tx.ExecContext(context.Background(), "INSERT INTO users (id) VALUES (1)"); err != nil {
+			t.Fatalf("exec: %v", err)
+		}
+	})
+
+	want := []string{"INSERT INTO users (id) VALUES (1) []", "ROLLBACK"}
+	if !slices.Equal(rec.execs, want) {
+		t.Fatalf("expected %q, got %q", want, rec.execs)
+	}
+}
+
+func TestPostgres_SchemaPerTest(t *testing.T) {
+	pg := goxidetest.NewPostgres(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"A", "B"} {
+		t.Run(name, func(t *testing.T) {
+			db := pg.Schema(t)
+			if _, err := db.ExecContext(ctx, "CREATE TABLE users (id int)"); err != nil {
+				t.Fatalf("create table: %v", err) // would fail for B if schemas were shared
+			}
+			if _, err := db.ExecContext(ctx, "INSERT INTO users VALUES (1)"); err != nil {
+				t.Fatalf("insert: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package mongotest provides a MongoDB test container with a connected *mongo.Client and
+// database-per-test isolation, so tests sharing one container never see each other's documents.
+//
+// Example - One container per package, one database per test:
+//
+//	var mongoDB *mongotest.Mongo
+//
+//	func TestMain(m *testing.M) {
+//	    mongoDB = mongotest.Start(context.Background()).Expect("start mongo")
+//	    code := m.Run()
+//	    _ = mongoDB.Cleanup(context.Background())
+//	    os.Exit(code)
+//	}
+//
+//	func TestOrders_Insert(t *testing.T) {
+//	    db := mongoDB.Database(t) // fresh database, dropped when t finishes
+//	    repo := NewOrderRepo(db.Collection("orders"))
+//	    ...
+//	}
+package mongotest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/seyedali-dev/goxide/goxidetest"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Mongo holds a running MongoDB container, a connected client and its cleanup function.
+type Mongo struct {
+	Container testcontainers.Container
+	Client    *mongo.Client
+	URI       string // mongodb:// URI of the server, for code that connects on its own
+	Cleanup   func(ctx context.Context) error
+}
+
+// Config holds the configuration for a Mongo container.
+type Config struct {
+	Image string   // e.g. "mongo:7"
+	Port  nat.Port // container internal port (usually "27017/tcp")
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// databaseSeq numbers the databases handed out per test, keeping names unique across tests.
+var databaseSeq atomic.Int64
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// DefaultConfig returns the default configuration for MongoDB.
+func DefaultConfig() Config {
+	return Config{
+		Image: "mongo:7",
+		Port:  "27017/tcp",
+	}
+}
+
+// Start starts a MongoDB container with DefaultConfig.
+// The returned Client is connected (Ping succeeded). Callers should call Cleanup when done.
+func Start(ctx context.Context) result.Result[*Mongo] {
+	return StartWithConfig(ctx, DefaultConfig())
+}
+
+// StartWithConfig starts a MongoDB container using the provided config.
+func StartWithConfig(ctx context.Context, cfg Config) result.Result[*Mongo] {
+	ctr, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        cfg.Image,
+			ExposedPorts: []string{string(cfg.Port)},
+			WaitingFor:   wait.ForListeningPort(cfg.Port).WithStartupTimeout(60 * time.Second),
+		},
+		Started: true,
+	})
+	if err != nil {
+		return result.Err[*Mongo](fmt.Errorf("start mongo container: %w", err))
+	}
+
+	endpoint, err := ctr.PortEndpoint(ctx, cfg.Port, "mongodb")
+	if err != nil {
+		_ = ctr.Terminate(ctx)
+		return result.Err[*Mongo](fmt.Errorf("failed to get container endpoint: %w", err))
+	}
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(endpoint))
+	if err != nil {
+		_ = ctr.Terminate(ctx)
+		return result.Err[*Mongo](fmt.Errorf("mongo.Connect: %w", err))
+	}
+
+	// Wait/poll for the server to accept commands (Ping).
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		if err := client.Ping(ctx, nil); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			_ = client.Disconnect(ctx)
+			_ = ctr.Terminate(ctx)
+			return result.Err[*Mongo](fmt.Errorf("mongo did not become ready in time: %w", err))
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+
+	cleanup := func(ctx context.Context) error {
+		var firstErr error
+		if err := client.Disconnect(ctx); err != nil {
+			firstErr = fmt.Errorf("disconnect client: %w", err)
+		}
+		if err := ctr.Terminate(ctx); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("terminate container: %w", err)
+		}
+		return firstErr
+	}
+
+	return result.Ok(&Mongo{
+		Container: ctr,
+		Client:    client,
+		URI:       endpoint,
+		Cleanup:   cleanup,
+	})
+}
+
+// New starts a MongoDB container for a single test or benchmark and terminates it when tb finishes.
+// The test is skipped when Docker is unavailable and fails if the container cannot start.
+func New(tb testing.TB) *Mongo {
+	tb.Helper()
+	goxidetest.SkipWithoutDocker(tb)
+
+	res := Start(context.Background())
+	if res.IsErr() {
+		tb.Fatalf("mongotest: start mongo: %v", res.Err())
+	}
+	m := res.Unwrap()
+	tb.Cleanup(func() {
+		if err := m.Cleanup(context.Background()); err != nil {
+			tb.Errorf("mongotest: cleanup mongo: %v", err)
+		}
+	})
+	return m
+}
+
+// Database returns a database private to tb, named after the test, and drops it when tb finishes.
+func (m *Mongo) Database(tb testing.TB) *mongo.Database {
+	tb.Helper()
+	db := m.Client.Database(databaseName(tb.Name()))
+	tb.Cleanup(func() {
+		if err := db.Drop(context.Background()); err != nil {
+			tb.Errorf("mongotest: drop database %s: %v", db.Name(), err)
+		}
+	})
+	return db
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// databaseName derives a unique, valid MongoDB database name from a test name. MongoDB forbids
+// /\. "$*<>:|? in names and limits them to 63 bytes.
+func databaseName(testName string) string {
+	suffix := fmt.Sprintf("_%d", databaseSeq.Add(1))
+	name := strings.Map(func(r rune) rune {
+		if r > 127 || strings.ContainsRune(`/\. "$*<>:|?`, r) {
+			return '_'
+		}
+		return r
+	}, testName)
+	return name[:min(len(name), 63-len(suffix))] + suffix
+}
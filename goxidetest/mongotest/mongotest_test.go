@@ -0,0 +1,35 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package mongotest_test. mongotest_test verifies the MongoDB helpers; it skips without Docker.
+package mongotest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/goxidetest/mongotest"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMongo_DatabasePerTest(t *testing.T) {
+	m := mongotest.New(t)
+	ctx := context.Background()
+
+	var names []string
+	for _, sub := range []string{"first", "second"} {
+		t.Run(sub, func(t *testing.T) {
+			db := m.Database(t)
+			names = append(names, db.Name())
+			if _, err := db.Collection("orders").InsertOne(ctx, bson.M{"sku": sub}); err != nil {
+				t.Fatalf("insert: %v", err)
+			}
+			if n, err := db.Collection("orders").CountDocuments(ctx, bson.M{}); err != nil || n != 1 {
+				t.Fatalf("expected %v, got %v (%v)", 1, n, err)
+			}
+		})
+	}
+	if names[0] == names[1] {
+		t.Fatalf("expected distinct databases, got %v", names)
+	}
+}
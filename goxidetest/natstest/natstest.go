@@ -0,0 +1,210 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package natstest provides a NATS test container (with JetStream) plus stream creation and
+// subscription helpers, for integration tests of stream and channel based code.
+//
+// Example - Asserting on published events:
+//
+//	func TestCheckout_PublishesOrderPlaced(t *testing.T) {
+//	    broker := natstest.New(t)
+//	    events := broker.Subscribe(t, "orders.placed")
+//
+//	    NewCheckout(broker.Conn).PlaceOrder(ctx, cart)
+//
+//	    msgs := rustytest.RequireOk(t, events.Take(1, 5*time.Second))
+//	    ...
+//	}
+package natstest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/seyedali-dev/goxide/goxidetest"
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// NATS holds a running NATS server container, a connection to it and its cleanup function.
+type NATS struct {
+	Container testcontainers.Container
+	Conn      *nats.Conn
+	URL       string // nats:// URL of the server, for code that connects on its own
+	Cleanup   func(ctx context.Context) error
+}
+
+// Config holds the configuration for a NATS container.
+type Config struct {
+	Image     string   // e.g. "nats:2.10-alpine"
+	Port      nat.Port // container client port (usually "4222/tcp")
+	JetStream bool     // start the server with JetStream enabled
+}
+
+// Subscription buffers the messages published on a subject so a test can assert on them.
+type Subscription struct {
+	sub *nats.Subscription
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrNoMessage is returned by Subscription.Take when fewer messages than requested arrive in time.
+var ErrNoMessage = errors.New("natstest: no message received")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// DefaultConfig returns the default configuration for NATS, with JetStream enabled.
+func DefaultConfig() Config {
+	return Config{
+		Image:     "nats:2.10-alpine",
+		Port:      "4222/tcp",
+		JetStream: true,
+	}
+}
+
+// Start starts a NATS container with DefaultConfig.
+// The returned Conn is connected. Callers should call Cleanup when done.
+func Start(ctx context.Context) result.Result[*NATS] {
+	return StartWithConfig(ctx, DefaultConfig())
+}
+
+// StartWithConfig starts a NATS container using the provided config.
+func StartWithConfig(ctx context.Context, cfg Config) result.Result[*NATS] {
+	var cmd []string
+	if cfg.JetStream {
+		cmd = []string{"-js"}
+	}
+	ctr, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        cfg.Image,
+			Cmd:          cmd,
+			ExposedPorts: []string{string(cfg.Port)},
+			WaitingFor:   wait.ForListeningPort(cfg.Port).WithStartupTimeout(60 * time.Second),
+		},
+		Started: true,
+	})
+	if err != nil {
+		return result.Err[*NATS](fmt.Errorf("start nats container: %w", err))
+	}
+
+	url, err := ctr.PortEndpoint(ctx, cfg.Port, "nats")
+	if err != nil {
+		_ = ctr.Terminate(ctx)
+		return result.Err[*NATS](fmt.Errorf("failed to get container endpoint: %w", err))
+	}
+
+	conn, err := nats.Connect(url, nats.RetryOnFailedConnect(true), nats.MaxReconnects(-1))
+	if err != nil {
+		_ = ctr.Terminate(ctx)
+		return result.Err[*NATS](fmt.Errorf("nats.Connect: %w", err))
+	}
+
+	cleanup := func(ctx context.Context) error {
+		conn.Close()
+		if err := ctr.Terminate(ctx); err != nil {
+			return fmt.Errorf("terminate container: %w", err)
+		}
+		return nil
+	}
+
+	return result.Ok(&NATS{
+		Container: ctr,
+		Conn:      conn,
+		URL:       url,
+		Cleanup:   cleanup,
+	})
+}
+
+// New starts a NATS container for a single test or benchmark and terminates it when tb finishes.
+// The test is skipped when Docker is unavailable and fails if the container cannot start.
+func New(tb testing.TB) *NATS {
+	tb.Helper()
+	goxidetest.SkipWithoutDocker(tb)
+
+	res := Start(context.Background())
+	if res.IsErr() {
+		tb.Fatalf("natstest: start nats: %v", res.Err())
+	}
+	n := res.Unwrap()
+	tb.Cleanup(func() {
+		if err := n.Cleanup(context.Background()); err != nil {
+			tb.Errorf("natstest: cleanup nats: %v", err)
+		}
+	})
+	return n
+}
+
+// CreateStream creates a JetStream stream (the NATS equivalent of a topic) capturing subjects, and deletes
+// it when tb finishes. The server must have JetStream enabled.
+//
+// Example:
+//
+//	stream := broker.CreateStream(t, "ORDERS", "orders.>")
+func (n *NATS) CreateStream(tb testing.TB, name string, subjects ...string) jetstream.Stream {
+	tb.Helper()
+	js, err := jetstream.New(n.Conn)
+	if err != nil {
+		tb.Fatalf("natstest: jetstream: %v", err)
+	}
+	stream, err := js.CreateStream(context.Background(), jetstream.StreamConfig{Name: name, Subjects: subjects})
+	if err != nil {
+		tb.Fatalf("natstest: create stream %s: %v", name, err)
+	}
+	tb.Cleanup(func() {
+		if err := js.DeleteStream(context.Background(), name); err != nil && !n.Conn.IsClosed() {
+			tb.Errorf("natstest: delete stream %s: %v", name, err)
+		}
+	})
+	return stream
+}
+
+// Subscribe starts buffering the messages published on subject (wildcards allowed) and unsubscribes when
+// tb finishes. The subscription is registered with the server before Subscribe returns, so no message
+// published afterwards is missed.
+func (n *NATS) Subscribe(tb testing.TB, subject string) *Subscription {
+	tb.Helper()
+	sub, err := n.Conn.SubscribeSync(subject)
+	if err == nil {
+		err = n.Conn.Flush()
+	}
+	if err != nil {
+		tb.Fatalf("natstest: subscribe %s: %v", subject, err)
+	}
+	tb.Cleanup(func() { _ = sub.Unsubscribe() })
+	return &Subscription{sub: sub}
+}
+
+// Next returns the next buffered message, waiting up to timeout, or None if none arrives.
+func (s *Subscription) Next(timeout time.Duration) option.Option[*nats.Msg] {
+	msg, err := s.sub.NextMsg(timeout)
+	if err != nil {
+		return option.None[*nats.Msg]()
+	}
+	return option.Some(msg)
+}
+
+// Take returns the next count messages, waiting up to timeout in total. If fewer arrive, the Err matches
+// ErrNoMessage and reports how many did.
+func (s *Subscription) Take(count int, timeout time.Duration) result.Result[[]*nats.Msg] {
+	deadline := time.Now().Add(timeout)
+	msgs := make([]*nats.Msg, 0, count)
+	for len(msgs) < count {
+		msg := s.Next(max(time.Until(deadline), time.Millisecond))
+		if msg.IsNone() {
+			return result.Err[[]*nats.Msg](fmt.Errorf("%w: got %d of %d on %s within %v",
+				ErrNoMessage, len(msgs), count, s.sub.Subject, timeout))
+		}
+		msgs = append(msgs, msg.Unwrap())
+	}
+	return result.Ok(msgs)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package natstest_test. natstest_test verifies the NATS helpers; it skips without Docker.
+package natstest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/goxidetest/natstest"
+)
+
+func TestNATS_SubscribeAndStream(t *testing.T) {
+	broker := natstest.New(t)
+	stream := broker.CreateStream(t, "ORDERS", "orders.>")
+	events := broker.Subscribe(t, "orders.*")
+
+	for _, body := range []string{"a", "b"} {
+		if err := broker.Conn.Publish("orders.placed", []byte(body)); err != nil {
+			t.Fatalf("publish: %v", err)
+		}
+	}
+
+	msgs := events.Take(2, 5*time.Second).Unwrap()
+	if string(msgs[0].Data) != "a" || string(msgs[1].Data) != "b" {
+		t.Fatalf("expected %v, got %q %q", "a b", msgs[0].Data, msgs[1].Data)
+	}
+	if res := events.Take(1, 50*time.Millisecond); !errors.Is(res.Err(), natstest.ErrNoMessage) {
+		t.Fatalf("expected %v, got %v", natstest.ErrNoMessage, res.Err())
+	}
+
+	if err := broker.Conn.Flush(); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+	info, err := stream.Info(context.Background())
+	if err != nil || info.State.Msgs != 2 {
+		t.Fatalf("expected %v stored messages, got %v (%v)", 2, info, err)
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package goxidetest. postgres provides reusable PostgreSQL test infrastructure backed by testcontainers, for
+// integration tests and benchmarks of Result-based data access code.
+//
+// Example - One database per test:
+//
+//	func TestUserRepo_Find(t *testing.T) {
+//	    pg := goxidetest.NewPostgres(t) // skipped when Docker is unavailable, terminated by t.Cleanup
+//	    repo := NewUserRepo(pg.DB)
+//	    user := rustytest.RequireOk(t, repo.Find(ctx, 1))
+//	    ...
+//	}
+//
+// Example - One database per package:
+//
+//	func TestMain(m *testing.M) {
+//	    os.Exit(goxidetest.PostgresMain(m)) // exports TEST_DATABASE_DSN
+//	}
+package goxidetest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	_ "github.com/lib/pq"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Postgres holds a running PostgreSQL container, an open database handle and its cleanup function.
+type Postgres struct {
+	Container *postgres.PostgresContainer
+	DB        *sql.DB
+	DSN       string // postgres:// URL of the database, for code that opens its own connections
+	Cleanup   func(ctx context.Context) error
+}
+
+// PostgresConfig holds the database configuration for a Postgres container. The zero values of the
+// optional fields keep the defaults.
+//
+// Example - PostGIS with an extension enabled by an init script:
+//
+//	cfg := goxidetest.DefaultPostgresConfig()
+//	cfg.Image = "postgis/postgis:16-3.4-alpine"
+//	cfg.InitScripts = []string{"testdata/enable_postgis.sql"}
+//	cfg.Tmpfs = true
+//	pg := goxidetest.StartPostgresWithConfig(ctx, cfg).Expect("start postgis")
+type PostgresConfig struct {
+	Database string
+	Username string
+	Password string
+	Image    string   // e.g. "postgres:15-alpine"
+	Port     nat.Port // container internal port (usually "5432")
+
+	Env         map[string]string                    // extra environment, e.g. POSTGRES_INITDB_ARGS
+	InitScripts []string                             // .sql, .sql.gz or .sh files run once at startup, in order
+	Tmpfs       bool                                 // keep the data directory in memory: faster, nothing persists
+	WaitFor     wait.Strategy                        // replaces the default wait for a working SQL connection
+	Options     []testcontainers.ContainerCustomizer // applied last, for anything not covered above
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// DSNEnv is the environment variable PostgresMain exports the database URL in.
+const DSNEnv = "TEST_DATABASE_DSN"
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// DefaultPostgresConfig returns the default database configuration for PostgreSQL.
+func DefaultPostgresConfig() PostgresConfig {
+	return PostgresConfig{
+		Database: "testdb",
+		Username: "test",
+		Password: "test",
+		Image:    "postgres:15-alpine",
+		Port:     "5432",
+	}
+}
+
+// DSN returns the postgres:// URL of the configured database on host and port.
+func (cfg PostgresConfig) DSN(host, port string) string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", cfg.Username, cfg.Password, host, port, cfg.Database)
+}
+
+// StartPostgres starts a PostgreSQL container with DefaultPostgresConfig.
+// The returned DB is ready for use (Ping succeeded). Callers should call Cleanup when done.
+func StartPostgres(ctx context.Context) result.Result[*Postgres] {
+	return StartPostgresWithConfig(ctx, DefaultPostgresConfig())
+}
+
+// StartPostgresWithConfig starts a PostgreSQL container using the provided config.
+func StartPostgresWithConfig(ctx context.Context, cfg PostgresConfig) result.Result[*Postgres] {
+	ctr, err := createPostgresContainer(ctx, cfg)
+	if err != nil {
+		return result.Err[*Postgres](fmt.Errorf("createPostgresContainer: %w", err))
+	}
+
+	// Get host and mapped port.
+	host, err := ctr.Host(ctx)
+	if err != nil {
+		_ = ctr.Terminate(ctx)
+		return result.Err[*Postgres](fmt.Errorf("failed to get container host: %w", err))
+	}
+	mappedPort, err := ctr.MappedPort(ctx, cfg.Port)
+	if err != nil {
+		_ = ctr.Terminate(ctx)
+		return result.Err[*Postgres](fmt.Errorf("failed to get mapped port: %w", err))
+	}
+
+	// Open database and verify connection.
+	dsn := cfg.DSN(host, mappedPort.Port())
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		_ = ctr.Terminate(ctx)
+		return result.Err[*Postgres](fmt.Errorf("sql.Open: %w", err))
+	}
+
+	// set reasonable connection limits for tests
+	db.SetMaxOpenConns(10)
+	db.SetConnMaxLifetime(5 * time.Minute)
+
+	// Wait/poll for DB to be ready (Ping).
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		if err := db.PingContext(ctx); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			_ = db.Close()
+			_ = ctr.Terminate(ctx)
+			return result.Err[*Postgres](fmt.Errorf("database did not become ready in time: %w", err))
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+
+	cleanup := func(ctx context.Context) error {
+		var firstErr error
+		if err := db.Close(); err != nil {
+			firstErr = fmt.Errorf("close db: %w", err)
+		}
+		if err := ctr.Terminate(ctx); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("terminate container: %w", err)
+		}
+		return firstErr
+	}
+
+	return result.Ok(&Postgres{
+		Container: ctr,
+		DB:        db,
+		DSN:       dsn,
+		Cleanup:   cleanup,
+	})
+}
+
+// NewPostgres starts a PostgreSQL container for a single test or benchmark and terminates it when tb
+// finishes. The test is skipped when Docker is unavailable and fails if the container cannot start.
+func NewPostgres(tb testing.TB) *Postgres {
+	tb.Helper()
+	SkipWithoutDocker(tb)
+
+	res := StartPostgres(context.Background())
+	if res.IsErr() {
+		tb.Fatalf("goxidetest: start postgres: %v", res.Err())
+	}
+	pg := res.Unwrap()
+	tb.Cleanup(func() {
+		if err := pg.Cleanup(context.Background()); err != nil {
+			tb.Errorf("goxidetest: cleanup postgres: %v", err)
+		}
+	})
+	return pg
+}
+
+// PostgresMain provisions a database for a whole package from TestMain, exports its URL in DSNEnv, runs
+// the tests and returns their exit code.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//	    os.Exit(goxidetest.PostgresMain(m))
+//	}
+func PostgresMain(m interface{ Run() int }) int {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	res := StartPostgres(ctx)
+	if res.IsErr() {
+		fmt.Printf("❌ Failed to setup test container: %v\n", res.Err())
+		return 1
+	}
+	pg := res.Unwrap()
+	defer func() {
+		if err := pg.Cleanup(ctx); err != nil {
+			fmt.Printf("❌ Failed to cleanup test container: %v\n", err)
+		}
+	}()
+
+	_ = os.Setenv(DSNEnv, pg.DSN)
+
+	fmt.Println("✅ Test environment initialized successfully!")
+	return m.Run()
+}
+
+// SkipWithoutDocker skips tb when no healthy Docker provider is reachable, so container-backed tests
+// don't fail on machines and CI runners without Docker.
+func SkipWithoutDocker(tb testing.TB) {
+	tb.Helper()
+	if err := dockerHealth(); err != nil {
+		tb.Skipf("goxidetest: Docker is not available: %v", err)
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// createPostgresContainer uses testcontainers' postgres helper to start a PostgreSQL container.
+func createPostgresContainer(ctx context.Context, cfg PostgresConfig) (*postgres.PostgresContainer, error) {
+	waitFor := cfg.WaitFor
+	if waitFor == nil {
+		waitFor = wait.ForSQL(cfg.Port, "postgres", func(host string, port nat.Port) string {
+			return cfg.DSN(host, port.Port())
+		}).WithStartupTimeout(60 * time.Second)
+	}
+
+	opts := []testcontainers.ContainerCustomizer{
+		postgres.WithDatabase(cfg.Database),
+		postgres.WithUsername(cfg.Username),
+		postgres.WithPassword(cfg.Password),
+		testcontainers.WithWaitStrategy(waitFor),
+	}
+	if len(cfg.Env) > 0 {
+		opts = append(opts, testcontainers.WithEnv(cfg.Env))
+	}
+	if len(cfg.InitScripts) > 0 {
+		opts = append(opts, postgres.WithInitScripts(cfg.InitScripts...))
+	}
+	if cfg.Tmpfs {
+		opts = append(opts, testcontainers.WithTmpfs(map[string]string{"/var/lib/postgresql/data": "rw"}))
+	}
+	opts = append(opts, cfg.Options...)
+
+	ctr, err := postgres.Run(ctx, cfg.Image, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres.RunContainer: %w", err)
+	}
+
+	return ctr, nil
+}
+
+// dockerHealth reports why the Docker provider is unusable, or nil if it is healthy. The provider panics
+// in some environments without Docker, which is reported as an error too.
+func dockerHealth() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	provider, err := testcontainers.ProviderDocker.GetProvider()
+	if err != nil {
+		return err
+	}
+	defer provider.Close()
+	return provider.Health(context.Background())
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package goxidetest_test. recorder_test provides a database/sql driver that records statements instead of
+// executing them, for tests that need no database server.
+package goxidetest_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// recorder is a database/sql connector recording every executed statement as "query [args]".
+type recorder struct {
+	mu     sync.Mutex
+	execs  []string
+	failOn string // statements containing failOn fail
+}
+
+type recorderConn struct{ r *recorder }
+
+func (r *recorder) Connect(context.Context) (driver.Conn, error) { return recorderConn{r}, nil }
+func (r *recorder) Driver() driver.Driver                        { return nil }
+
+func (c recorderConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c recorderConn) Close() error                        { return nil }
+func (c recorderConn) Begin() (driver.Tx, error)           { return recorderTx(c), nil }
+
+type recorderTx recorderConn
+
+func (tx recorderTx) Commit() error   { return tx.record("COMMIT") }
+func (tx recorderTx) Rollback() error { return tx.record("ROLLBACK") }
+
+func (tx recorderTx) record(stmt string) error {
+	tx.r.mu.Lock()
+	defer tx.r.mu.Unlock()
+	tx.r.execs = append(tx.r.execs, stmt)
+	return nil
+}
+
+func (c recorderConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.r.mu.Lock()
+	defer c.r.mu.Unlock()
+	if c.r.failOn != "" && strings.Contains(query, c.r.failOn) {
+		return nil, errors.New("constraint violation")
+	}
+	values := make([]any, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	c.r.execs = append(c.r.execs, fmt.Sprintf("%s %v", query, values))
+	return driver.RowsAffected(1), nil
+}
+
+func openRecorder(failOn string) (*sql.DB, *recorder) {
+	r := &recorder{failOn: failOn}
+	return sql.OpenDB(r), r
+}
@@ -0,0 +1,2 @@
+CREATE TABLE seeded (id int PRIMARY KEY);
+INSERT INTO seeded VALUES (1);
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package deepcopy. deepcopy implements the recursive value copy behind reflect.DeepCopy. It lives in an
+// internal package so that low-level packages such as types can use it without importing reflect, which
+// itself depends on result and option.
+package deepcopy
+
+import "reflect"
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Copy returns a deep copy of v. Pointers, slices, maps, arrays, interfaces and exported struct fields
+// are copied recursively, and shared or cyclic pointers keep their shape in the copy. Channels, functions
+// and unexported struct fields are copied shallowly.
+func Copy[T any](v T) T {
+	src := reflect.ValueOf(&v).Elem()
+	dst := reflect.New(src.Type()).Elem()
+	copyValue(dst, src, map[uintptr]reflect.Value{})
+	return dst.Interface().(T)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// copyValue deep-copies src into dst. seen maps already-copied pointers to their copies.
+func copyValue(dst, src reflect.Value, seen map[uintptr]reflect.Value) {
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		if copied, ok := seen[src.Pointer()]; ok {
+			dst.Set(copied)
+			return
+		}
+		ptr := reflect.New(src.Elem().Type())
+		seen[src.Pointer()] = ptr
+		copyValue(ptr.Elem(), src.Elem(), seen)
+		dst.Set(ptr)
+
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		elem := reflect.New(src.Elem().Type()).Elem()
+		copyValue(elem, src.Elem(), seen)
+		dst.Set(elem)
+
+	case reflect.Struct:
+		dst.Set(src) // carries unexported fields over as-is
+		for i := range src.NumField() {
+			if dst.Field(i).CanSet() {
+				copyValue(dst.Field(i), src.Field(i), seen)
+			}
+		}
+
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Cap()))
+		for i := range src.Len() {
+			copyValue(dst.Index(i), src.Index(i), seen)
+		}
+
+	case reflect.Array:
+		for i := range src.Len() {
+			copyValue(dst.Index(i), src.Index(i), seen)
+		}
+
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeMapWithSize(src.Type(), src.Len()))
+		iter := src.MapRange()
+		for iter.Next() {
+			key := reflect.New(iter.Key().Type()).Elem()
+			copyValue(key, iter.Key(), seen)
+			value := reflect.New(iter.Value().Type()).Elem()
+			copyValue(value, iter.Value(), seen)
+			dst.SetMapIndex(key, value)
+		}
+
+	default:
+		dst.Set(src)
+	}
+}
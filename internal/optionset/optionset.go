@@ -0,0 +1,32 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package optionset. optionset lets the reflection-driven binders (textconv, flagx) build option.Option
+// values of types they only know at run time, without the option package exporting setters for them.
+// The option package installs the setter when it is initialized.
+package optionset
+
+import "reflect"
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// setSome stores Some(value) in the Option that ptr points to; installed by the option package.
+var setSome func(ptr, value any)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Install registers the setter behind SetSome. Only the option package calls it.
+func Install(fn func(ptr, value any)) {
+	setSome = fn
+}
+
+// Elem returns T for t = option.Option[T].
+func Elem(t reflect.Type) reflect.Type {
+	field, _ := t.FieldByName("value")
+	return field.Type
+}
+
+// SetSome stores Some(value) in v, an addressable option.Option[T] with value of type T.
+func SetSome(v, value reflect.Value) {
+	setSome(v.Addr().Interface(), value.Interface())
+}
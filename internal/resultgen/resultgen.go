@@ -0,0 +1,319 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package resultgen. resultgen generates Result-returning adapters for existing Go APIs: a wrapper type for
+// each interface, and optionally a wrapper function for each package-level function. Methods and functions
+// returning (T, error) become Result[T], those returning only error become Result[types.Unit], and everything
+// else is passed through unchanged. It backs the goxide gen command.
+package resultgen
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"go/types"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"golang.org/x/tools/go/packages"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Config selects what to generate.
+type Config struct {
+	Pattern string   // package to load, e.g. "." or "github.com/acme/app/repo"
+	Dir     string   // directory to resolve Pattern from; empty for the current directory
+	Types   []string // interfaces to wrap; empty wraps every exported interface unless Funcs is set
+	Funcs   bool     // also wrap exported package-level functions
+	Package string   // output package name; empty to generate into the source package
+}
+
+// kind classifies a signature by what its wrapper returns.
+type kind int
+
+const (
+	passThrough kind = iota // no error result: returned unchanged
+	valueResult             // (T, error): Result[T]
+	unitResult              // error: Result[types.Unit]
+)
+
+// generator accumulates the generated declarations and the imports they use.
+type generator struct {
+	src     *types.Package
+	same    bool              // output goes into src itself
+	imports map[string]string // import path -> local name
+	body    bytes.Buffer
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+const (
+	resultPath = "github.com/seyedali-dev/goxide/rusty/result"
+	typesPath  = "github.com/seyedali-dev/goxide/rusty/types"
+)
+
+var (
+	// ErrNotFound is returned when a requested type does not exist in the package.
+	ErrNotFound = errors.New("resultgen: type not found")
+	// ErrNotInterface is returned when a requested type is not a non-generic interface.
+	ErrNotInterface = errors.New("resultgen: not a non-generic interface")
+)
+
+// errorType is the predeclared error type.
+var errorType = types.Universe.Lookup("error").Type()
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Generate loads cfg.Pattern and returns the gofmt-ed source of the adapters.
+//
+// Example - Generated wrapper for an interface:
+//
+//	type UserRepo interface {
+//	    FindUserByID(ctx context.Context, id int) (*User, error)
+//	}
+//
+//	// generates
+//	type UserRepoResult struct{ inner UserRepo }
+//	func NewUserRepoResult(inner UserRepo) UserRepoResult { ... }
+//	func (w UserRepoResult) FindUserByID(ctx context.Context, id int) result.Result[*User] {
+//	    return result.Wrap(w.inner.FindUserByID(ctx, id))
+//	}
+func Generate(cfg Config) (res result.Result[[]byte]) {
+	defer result.Catch(&res)
+
+	pkg := load(cfg).BubbleUp()
+	g := &generator{
+		src:     pkg,
+		same:    cfg.Package == "" || cfg.Package == pkg.Name(),
+		imports: make(map[string]string),
+	}
+
+	for _, iface := range interfaces(pkg, cfg).BubbleUp() {
+		g.wrapInterface(iface)
+	}
+	if cfg.Funcs {
+		for _, name := range pkg.Scope().Names() {
+			if fn, ok := pkg.Scope().Lookup(name).(*types.Func); ok && fn.Exported() {
+				g.wrapFunc(fn)
+			}
+		}
+	}
+
+	return result.Wrap(format.Source(g.file(cmp.Or(cfg.Package, pkg.Name()))))
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// load type-checks the single package matched by cfg.Pattern.
+func load(cfg Config) result.Result[*types.Package] {
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedTypes, Dir: cfg.Dir}, cfg.Pattern)
+	if err != nil {
+		return result.Err[*types.Package](err)
+	}
+	if len(pkgs) != 1 {
+		return result.Err[*types.Package](fmt.Errorf("resultgen: %q matched %d packages, want 1", cfg.Pattern, len(pkgs)))
+	}
+	for _, e := range pkgs[0].Errors {
+		return result.Err[*types.Package](e)
+	}
+	return result.Ok(pkgs[0].Types)
+}
+
+// interfaces returns the named interfaces selected by cfg, in source order of cfg.Types or by name.
+func interfaces(pkg *types.Package, cfg Config) result.Result[[]*types.TypeName] {
+	names := cfg.Types
+	if len(names) == 0 && !cfg.Funcs {
+		for _, name := range pkg.Scope().Names() {
+			if obj, ok := pkg.Scope().Lookup(name).(*types.TypeName); ok && obj.Exported() && types.IsInterface(obj.Type()) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	var out []*types.TypeName
+	for _, name := range names {
+		obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			return result.Err[[]*types.TypeName](fmt.Errorf("%w: %s.%s", ErrNotFound, pkg.Path(), name))
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok || !types.IsInterface(named) || named.TypeParams().Len() > 0 {
+			return result.Err[[]*types.TypeName](fmt.Errorf("%w: %s.%s", ErrNotInterface, pkg.Path(), name))
+		}
+		out = append(out, obj)
+	}
+	return result.Ok(out)
+}
+
+// wrapInterface emits the wrapper type, its constructor and one method per interface method.
+func (g *generator) wrapInterface(obj *types.TypeName) {
+	iface := obj.Type().Underlying().(*types.Interface)
+	name, ifaceType := obj.Name()+"Result", g.typeString(obj.Type())
+	ctor := "New" + upperFirst(name)
+	if !token.IsExported(obj.Name()) {
+		ctor = "new" + upperFirst(name)
+	}
+
+	fmt.Fprintf(&g.body, "// %s wraps %s, returning Results instead of (value, error) pairs.\n", name, obj.Name())
+	fmt.Fprintf(&g.body, "type %s struct {\n\tinner %s\n}\n\n", name, ifaceType)
+	fmt.Fprintf(&g.body, "// %s wraps inner.\n", ctor)
+	fmt.Fprintf(&g.body, "func %s(inner %s) %s {\n\treturn %s{inner: inner}\n}\n\n", ctor, ifaceType, name, name)
+	fmt.Fprintf(&g.body, "// Unwrap returns the wrapped %s.\n", obj.Name())
+	fmt.Fprintf(&g.body, "func (w %s) Unwrap() %s {\n\treturn w.inner\n}\n\n", name, ifaceType)
+
+	for method := range iface.Methods() {
+		sig := method.Signature()
+		fmt.Fprintf(&g.body, "// %s calls %s.%s%s.\n", method.Name(), obj.Name(), method.Name(), g.docSuffix(sig))
+		g.emitFunc("(w "+name+") ", method.Name(), "w.inner."+method.Name(), sig)
+	}
+}
+
+// wrapFunc emits an adapter for a package-level function that returns an error. Adapters generated into
+// the source package are suffixed with Result to avoid clashing with the original.
+func (g *generator) wrapFunc(fn *types.Func) {
+	sig := fn.Signature()
+	if classify(sig) == passThrough || sig.TypeParams().Len() > 0 {
+		return
+	}
+	name, target := fn.Name(), fn.Name()
+	if g.same {
+		name += "Result"
+	} else {
+		target = g.importName(g.src.Path(), g.src.Name()) + "." + fn.Name()
+	}
+	fmt.Fprintf(&g.body, "// %s calls %s.%s.\n", name, g.src.Name(), fn.Name()+g.docSuffix(sig))
+	g.emitFunc("", name, target, sig)
+}
+
+// emitFunc emits a function or method named name whose body calls target with the parameters of sig.
+func (g *generator) emitFunc(recv, name, target string, sig *types.Signature) {
+	reserved := map[string]bool{"w": true, "result": true, "types": true}
+	var params, args []string
+	for i := range sig.Params().Len() {
+		v := sig.Params().At(i)
+		pname := v.Name()
+		if pname == "" || pname == "_" || reserved[pname] {
+			pname = fmt.Sprintf("p%d", i)
+		}
+		typ := g.typeString(v.Type())
+		arg := pname
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			typ = "..." + g.typeString(v.Type().(*types.Slice).Elem())
+			arg += "..."
+		}
+		params = append(params, pname+" "+typ)
+		args = append(args, arg)
+	}
+	call := fmt.Sprintf("%s(%s)", target, strings.Join(args, ", "))
+
+	var results, body string
+	switch classify(sig) {
+	case valueResult:
+		results = fmt.Sprintf("%s.Result[%s]", g.result(), g.typeString(sig.Results().At(0).Type()))
+		body = fmt.Sprintf("return %s.Wrap(%s)", g.result(), call)
+	case unitResult:
+		unit := g.importName(typesPath, "types") + ".Unit"
+		results = fmt.Sprintf("%s.Result[%s]", g.result(), unit)
+		body = fmt.Sprintf("return %s.Wrap(%s{}, %s)", g.result(), unit, call)
+	default:
+		var out []string
+		for v := range sig.Results().Variables() {
+			out = append(out, g.typeString(v.Type()))
+		}
+		results = strings.Join(out, ", ")
+		if len(out) > 1 {
+			results = "(" + results + ")"
+		}
+		body = call
+		if len(out) > 0 {
+			body = "return " + call
+		}
+	}
+	fmt.Fprintf(&g.body, "func %s%s(%s) %s {\n\t%s\n}\n\n", recv, name, strings.Join(params, ", "), results, body)
+}
+
+// docSuffix describes how the wrapper changes the signature, for its doc comment.
+func (g *generator) docSuffix(sig *types.Signature) string {
+	if classify(sig) == passThrough {
+		return ""
+	}
+	return ", returning its outcome as a Result"
+}
+
+// file assembles the package clause, imports and generated declarations.
+func (g *generator) file(pkgName string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by goxide gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	if len(g.imports) > 0 {
+		buf.WriteString("import (\n")
+		for _, importPath := range slices.Sorted(maps.Keys(g.imports)) {
+			if local := g.imports[importPath]; local != path.Base(importPath) {
+				buf.WriteString("\t" + local + " ")
+			}
+			fmt.Fprintf(&buf, "\t%q\n", importPath)
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.Write(g.body.Bytes())
+	return buf.Bytes()
+}
+
+// typeString renders t as it must be written in the generated file, registering the imports it needs.
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if g.same && p == g.src {
+			return ""
+		}
+		return g.importName(p.Path(), p.Name())
+	})
+}
+
+// result returns the local name of the result package.
+func (g *generator) result() string {
+	return g.importName(resultPath, "result")
+}
+
+// importName registers path and returns its local name, numbering it if name is taken by another path.
+func (g *generator) importName(path, name string) string {
+	if local, ok := g.imports[path]; ok {
+		return local
+	}
+	taken := make(map[string]bool, len(g.imports))
+	for _, local := range g.imports {
+		taken[local] = true
+	}
+	local := name
+	for i := 2; taken[local]; i++ {
+		local = fmt.Sprintf("%s%d", name, i)
+	}
+	g.imports[path] = local
+	return local
+}
+
+// classify reports whether sig ends in (T, error), in a lone error, or in neither.
+func classify(sig *types.Signature) kind {
+	res := sig.Results()
+	switch {
+	case res.Len() == 1 && types.Identical(res.At(0).Type(), errorType):
+		return unitResult
+	case res.Len() == 2 && types.Identical(res.At(1).Type(), errorType):
+		return valueResult
+	}
+	return passThrough
+}
+
+// upperFirst upper-cases the first ASCII letter of s.
+func upperFirst(s string) string {
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		return s
+	}
+	return string(s[0]-'a'+'A') + s[1:]
+}
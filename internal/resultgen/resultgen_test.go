@@ -0,0 +1,61 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package resultgen_test. resultgen_test generates adapters for testdata/repo and checks the output.
+package resultgen_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/internal/resultgen"
+)
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestGenerate_Interface(t *testing.T) {
+	src := string(resultgen.Generate(resultgen.Config{Pattern: "./testdata/repo", Types: []string{"UserRepo"}}).Unwrap())
+
+	for _, want := range []string{
+		"// Code generated by goxide gen. DO NOT EDIT.",
+		"package repo",
+		"type UserRepoResult struct {\n\tinner UserRepo\n}",
+		"func NewUserRepoResult(inner UserRepo) UserRepoResult {",
+		"func (w UserRepoResult) Close() result.Result[types.Unit] {\n\treturn result.Wrap(types.Unit{}, w.inner.Close())",
+		"func (w UserRepoResult) FindUserByID(ctx context.Context, id int) result.Result[*User] {\n\treturn result.Wrap(w.inner.FindUserByID(ctx, id))",
+		"func (w UserRepoResult) Tag(p0 string, values ...string) result.Result[types.Unit] {\n\treturn result.Wrap(types.Unit{}, w.inner.Tag(p0, values...))",
+		"func (w UserRepoResult) Count() int {\n\treturn w.inner.Count()",
+	} {
+		if !strings.Contains(src, want) {
+			t.Fatalf("expected output to contain %q, got:\n%s", want, src)
+		}
+	}
+}
+
+func TestGenerate_FuncsIntoOtherPackage(t *testing.T) {
+	src := string(resultgen.Generate(resultgen.Config{Pattern: "./testdata/repo", Funcs: true, Package: "repox"}).Unwrap())
+
+	for _, want := range []string{
+		"package repox",
+		`"github.com/seyedali-dev/goxide/internal/resultgen/testdata/repo"`,
+		"func Load(p0 context.Context, ids []int) result.Result[[]repo.User] {\n\treturn result.Wrap(repo.Load(p0, ids))",
+	} {
+		if !strings.Contains(src, want) {
+			t.Fatalf("expected output to contain %q, got:\n%s", want, src)
+		}
+	}
+	if strings.Contains(src, "UserRepoResult") || strings.Contains(src, "Version") {
+		t.Fatalf("expected only error-returning functions, got:\n%s", src)
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	cases := map[string]error{"Missing": resultgen.ErrNotFound, "User": resultgen.ErrNotInterface, "Generic": resultgen.ErrNotInterface}
+	for name, want := range cases {
+		res := resultgen.Generate(resultgen.Config{Pattern: "./testdata/repo", Types: []string{name}})
+		if !errors.Is(res.Err(), want) {
+			t.Fatalf("expected %v for %s, got %v", want, name, res.Err())
+		}
+	}
+}
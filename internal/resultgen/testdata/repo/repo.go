@@ -0,0 +1,24 @@
+// Package repo is a sample package for resultgen tests.
+package repo
+
+import (
+	"context"
+	"io"
+)
+
+type User struct{ ID int }
+
+type UserRepo interface {
+	io.Closer
+	FindUserByID(ctx context.Context, id int) (*User, error)
+	Tag(result string, values ...string) error
+	Count() int
+}
+
+type Generic[T any] interface {
+	Get() (T, error)
+}
+
+func Load(_ context.Context, ids []int) ([]User, error) { return nil, nil }
+
+func Version() string { return "v1" }
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package textconv. textconv parses strings into reflected values of the scalar types supported by the
+// tag-driven binders (config, csvx, ...), so every binder accepts the same field types with the same syntax:
+// strings, bools, integers, floats, time.Duration, encoding.TextUnmarshaler implementations,
+// comma-separated slices of those, Option[T] of any of them, and types with a parser added by Register.
+package textconv
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/seyedali-dev/goxide/internal/optionset"
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Parser parses a string into a value of the type it was registered for.
+type Parser func(raw string) (reflect.Value, error)
+
+// -------------------------------------------- Constants --------------------------------------------
+
+var (
+	durationType      = reflect.TypeFor[time.Duration]()
+	textUnmarshalType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	optionPkgPath     = reflect.TypeFor[option.Option[int]]().PkgPath()
+)
+
+// ErrUnsupported is wrapped by the error Set returns for types it cannot parse.
+var ErrUnsupported = errors.New("unsupported field type")
+
+// parsers holds the Parsers added by Register, keyed by reflect.Type.
+var parsers sync.Map
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Register makes Set parse values of type t with parse, taking precedence over the built-in rules.
+// Registering t again replaces its parser.
+func Register(t reflect.Type, parse Parser) {
+	parsers.Store(t, parse)
+}
+
+// IsScalar reports whether values of t are parsed from a single string by Set, as opposed to structs
+// that callers should recurse into field by field.
+func IsScalar(t reflect.Type) bool {
+	return t.Kind() != reflect.Struct || IsOption(t) || reflect.PointerTo(t).Implements(textUnmarshalType) || registered(t)
+}
+
+// IsOption reports whether t is an option.Option instantiation.
+func IsOption(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == optionPkgPath && strings.HasPrefix(t.Name(), "Option[")
+}
+
+// Set parses raw into v according to v's type. v must be addressable.
+// Option[T] fields become Some of the parsed T; slices are parsed from comma-separated items.
+func Set(v reflect.Value, raw string) error {
+	if parse, ok := parsers.Load(v.Type()); ok {
+		parsed, err := parse.(Parser)(raw)
+		if err != nil {
+			return err
+		}
+		v.Set(parsed)
+		return nil
+	}
+	if IsOption(v.Type()) {
+		inner := reflect.New(optionset.Elem(v.Type())).Elem()
+		if err := Set(inner, raw); err != nil {
+			return err
+		}
+		optionset.SetSome(v, inner)
+		return nil
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(raw))
+	}
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		var parts []string
+		if raw != "" {
+			parts = strings.Split(raw, ",")
+		}
+		slice := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := Set(slice.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+	default:
+		return fmt.Errorf("%w %s", ErrUnsupported, v.Type())
+	}
+	return nil
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// registered reports whether a parser was added for t.
+func registered(t reflect.Type) bool {
+	_, ok := parsers.Load(t)
+	return ok
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect. columns derives ordered column name and value lists from struct tags, so INSERT and
+// UPDATE statements in Result-based repositories can be generated instead of hand-typed.
+//
+// Example - Building an INSERT:
+//
+//	cols := reflect.Columns[User]("db")
+//	query := fmt.Sprintf("INSERT INTO users (%s) VALUES (%s)", strings.Join(cols, ", "), placeholders(len(cols)))
+//	_, err := db.ExecContext(ctx, query, reflect.ColumnValues(user, "db")...)
+package reflect
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Columns returns the names given by the tag key on the fields of struct type T, in field declaration order
+// (fields of untagged embedded structs appear where the embedded struct is declared). Names are resolved as
+// by FieldsByTag. It returns nil if T is not a struct.
+//
+// Example:
+//
+//	type User struct {
+//	    ID    int    `db:"id"`
+//	    Email string `db:"email"`
+//	}
+//	reflect.Columns[User]("db") // [id email]
+func Columns[T any](key string) []string {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	return orderedNames(FieldsByTag(t, key))
+}
+
+// ColumnValues returns the values of the fields named by Columns, in the same order, so the two line up as
+// column list and query arguments. instance may be a struct or a non-nil pointer to one; any other value
+// yields nil.
+//
+// Example:
+//
+//	reflect.ColumnValues(User{ID: 7, Email: "ali@example.com"}, "db") // [7 ali@example.com]
+func ColumnValues[T any](instance T, key string) []any {
+	v := reflect.ValueOf(instance)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := FieldsByTag(v.Type(), key)
+	names := orderedNames(fields)
+	values := make([]any, len(names))
+	for i, name := range names {
+		values[i] = v.FieldByIndex(fields[name]).Interface()
+	}
+	return values
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// orderedNames returns the names of fields sorted by their index paths, i.e. in declaration order.
+func orderedNames(fields map[string][]int) []string {
+	return slices.SortedFunc(maps.Keys(fields), func(a, b string) int {
+		return slices.Compare(fields[a], fields[b])
+	})
+}
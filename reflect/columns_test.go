@@ -0,0 +1,36 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect_test. columns_test verifies column ordering and value extraction.
+package reflect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/reflect"
+)
+
+func TestColumns(t *testing.T) {
+	got := fmt.Sprint(reflect.Columns[Account]("db"))
+	if want := "[created_at id email]"; got != want {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if cols := reflect.Columns[int]("db"); cols != nil {
+		t.Fatalf("expected %v, got %v", nil, cols)
+	}
+}
+
+func TestColumnValues(t *testing.T) {
+	account := Account{Base: Base{ID: 1, Created: "today"}, ID: "a-1", Email: "ali@example.com", Password: "x"}
+	want := "[today a-1 ali@example.com]"
+	if got := fmt.Sprint(reflect.ColumnValues(account, "db")); got != want {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got := fmt.Sprint(reflect.ColumnValues(&account, "db")); got != want {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got := reflect.ColumnValues[*Account](nil, "db"); got != nil {
+		t.Fatalf("expected %v, got %v", nil, got)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect. deepcopy provides DeepCopy, a reflection-based recursive copy for values whose nested
+// maps, slices and pointers must not be shared with the original.
+package reflect
+
+import "github.com/seyedali-dev/goxide/internal/deepcopy"
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// DeepCopy returns a copy of v that shares no mutable memory with it.
+// Pointers, slices, maps, arrays, interfaces and exported struct fields are copied recursively, and shared
+// or cyclic pointers keep their shape in the copy. Channels, functions and unexported struct fields are
+// copied shallowly.
+//
+// When to use:
+//   - Before handing a config or cache entry to code that may mutate it
+//   - When taking snapshots of nested state for comparison or rollback
+//
+// Example:
+//
+//	snapshot := reflect.DeepCopy(cfg)
+//	snapshot.Limits["uploads"] = 10 // cfg.Limits is unchanged
+func DeepCopy[T any](v T) T {
+	return deepcopy.Copy(v)
+}
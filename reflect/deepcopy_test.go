@@ -0,0 +1,56 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect_test. deepcopy_test verifies that copies share no mutable memory with the original.
+package reflect_test
+
+import (
+	"testing"
+
+	"github.com/seyedali-dev/goxide/reflect"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+type Limits struct {
+	Values map[string]int
+	Tags   []string
+}
+
+type Config struct {
+	Name   string
+	Limits *Limits
+	Extra  any
+	Peers  [2]*Limits
+	Self   *Config
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestDeepCopy_NoSharedMemory(t *testing.T) {
+	shared := &Limits{Values: map[string]int{"uploads": 5}, Tags: []string{"a"}}
+	orig := Config{Name: "base", Limits: shared, Extra: []int{1}, Peers: [2]*Limits{shared, nil}}
+	orig.Self = &orig
+
+	cp := reflect.DeepCopy(orig)
+	cp.Limits.Values["uploads"] = 10
+	cp.Limits.Tags[0] = "b"
+	cp.Extra.([]int)[0] = 2
+
+	if shared.Values["uploads"] != 5 || shared.Tags[0] != "a" || orig.Extra.([]int)[0] != 1 {
+		t.Fatal("expected original to be unaffected by changes to the copy")
+	}
+	if cp.Peers[0] != cp.Limits {
+		t.Fatal("expected shared pointers to stay shared within the copy")
+	}
+	if cp.Self == &orig || cp.Self.Self != cp.Self {
+		t.Fatal("expected cyclic pointer to be copied as a cycle")
+	}
+}
+
+func TestDeepCopy_NilValues(t *testing.T) {
+	cp := reflect.DeepCopy(Config{})
+	if cp.Limits != nil || cp.Extra != nil {
+		t.Fatalf("expected nil fields to stay nil, got %+v", cp)
+	}
+}
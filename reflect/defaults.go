@@ -0,0 +1,90 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect. defaults provides ApplyDefaults, which fills zero-valued struct fields from `default:"..."`
+// tags using the same conversions as config.Load, so config and DTO structs get sane defaults without
+// constructor boilerplate.
+//
+// Example - Traditional vs ApplyDefaults:
+//
+//	// Traditional Go
+//	func NewServerConfig() ServerConfig {
+//	    return ServerConfig{Port: 8080, Timeout: 5 * time.Second, Tags: []string{"web"}}
+//	}
+//
+//	// With ApplyDefaults
+//	type ServerConfig struct {
+//	    Port    int           `default:"8080"`
+//	    Timeout time.Duration `default:"5s"`
+//	    Tags    []string      `default:"web"`
+//	}
+//	var cfg ServerConfig
+//	err := reflect.ApplyDefaults(&cfg)
+package reflect
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/seyedali-dev/goxide/internal/textconv"
+)
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// DefaultTag is the struct tag key read by ApplyDefaults.
+const DefaultTag = "default"
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// ApplyDefaults sets every zero-valued exported field of the struct v points to from its `default:"..."` tag.
+// Values are parsed as by FromMap (numbers, bools, durations, comma-separated slices, TextUnmarshalers,
+// Option[T], ...). Fields that already hold a non-zero value are kept, and untagged struct fields (and non-nil
+// pointers to structs) are filled recursively. On failure the error joins one *FieldError per field, named by
+// its dotted Go field path; the other fields are still applied. A nil v is a no-op.
+//
+// When to use:
+//   - When decoding JSON or YAML into a struct that should fall back to defaults for omitted keys
+//   - When building DTOs whose zero values are not meaningful
+func ApplyDefaults[T any](v *T) error {
+	if v == nil {
+		return nil
+	}
+	target := reflect.ValueOf(v).Elem()
+	if target.Kind() != reflect.Struct {
+		return ErrNotStructPointer
+	}
+	return errors.Join(applyDefaults(target, "")...)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// applyDefaults fills the zero-valued tagged fields of struct v, prefixing error names with path.
+func applyDefaults(v reflect.Value, path string) []error {
+	var errs []error
+	for i := range v.NumField() {
+		field, sf := v.Field(i), v.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := path + sf.Name
+
+		raw, tagged := sf.Tag.Lookup(DefaultTag)
+		if !tagged {
+			switch {
+			case field.Kind() == reflect.Struct && !textconv.IsScalar(field.Type()):
+				errs = append(errs, applyDefaults(field, name+".")...)
+			case field.Kind() == reflect.Pointer && !field.IsNil() && field.Elem().Kind() == reflect.Struct &&
+				!textconv.IsScalar(field.Elem().Type()):
+				errs = append(errs, applyDefaults(field.Elem(), name+".")...)
+			}
+			continue
+		}
+		if !field.IsZero() {
+			continue
+		}
+		if err := textconv.Set(field, raw); err != nil {
+			errs = append(errs, &FieldError{Name: name, Err: err})
+		}
+	}
+	return errs
+}
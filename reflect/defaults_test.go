@@ -0,0 +1,65 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect_test. defaults_test verifies default filling, coercion, recursion and error reporting.
+package reflect_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/reflect"
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+type Quota struct {
+	Uploads int `default:"10"`
+}
+
+type ServerConfig struct {
+	Host    string              `default:"localhost"`
+	Port    int                 `default:"8080"`
+	Timeout time.Duration       `default:"5s"`
+	Tags    []string            `default:"web, api"`
+	Debug   option.Option[bool] `default:"true"`
+	Quota   Quota
+	Backup  *Quota
+}
+
+func TestApplyDefaults(t *testing.T) {
+	cfg := ServerConfig{Port: 9090, Backup: &Quota{}}
+	if err := reflect.ApplyDefaults(&cfg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	got := fmt.Sprint(cfg.Host, cfg.Port, cfg.Timeout, cfg.Tags, cfg.Debug.Unwrap(), cfg.Quota.Uploads, cfg.Backup.Uploads)
+	if want := fmt.Sprint("localhost", 9090, 5*time.Second, []string{"web", "api"}, true, 10, 10); got != want {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestApplyDefaults_Errors(t *testing.T) {
+	type broken struct {
+		Port  int `default:"http"`
+		Inner struct {
+			Ratio float64 `default:"half"`
+		}
+		Name string `default:"ok"`
+	}
+
+	var v broken
+	err := reflect.ApplyDefaults(&v)
+	var fieldErr *reflect.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Name != "Port" {
+		t.Fatalf("expected a *FieldError for Port, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "Inner.Ratio: ") {
+		t.Fatalf("expected an error for %v, got %v", "Inner.Ratio", err)
+	}
+	if v.Name != "ok" {
+		t.Fatalf("expected %v, got %v", "ok", v.Name)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect. frommap populates structs from loosely typed maps (decoded query strings, form values,
+// generic JSON, fixture rows) by struct tag, converting strings with the same rules as config.Load and
+// reporting every field that cannot be set instead of stopping at the first.
+package reflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/seyedali-dev/goxide/internal/textconv"
+	"github.com/seyedali-dev/goxide/rusty/convert"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// FieldError reports a map entry that could not be assigned to its struct field.
+type FieldError struct {
+	Name string // the tag name of the field, or its dotted Go field path for ApplyDefaults
+	Err  error
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrNotStructPointer is returned by MapInto when its target is not a non-nil pointer to a struct.
+var ErrNotStructPointer = errors.New("reflect: target must be a non-nil pointer to a struct")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// FromMap builds a T from m, assigning each entry to the field whose tag key names it (see FieldsByTag).
+// Values are converted as follows:
+//   - strings are parsed into the field type (numbers, bools, durations, TextUnmarshalers, Option[T], ...)
+//   - []string values fill slice fields element by element; a single-element []string sets a scalar field
+//   - other values are converted as convert.TryInto does: assigned if assignable, converted between numeric
+//     types if no precision is lost, element by element into slices, and reported as *convert.Error otherwise
+//
+// Entries without a matching field are ignored. On failure the Err joins one *FieldError per entry.
+//
+// When to use:
+//   - When binding url.Values, form posts or map[string]any payloads into typed structs
+//   - When loading table-like fixtures keyed by column name
+//
+// Example:
+//
+//	type Filter struct {
+//	    Limit  int      `query:"limit"`
+//	    Status []string `query:"status"`
+//	}
+//	filter := reflect.FromMap[Filter](map[string]any{"limit": "20", "status": []string{"open", "paid"}}, "query")
+func FromMap[T any](m map[string]any, tag string) result.Result[T] {
+	var v T
+	if err := MapInto(&v, m, tag); err != nil {
+		return result.Err[T](err)
+	}
+	return result.Ok(v)
+}
+
+// MapInto assigns the entries of m to the tagged fields of the struct that target points to, leaving other
+// fields untouched. It follows the conversion rules of FromMap.
+func MapInto(target any, m map[string]any, tag string) error {
+	ptr := reflect.ValueOf(target)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return ErrNotStructPointer
+	}
+	v := ptr.Elem()
+
+	fields := FieldsByTag(v.Type(), tag)
+	names := orderedNames(fields) // report errors in field order
+
+	var errs []error
+	for _, name := range names {
+		raw, ok := m[name]
+		if !ok {
+			continue
+		}
+		if err := assign(v.FieldByIndex(fields[name]), raw); err != nil {
+			errs = append(errs, &FieldError{Name: name, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// -------------------------------------------- FieldError Methods --------------------------------------------
+
+// Error formats the failure as "name: reason".
+func (e *FieldError) Error() string {
+	return e.Name + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying conversion error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// assign converts raw into field according to the FromMap rules.
+func assign(field reflect.Value, raw any) error {
+	switch value := raw.(type) {
+	case string:
+		return textconv.Set(field, value)
+	case []string:
+		if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+			slice := reflect.MakeSlice(field.Type(), len(value), len(value))
+			for i, item := range value {
+				if err := assign(slice.Index(i), item); err != nil {
+					return fmt.Errorf("item %d: %w", i, err)
+				}
+			}
+			field.Set(slice)
+			return nil
+		}
+		if len(value) != 1 {
+			return fmt.Errorf("expected a single value, got %d", len(value))
+		}
+		return textconv.Set(field, value[0])
+	}
+
+	return convert.Assign(field, raw)
+}
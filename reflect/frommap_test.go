@@ -0,0 +1,59 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect_test. frommap_test verifies conversions and aggregated field errors.
+package reflect_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/reflect"
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+type Filter struct {
+	Limit   int                  `query:"limit"`
+	Status  []string             `query:"status"`
+	Timeout time.Duration        `query:"timeout"`
+	Cursor  option.Option[int64] `query:"cursor"`
+	Score   float64              `query:"score"`
+}
+
+func TestFromMap(t *testing.T) {
+	got := reflect.FromMap[Filter](map[string]any{
+		"limit":   []string{"20"},
+		"status":  []string{"open", "paid"},
+		"timeout": "2s",
+		"cursor":  "99",
+		"score":   3, // int converts to float64
+		"unknown": "ignored",
+	}, "query").Unwrap()
+
+	if got.Limit != 20 || got.Timeout != 2*time.Second || got.Cursor.Unwrap() != 99 || got.Score != 3 {
+		t.Fatalf("unexpected filter %+v", got)
+	}
+	if !slices.Equal(got.Status, []string{"open", "paid"}) {
+		t.Fatalf("expected %v, got %v", []string{"open", "paid"}, got.Status)
+	}
+}
+
+func TestFromMap_AggregatesErrors(t *testing.T) {
+	res := reflect.FromMap[Filter](map[string]any{"limit": "many", "timeout": "soon", "score": "high"}, "query")
+
+	var fieldErr *reflect.FieldError
+	if !errors.As(res.Err(), &fieldErr) {
+		t.Fatalf("expected *FieldError, got %v", res.Err())
+	}
+	if n := len(res.Err().(interface{ Unwrap() []error }).Unwrap()); n != 3 {
+		t.Fatalf("expected %v, got %v", 3, n)
+	}
+}
+
+func TestMapInto_RequiresStructPointer(t *testing.T) {
+	if err := reflect.MapInto(Filter{}, nil, "query"); !errors.Is(err, reflect.ErrNotStructPointer) {
+		t.Fatalf("expected %v, got %v", reflect.ErrNotStructPointer, err)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect. implements provides Implements, a type assertion to an interface that explains its
+// failures: which methods are missing, which have the wrong signature, and which exist only on the pointer.
+//
+// Example - Traditional vs Implements:
+//
+//	// Traditional Go
+//	h, ok := plugin.(Handler)
+//	if !ok {
+//	    return errors.New("plugin does not implement Handler") // but why?
+//	}
+//
+//	// With Implements
+//	h := reflect.Implements[Handler](plugin).BubbleUp()
+//	// Err: reflect: plugin.Echo does not implement app.Handler:
+//	//   missing method Close
+//	//   method Serve has signature func(string) error, want func(context.Context, string) error
+package reflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// ImplementsError describes why a value's type does not implement an interface.
+type ImplementsError struct {
+	Type      reflect.Type // the dynamic type of the value, nil for a nil interface value
+	Interface reflect.Type
+	Problems  []string // one line per missing or mismatched method, sorted by method name
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrNotImplemented is wrapped by every *ImplementsError.
+var ErrNotImplemented = errors.New("reflect: interface not implemented")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Implements asserts v to T. When the assertion fails and T is an interface, the Err is an *ImplementsError
+// listing every method of T that v's type is missing or declares with a different signature, and noting
+// methods that are only declared on the pointer type. When T is not an interface, the Err reports the type
+// mismatch. Either way errors.Is(err, ErrNotImplemented) holds.
+//
+// When to use:
+//   - When loading plugins, handlers or test doubles whose conformance is only known at runtime
+//   - When a failed assertion deserves a better message than "does not implement"
+func Implements[T any](v any) result.Result[T] {
+	if t, ok := v.(T); ok {
+		return result.Ok(t)
+	}
+	return result.Err[T](diagnose(reflect.TypeOf(v), reflect.TypeFor[T]()))
+}
+
+// -------------------------------------------- ImplementsError Methods --------------------------------------------
+
+// Error lists the problems, one per line, after a summary line.
+func (e *ImplementsError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "reflect: %v does not implement %v", typeName(e.Type), e.Interface)
+	if len(e.Problems) > 0 {
+		b.WriteString(":\n  ")
+		b.WriteString(strings.Join(e.Problems, "\n  "))
+	}
+	return b.String()
+}
+
+// Unwrap returns ErrNotImplemented.
+func (e *ImplementsError) Unwrap() error {
+	return ErrNotImplemented
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// diagnose explains why concrete does not implement or convert to iface.
+func diagnose(concrete, iface reflect.Type) *ImplementsError {
+	e := &ImplementsError{Type: concrete, Interface: iface}
+	if concrete == nil || iface.Kind() != reflect.Interface {
+		return e
+	}
+	for i := range iface.NumMethod() {
+		want := iface.Method(i)
+		if !want.IsExported() {
+			e.Problems = append(e.Problems, fmt.Sprintf("missing unexported method %s (only implementable in %s)", want.Name, want.PkgPath))
+			continue
+		}
+		got, ok := methodType(concrete, want.Name)
+		switch {
+		case !ok && concrete.Kind() != reflect.Pointer && hasMethod(reflect.PointerTo(concrete), want.Name):
+			e.Problems = append(e.Problems, fmt.Sprintf("method %s has a pointer receiver; use *%v", want.Name, concrete))
+		case !ok:
+			e.Problems = append(e.Problems, "missing method "+want.Name)
+		case got != want.Type:
+			e.Problems = append(e.Problems, fmt.Sprintf("method %s has signature %v, want %v", want.Name, got, want.Type))
+		}
+	}
+	return e
+}
+
+// methodType returns the type of t's method name without its receiver, as an interface method declares it.
+func methodType(t reflect.Type, name string) (reflect.Type, bool) {
+	if t.Kind() == reflect.Interface {
+		m, ok := t.MethodByName(name)
+		return m.Type, ok
+	}
+	m, ok := t.MethodByName(name)
+	if !ok {
+		return nil, false
+	}
+	in := make([]reflect.Type, 0, m.Type.NumIn()-1)
+	for i := 1; i < m.Type.NumIn(); i++ {
+		in = append(in, m.Type.In(i))
+	}
+	out := make([]reflect.Type, m.Type.NumOut())
+	for i := range out {
+		out[i] = m.Type.Out(i)
+	}
+	return reflect.FuncOf(in, out, m.Type.IsVariadic()), true
+}
+
+// hasMethod reports whether t has a method called name.
+func hasMethod(t reflect.Type, name string) bool {
+	_, ok := t.MethodByName(name)
+	return ok
+}
+
+// typeName formats t for error messages, naming a nil interface value explicitly.
+func typeName(t reflect.Type) string {
+	if t == nil {
+		return "nil"
+	}
+	return t.String()
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect_test. implements_test verifies the diagnostics of failed interface assertions.
+package reflect_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/reflect"
+)
+
+type Handler interface {
+	Serve(ctx context.Context, name string) error
+	Close() error
+	Name() string
+}
+
+type goodHandler struct{}
+
+func (goodHandler) Serve(context.Context, string) error { return nil }
+func (goodHandler) Close() error                        { return nil }
+func (goodHandler) Name() string                        { return "good" }
+
+type badHandler struct{}
+
+func (badHandler) Serve(string) error { return nil }
+func (*badHandler) Name() string      { return "bad" }
+
+func TestImplements(t *testing.T) {
+	if h := reflect.Implements[Handler](goodHandler{}); h.IsErr() || h.Unwrap().Name() != "good" {
+		t.Fatalf("expected Ok, got %v", h.Err())
+	}
+
+	err := reflect.Implements[Handler](badHandler{}).Err()
+	var implErr *reflect.ImplementsError
+	if !errors.As(err, &implErr) || !errors.Is(err, reflect.ErrNotImplemented) {
+		t.Fatalf("expected *ImplementsError, got %v", err)
+	}
+	want := []string{
+		"missing method Close",
+		"method Name has a pointer receiver; use *reflect_test.badHandler",
+		"method Serve has signature func(string) error, want func(context.Context, string) error",
+	}
+	if strings.Join(implErr.Problems, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("expected %q, got %q", want, implErr.Problems)
+	}
+}
+
+func TestImplements_NonInterface(t *testing.T) {
+	err := reflect.Implements[int]("x").Err()
+	if !errors.Is(err, reflect.ErrNotImplemented) || err.Error() != "reflect: string does not implement int" {
+		t.Fatalf("expected type mismatch, got %v", err)
+	}
+	if err := reflect.Implements[Handler](nil).Err(); err == nil || !strings.Contains(err.Error(), "nil does not implement") {
+		t.Fatalf("expected nil value error, got %v", err)
+	}
+}
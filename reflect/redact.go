@@ -0,0 +1,147 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect. redact provides Redact, which scrubs fields tagged `redact:"mask"` or `redact:"drop"` from
+// a value before it is logged or serialized, so PII and secrets stay out of logs without per-type code.
+//
+// Example:
+//
+//	type Customer struct {
+//	    ID       int
+//	    Email    string `redact:"mask"`
+//	    Password string `redact:"drop"`
+//	    Address  Address // scrubbed recursively
+//	}
+//	safe := reflect.DeepCopy(customer)
+//	reflect.Redact(&safe)
+//	slog.Info("customer updated", "customer", safe) // Email: "****", Password: ""
+package reflect
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// redactor walks a value graph, remembering visited pointers so cycles terminate.
+type redactor struct {
+	seen map[uintptr]bool
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// RedactTag is the struct tag key read by Redact.
+const RedactTag = "redact"
+
+// Mask replaces non-empty string values of fields tagged `redact:"mask"`.
+const Mask = "****"
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Redact scrubs the value v points to in place:
+//   - `redact:"drop"` fields are set to their zero value
+//   - `redact:"mask"` string fields (and non-nil *string fields) are set to Mask, so presence is still visible;
+//     mask on any other type behaves like drop
+//   - untagged structs, pointers, slices, arrays, maps and interfaces are walked recursively
+//
+// Unexported fields are left untouched, except that the exported fields promoted from an embedded unexported
+// type are scrubbed like any other, since encoding/json serializes them. Redact mutates memory reachable from v, including memory shared with
+// other values through pointers, slices and maps; redact a DeepCopy when the original must stay intact.
+// A nil v is a no-op.
+func Redact[T any](v *T) {
+	if v == nil {
+		return
+	}
+	r := redactor{seen: make(map[uintptr]bool)}
+	r.walk(reflect.ValueOf(v))
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// walk redacts the tagged fields reachable from v. v must be settable for changes to take effect.
+func (r *redactor) walk(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() || r.seen[v.Pointer()] {
+			return
+		}
+		r.seen[v.Pointer()] = true
+		r.walk(v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		elem := v.Elem()
+		if elem.Kind() == reflect.Pointer {
+			r.walk(elem)
+			return
+		}
+		if v.CanSet() {
+			copied := reflect.New(elem.Type()).Elem()
+			copied.Set(elem)
+			r.walk(copied)
+			v.Set(copied)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			field := v.Field(i)
+			if !field.CanSet() {
+				if !v.Type().Field(i).Anonymous || !field.CanAddr() {
+					continue
+				}
+				// Embedded unexported types are read-only through reflection, yet encoding/json and fmt still
+				// print their promoted exported fields, so reach them through an unrestricted alias.
+				field = reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+			}
+			switch v.Type().Field(i).Tag.Get(RedactTag) {
+			case "drop":
+				field.SetZero()
+			case "mask":
+				mask(field)
+			default:
+				r.walk(field)
+			}
+		}
+	case reflect.Slice:
+		if v.Len() > 0 && r.seen[v.Pointer()] {
+			return
+		}
+		if v.Len() > 0 {
+			r.seen[v.Pointer()] = true
+		}
+		fallthrough
+	case reflect.Array:
+		for i := range v.Len() {
+			r.walk(v.Index(i))
+		}
+	case reflect.Map:
+		if v.IsNil() || r.seen[v.Pointer()] {
+			return
+		}
+		r.seen[v.Pointer()] = true
+		iter := v.MapRange()
+		for iter.Next() {
+			copied := reflect.New(iter.Value().Type()).Elem()
+			copied.Set(iter.Value())
+			r.walk(copied)
+			v.SetMapIndex(iter.Key(), copied)
+		}
+	}
+}
+
+// mask applies `redact:"mask"` to field.
+func mask(field reflect.Value) {
+	switch {
+	case field.Kind() == reflect.String:
+		if field.Len() > 0 {
+			field.SetString(Mask)
+		}
+	case field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.String && !field.IsNil():
+		masked := reflect.New(field.Type().Elem())
+		masked.Elem().SetString(Mask)
+		field.Set(masked)
+	default:
+		field.SetZero()
+	}
+}
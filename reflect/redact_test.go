@@ -0,0 +1,85 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect_test. redact_test verifies masking, dropping and recursive redaction.
+package reflect_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/reflect"
+)
+
+type Card struct {
+	Number string `redact:"mask"`
+	CVV    int    `redact:"mask"`
+}
+
+type Customer struct {
+	Name     string
+	Email    string  `redact:"mask"`
+	Phone    *string `redact:"mask"`
+	Password string  `redact:"drop"`
+	Cards    []Card
+	Primary  *Card
+	Meta     map[string]any
+	Self     *Customer
+}
+
+type secrets struct {
+	Password string `json:"password" redact:"drop"`
+	Token    string `json:"token" redact:"mask"`
+	salt     string
+}
+
+type Login struct {
+	secrets
+	Name string `json:"name"`
+}
+
+func TestRedact(t *testing.T) {
+	phone := "+98 912"
+	customer := Customer{
+		Name:     "ali",
+		Email:    "ali@example.com",
+		Phone:    &phone,
+		Password: "hunter2",
+		Cards:    []Card{{Number: "4111", CVV: 123}},
+		Primary:  &Card{Number: "5500", CVV: 456},
+		Meta:     map[string]any{"card": Card{Number: "3400"}, "note": "vip"},
+	}
+	customer.Self = &customer
+
+	reflect.Redact(&customer)
+
+	if customer.Name != "ali" || customer.Email != reflect.Mask || customer.Password != "" {
+		t.Fatalf("expected masked email and dropped password, got %+v", customer)
+	}
+	if *customer.Phone != reflect.Mask || phone != "+98 912" {
+		t.Fatalf("expected a fresh masked phone, got %v (original %v)", *customer.Phone, phone)
+	}
+	if customer.Cards[0] != (Card{Number: reflect.Mask}) || *customer.Primary != (Card{Number: reflect.Mask}) {
+		t.Fatalf("expected nested cards masked, got %+v and %+v", customer.Cards[0], *customer.Primary)
+	}
+	if customer.Meta["card"] != (Card{Number: reflect.Mask}) || customer.Meta["note"] != "vip" {
+		t.Fatalf("expected map values redacted, got %v", customer.Meta)
+	}
+
+	reflect.Redact[Customer](nil)
+}
+
+func TestRedact_UnexportedEmbedded(t *testing.T) {
+	account := Login{secrets: secrets{Password: "hunter2", Token: "abc", salt: "pepper"}, Name: "ali"}
+
+	reflect.Redact(&account)
+
+	if account.Password != "" || account.Token != reflect.Mask || account.salt != "pepper" || account.Name != "ali" {
+		t.Fatalf("expected promoted fields redacted and unexported ones kept, got %+v", account)
+	}
+	data, err := json.Marshal(account)
+	if err != nil || strings.Contains(string(data), "hunter2") {
+		t.Fatalf("expected no password in %s, got error %v", data, err)
+	}
+}
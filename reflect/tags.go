@@ -0,0 +1,59 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect. tags provides struct tag lookups shared by the tag-driven mappers (database columns,
+// request binding, fixtures), so every mapper resolves names, embedded structs and "-" the same way.
+package reflect
+
+import (
+	"reflect"
+	"strings"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// FieldsByTag maps the names given by the tag key on the exported fields of struct type t to their index
+// paths, for use with reflect.Value.FieldByIndex. Only the part of the tag before the first comma is used
+// as the name. Fields tagged "-" or untagged are skipped, except untagged embedded structs, whose fields
+// are included as if declared on t. When a name appears twice, the shallower field wins.
+//
+// When to use:
+//   - When mapping external names (columns, parameters) onto struct fields
+//   - When the same mapping is applied to many values and should be computed once
+//
+// Example:
+//
+//	type User struct {
+//	    ID    int    `db:"id"`
+//	    Email string `db:"email,unique"`
+//	}
+//	fields := reflect.FieldsByTag(stdreflect.TypeFor[User](), "db")
+//	// map[email:[1] id:[0]]
+func FieldsByTag(t reflect.Type, key string) map[string][]int {
+	fields := make(map[string][]int)
+	depths := make(map[string]int)
+	var walk func(t reflect.Type, path []int)
+	walk = func(t reflect.Type, path []int) {
+		for i := range t.NumField() {
+			sf := t.Field(i)
+			if !sf.IsExported() && !sf.Anonymous {
+				continue
+			}
+			index := append(append([]int(nil), path...), i)
+			tag, tagged := sf.Tag.Lookup(key)
+			name, _, _ := strings.Cut(tag, ",")
+			if !tagged && sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				walk(sf.Type, index)
+				continue
+			}
+			if !tagged || name == "-" || name == "" || !sf.IsExported() {
+				continue
+			}
+			if depth, seen := depths[name]; !seen || len(index) < depth {
+				fields[name], depths[name] = index, len(index)
+			}
+		}
+	}
+	walk(t, nil)
+	return fields
+}
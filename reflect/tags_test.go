@@ -0,0 +1,41 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package reflect_test. tags_test verifies tag name resolution, embedding and shadowing.
+package reflect_test
+
+import (
+	stdreflect "reflect"
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/reflect"
+)
+
+type Base struct {
+	ID      int    `db:"id"`
+	Created string `db:"created_at"`
+}
+
+type Account struct {
+	Base
+	ID       string `db:"id,pk"` // shadows Base.ID
+	Email    string `db:"email"`
+	Password string `db:"-"`
+	Note     string
+	secret   string `db:"secret"`
+}
+
+func TestFieldsByTag(t *testing.T) {
+	fields := reflect.FieldsByTag(stdreflect.TypeFor[Account](), "db")
+
+	want := map[string][]int{"id": {1}, "created_at": {0, 1}, "email": {2}}
+	if len(fields) != len(want) {
+		t.Fatalf("expected %v, got %v", want, fields)
+	}
+	for name, index := range want {
+		if !slices.Equal(fields[name], index) {
+			t.Fatalf("expected %v for %q, got %v", index, name, fields[name])
+		}
+	}
+}
@@ -0,0 +1,407 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package bubbleup. bubbleup provides an analyzer that reports BubbleUp calls (and the chain package's Bubble)
+// in functions that do not defer result.Catch (or CatchErr, CatchWith, Fallback, OnErrDefer). Such a BubbleUp
+// turns every Err into a panic that escapes to the caller, which is the most dangerous way to misuse the
+// result package.
+//
+// Functions that re-raise on purpose, like LazyResult.BubbleUp, are declared bubblers: any function or method
+// named BubbleUp or Bubble, and any function or function literal marked with a //goxide:bubbles directive on
+// the line above it. Their bodies are not reported, and calls to them are checked like calls to BubbleUp:
+//
+//	//goxide:bubbles
+//	handler := func(ctx context.Context, req any) (any, error) {
+//	    return find(req).BubbleUp(), nil // recovered by the interceptor under test
+//	}
+//
+// The analyzer runs under go vet through the goxidevet command:
+//
+//	go install github.com/seyedali-dev/goxide/cmd/goxidevet@latest
+//	go vet -vettool=$(which goxidevet) ./...
+package bubbleup
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// bubbler marks a function declared to panic with BubbleUp errors, so calls to it from other packages are
+// checked like calls to BubbleUp.
+type bubbler struct{}
+
+// AFact implements analysis.Fact.
+func (*bubbler) AFact() {}
+
+func (*bubbler) String() string { return "bubbler" }
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// modulePath is the import path of the goxide module.
+const modulePath = "github.com/seyedali-dev/goxide"
+
+// resultPath is the import path of the result package.
+const resultPath = modulePath + "/rusty/result"
+
+// chainPath is the import path of the chain package.
+const chainPath = modulePath + "/rusty/chain"
+
+// directive marks a function or function literal as a declared bubbler.
+const directive = "//goxide:bubbles"
+
+// bubblers maps package paths to the name of their methods that panic with a BubbleUp error.
+var bubblers = map[string]string{resultPath: "BubbleUp", chainPath: "Bubble"}
+
+// bubblerNames are the function names that declare a bubbler without a directive.
+var bubblerNames = map[string]bool{"BubbleUp": true, "Bubble": true}
+
+// catchers are the result functions that, when deferred, recover BubbleUp panics.
+var catchers = map[string]bool{"Catch": true, "CatchErr": true, "CatchWith": true, "Fallback": true, "OnErrDefer": true}
+
+// recoverers are the goxide functions and methods, by package path, that call a function argument under
+// result.Catch (directly or through CatchInto or SafeGo), so a literal passed to them may BubbleUp.
+var recoverers = map[string]map[string]bool{
+	resultPath:                         {"CatchInto": true, "SafeGo": true, "CatchWith": true},
+	modulePath + "/rusty/cache":        {"GetOrLoad": true},
+	modulePath + "/rusty/convert":      {"RegisterFromString": true, "RegisterConverter": true},
+	modulePath + "/rusty/dbx":          {"WithTx": true},
+	modulePath + "/rusty/events":       {"Subscribe": true},
+	modulePath + "/rusty/funcx":        {"Debounce": true},
+	modulePath + "/rusty/pipeline":     {"Add": true},
+	modulePath + "/rusty/schedule":     {"Every": true, "On": true},
+	modulePath + "/rusty/statemachine": {"On": true, "OnExit": true, "OnEnter": true},
+	modulePath + "/rusty/syncx":        {"Async": true, "Do": true},
+}
+
+// Analyzer reports BubbleUp calls that no deferred result.Catch will recover.
+//
+// A BubbleUp is accepted when its function defers one of the catchers or is a declared bubbler, or when it
+// sits in a function literal that is passed inline to one of the recoverers (such as result.CatchInto,
+// dbx.WithTx or a CatchWith handler) or that runs synchronously inside a function which defers a catcher.
+// A literal runs synchronously when it is called where it is written, or when it is stored in a local
+// variable that is only ever called or passed to the recoverers. A literal passed to any other call
+// (errgroup.Go, a helper that starts a goroutine) or started with go, directly or through a variable, never
+// inherits the enclosing Catch, since its panics may not reach it.
+var Analyzer = &analysis.Analyzer{
+	Name:      "bubbleup",
+	Doc:       "report BubbleUp calls in functions that do not defer result.Catch",
+	URL:       "https://pkg.go.dev/github.com/seyedali-dev/goxide/rusty/analysis/bubbleup",
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	Run:       run,
+	FactTypes: []analysis.Fact{new(bubbler)},
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// run checks every function declaration and literal in the package.
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	marked := directiveLines(pass)
+	locals := localBubblers(pass, marked)
+
+	// Export the declared bubblers first, so calls to them are recognized wherever they appear.
+	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		decl := n.(*ast.FuncDecl)
+		if fn, ok := pass.TypesInfo.Defs[decl.Name].(*types.Func); ok && declaredBubbler(pass, n, marked) {
+			pass.ExportObjectFact(fn, new(bubbler))
+		}
+	})
+
+	filter := []ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}
+	insp.WithStack(filter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		body, name := funcBody(n)
+		if body == nil || declaredBubbler(pass, n, marked) {
+			return true
+		}
+		calls := bubbleUps(pass, body, locals)
+		if len(calls) == 0 || defersCatch(pass, body) {
+			return true
+		}
+		if lit, ok := n.(*ast.FuncLit); ok && (passedToRecoverer(pass, lit, stack) || enclosedByCatch(pass, lit, stack)) {
+			return true
+		}
+		for _, call := range calls {
+			pass.Reportf(call.Pos(), "%s in %s without a deferred result.Catch: an Err will panic into the caller",
+				calleeName(pass, call), name)
+		}
+		return true
+	})
+	return nil, nil
+}
+
+// directiveLines returns the positions (file and line) of every //goxide:bubbles directive in the package.
+func directiveLines(pass *analysis.Pass) map[token.Position]bool {
+	marked := make(map[token.Position]bool)
+	for _, file := range pass.Files {
+		for _, group := range file.Comments {
+			for _, c := range group.List {
+				if strings.TrimSpace(c.Text) == directive {
+					pos := pass.Fset.Position(c.Slash)
+					marked[token.Position{Filename: pos.Filename, Line: pos.Line}] = true
+				}
+			}
+		}
+	}
+	return marked
+}
+
+// declaredBubbler reports whether n is named BubbleUp or Bubble, or is marked by a directive on the line
+// above it (for declarations, anywhere in the doc comment).
+func declaredBubbler(pass *analysis.Pass, n ast.Node, marked map[token.Position]bool) bool {
+	if decl, ok := n.(*ast.FuncDecl); ok {
+		if bubblerNames[decl.Name.Name] {
+			return true
+		}
+		if decl.Doc != nil {
+			for _, c := range decl.Doc.List {
+				if strings.TrimSpace(c.Text) == directive {
+					return true
+				}
+			}
+		}
+	}
+	pos := pass.Fset.Position(n.Pos())
+	return marked[token.Position{Filename: pos.Filename, Line: pos.Line - 1}]
+}
+
+// localBubblers returns the local variables holding a function literal marked by a directive, so calls
+// through them are checked like calls to BubbleUp.
+func localBubblers(pass *analysis.Pass, marked map[token.Position]bool) map[types.Object]bool {
+	locals := make(map[types.Object]bool)
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.FuncLit); ok && declaredBubbler(pass, lit, marked) {
+				if obj := assignedTo(pass, file, lit); obj != nil {
+					locals[obj] = true
+				}
+			}
+			return true
+		})
+	}
+	return locals
+}
+
+// funcBody returns the body of a function declaration or literal and a name to report it by.
+func funcBody(n ast.Node) (*ast.BlockStmt, string) {
+	switch fn := n.(type) {
+	case *ast.FuncDecl:
+		return fn.Body, fn.Name.Name
+	case *ast.FuncLit:
+		return fn.Body, "function literal"
+	}
+	return nil, ""
+}
+
+// bubbleUps returns the BubbleUp and Bubble calls made directly in body, excluding nested function literals.
+func bubbleUps(pass *analysis.Pass, body *ast.BlockStmt, locals map[types.Object]bool) []*ast.CallExpr {
+	var calls []*ast.CallExpr
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			if isBubbleCall(pass, n, locals) {
+				calls = append(calls, n)
+			}
+		}
+		return true
+	})
+	return calls
+}
+
+// defersCatch reports whether body directly defers one of the catchers.
+func defersCatch(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.DeferStmt:
+			if fn := resultFunc(pass, n.Call); fn != nil && catchers[fn.Name()] {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// isBubbleCall reports whether call calls BubbleUp, chain's Bubble or a declared bubbler, including a local
+// variable holding a marked function literal.
+func isBubbleCall(pass *analysis.Pass, call *ast.CallExpr, locals map[types.Object]bool) bool {
+	if id, ok := ast.Unparen(call.Fun).(*ast.Ident); ok && locals[pass.TypesInfo.Uses[id]] {
+		return true
+	}
+	fn := callee(pass, call)
+	if fn == nil {
+		return false
+	}
+	if bubblers[fn.Pkg().Path()] == fn.Name() {
+		return true
+	}
+	return pass.ImportObjectFact(fn.Origin(), new(bubbler))
+}
+
+// passedToRecoverer reports whether lit is passed directly as an argument to one of the recoverers.
+func passedToRecoverer(pass *analysis.Pass, lit *ast.FuncLit, stack []ast.Node) bool {
+	call, ok := parent(stack).(*ast.CallExpr)
+	return ok && recoverer(pass, call) && slices.Contains(call.Args, ast.Expr(lit))
+}
+
+// enclosedByCatch reports whether lit runs synchronously inside an enclosing function that defers a catcher,
+// so lit's panics reach that catcher: lit is called where it is written, or stored in a local variable that
+// is only called or passed to the recoverers. An enclosing literal that itself runs synchronously under a
+// catcher passes it on.
+func enclosedByCatch(pass *analysis.Pass, lit *ast.FuncLit, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		body, _ := funcBody(stack[i])
+		if body == nil {
+			continue
+		}
+		if !synchronous(pass, lit, body, stack) {
+			return false
+		}
+		if defersCatch(pass, body) {
+			return true
+		}
+		outer, ok := stack[i].(*ast.FuncLit)
+		if !ok {
+			return false
+		}
+		lit, stack = outer, stack[:i+1]
+	}
+	return false
+}
+
+// synchronous reports whether lit, the top of stack, runs before body returns: it is called where it is
+// written without go, or assigned to a local variable of body that is only called or passed to the
+// recoverers.
+func synchronous(pass *analysis.Pass, lit *ast.FuncLit, body *ast.BlockStmt, stack []ast.Node) bool {
+	if call, ok := parent(stack).(*ast.CallExpr); ok && ast.Unparen(call.Fun) == lit {
+		_, started := stack[len(stack)-3].(*ast.GoStmt)
+		return !started
+	}
+	obj := assignedTo(pass, body, lit)
+	return obj != nil && onlyCalled(pass, body, obj)
+}
+
+// assignedTo returns the local variable that lit is assigned to within root, or nil.
+func assignedTo(pass *analysis.Pass, root ast.Node, lit *ast.FuncLit) types.Object {
+	var obj types.Object
+	ast.Inspect(root, func(n ast.Node) bool {
+		var lhs []*ast.Ident
+		var rhs []ast.Expr
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, expr := range n.Lhs {
+				id, _ := expr.(*ast.Ident)
+				lhs = append(lhs, id)
+			}
+			rhs = n.Rhs
+		case *ast.ValueSpec:
+			lhs, rhs = n.Names, n.Values
+		}
+		for i, expr := range rhs {
+			if ast.Unparen(expr) == lit && i < len(lhs) && lhs[i] != nil {
+				obj = pass.TypesInfo.ObjectOf(lhs[i])
+			}
+		}
+		return obj == nil
+	})
+	if _, ok := obj.(*types.Var); !ok {
+		return nil
+	}
+	return obj
+}
+
+// onlyCalled reports whether every use of obj within body is a call that is not started with go, or an
+// argument to one of the recoverers.
+func onlyCalled(pass *analysis.Pass, body *ast.BlockStmt, obj types.Object) bool {
+	safe := make(map[*ast.Ident]bool)
+	started := make(map[*ast.CallExpr]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GoStmt:
+			started[n.Call] = true
+		case *ast.AssignStmt:
+			for _, expr := range n.Lhs {
+				if id, ok := expr.(*ast.Ident); ok {
+					safe[id] = true
+				}
+			}
+		case *ast.CallExpr:
+			if id, ok := ast.Unparen(n.Fun).(*ast.Ident); ok && !started[n] {
+				safe[id] = true
+			}
+			if recoverer(pass, n) {
+				for _, arg := range n.Args {
+					if id, ok := ast.Unparen(arg).(*ast.Ident); ok {
+						safe[id] = true
+					}
+				}
+			}
+		}
+		return true
+	})
+	for id, used := range pass.TypesInfo.Uses {
+		if used == obj && id.Pos() >= body.Pos() && id.End() <= body.End() && !safe[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// recoverer reports whether call calls one of the recoverers.
+func recoverer(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := callee(pass, call)
+	return fn != nil && recoverers[fn.Pkg().Path()][fn.Name()]
+}
+
+// parent returns the node enclosing the top of stack, or nil.
+func parent(stack []ast.Node) ast.Node {
+	if len(stack) < 2 {
+		return nil
+	}
+	return stack[len(stack)-2]
+}
+
+// resultFunc returns the function or method of the result package called by call, or nil.
+func resultFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	if fn := callee(pass, call); fn != nil && fn.Pkg().Path() == resultPath {
+		return fn
+	}
+	return nil
+}
+
+// calleeName returns the name of the function or variable called by call.
+func calleeName(pass *analysis.Pass, call *ast.CallExpr) string {
+	if fn := callee(pass, call); fn != nil {
+		return fn.Name()
+	}
+	if id, ok := ast.Unparen(call.Fun).(*ast.Ident); ok {
+		return id.Name
+	}
+	return types.ExprString(call.Fun)
+}
+
+// callee returns the package-level function or method called by call, or nil.
+func callee(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return nil
+	}
+	return fn
+}
@@ -0,0 +1,16 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package bubbleup_test. bubbleup_test runs the analyzer over the cases in testdata/src/a.
+package bubbleup_test
+
+import (
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/analysis/bubbleup"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), bubbleup.Analyzer, "a")
+}
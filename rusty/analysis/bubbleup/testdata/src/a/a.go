@@ -0,0 +1,154 @@
+package a
+
+import (
+	"net/http"
+	"sync"
+
+	"b"
+
+	"github.com/seyedali-dev/goxide/rusty/chain"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+func find() result.Result[int] { return result.Ok(1) }
+
+func run(fn func() result.Result[int]) result.Result[int] { return fn() }
+
+func caught() (res result.Result[int]) {
+	defer result.Catch(&res)
+	return result.Ok(find().BubbleUp())
+}
+
+func caughtErr() (n int, err error) {
+	defer result.CatchErr(&n, &err)
+	return find().BubbleUp(), nil
+}
+
+func uncaught() result.Result[int] {
+	return result.Ok(find().BubbleUp()) // want `BubbleUp in uncaught without a deferred result.Catch`
+}
+
+func catchInNestedFunc() (res result.Result[int]) {
+	defer func() { result.Catch(&res) }() // recover only works in the deferred function itself
+	return result.Ok(find().BubbleUp())   // want `BubbleUp in catchInNestedFunc without a deferred result.Catch`
+}
+
+func argument() result.Result[int] {
+	return run(func() result.Result[int] { return result.Ok(find().BubbleUp()) }) // want `BubbleUp in function literal without a deferred result.Catch`
+}
+
+func handlerFunc() {
+	http.HandleFunc("/", func(http.ResponseWriter, *http.Request) {
+		find().BubbleUp() // want `BubbleUp in function literal without a deferred result.Catch`
+	})
+}
+
+func recovered() result.Result[int] {
+	<-result.SafeGo(func() result.Result[int] { return result.Ok(find().BubbleUp()) })
+	return result.CatchInto(func() int { return find().BubbleUp() })
+}
+
+func handler() (res result.Result[int]) {
+	defer result.Catch(&res)
+	defer result.CatchWith(&res, func(error) int { return find().BubbleUp() })
+	return find()
+}
+
+func synchronousLiteral() (res result.Result[int]) {
+	defer result.Catch(&res)
+	get := func() int { return find().BubbleUp() }
+	return result.Ok(get())
+}
+
+func goroutine() (res result.Result[int]) {
+	defer result.Catch(&res)
+	go func() {
+		find().BubbleUp() // want `BubbleUp in function literal without a deferred result.Catch`
+	}()
+	return find()
+}
+
+func nestedSynchronousLiteral() (res result.Result[int]) {
+	defer result.Catch(&res)
+	func() {
+		func() { find().BubbleUp() }()
+	}()
+	return find()
+}
+
+func storedAndRecovered() (res result.Result[int]) {
+	defer result.Catch(&res)
+	get := func() int { return find().BubbleUp() }
+	return result.CatchInto(get)
+}
+
+func waitGroup() (res result.Result[int]) {
+	defer result.Catch(&res)
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		find().BubbleUp() // want `BubbleUp in function literal without a deferred result.Catch`
+	})
+	wg.Wait()
+	return find()
+}
+
+func spawn(fn func()) { go fn() }
+
+func spawnHelper() (res result.Result[int]) {
+	defer result.Catch(&res)
+	spawn(func() {
+		find().BubbleUp() // want `BubbleUp in function literal without a deferred result.Catch`
+	})
+	return find()
+}
+
+func storedGoroutine() (res result.Result[int]) {
+	defer result.Catch(&res)
+	f := func() {
+		find().BubbleUp() // want `BubbleUp in function literal without a deferred result.Catch`
+	}
+	go f()
+	return find()
+}
+
+func storedLiteral() func() int {
+	return func() int { return find().BubbleUp() } // want `BubbleUp in function literal without a deferred result.Catch`
+}
+
+func chainCaught() (res result.Result[int]) {
+	defer result.Catch(&res)
+	return result.Ok(chain.Chain[int](find()).Bubble())
+}
+
+func chainUncaught() int {
+	return chain.Chain[int](find()).Bubble() // want `Bubble in chainUncaught without a deferred result.Catch`
+}
+
+type lazy struct{ r result.Result[int] }
+
+func (l lazy) BubbleUp() int { return l.r.BubbleUp() } // want BubbleUp:"bubbler"
+
+//goxide:bubbles
+func mustFind() int { return find().BubbleUp() } // want mustFind:"bubbler"
+
+func declaredBubblers() int {
+	//goxide:bubbles
+	handle := func() int { return find().BubbleUp() }
+	return handle() // want `handle in declaredBubblers without a deferred result.Catch`
+}
+
+func declaredBubblersCaught() (res result.Result[int]) {
+	defer result.Catch(&res)
+	//goxide:bubbles
+	handle := func() int { return find().BubbleUp() }
+	return result.Ok(handle())
+}
+
+func callsBubblers() int {
+	return mustFind() + b.Must() // want `mustFind in callsBubblers without a deferred result.Catch` `Must in callsBubblers without a deferred result.Catch`
+}
+
+func callsBubblersCaught() (res result.Result[int]) {
+	defer result.Catch(&res)
+	return result.Ok(mustFind() + b.Must())
+}
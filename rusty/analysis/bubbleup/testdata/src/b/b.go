@@ -0,0 +1,8 @@
+package b
+
+import "github.com/seyedali-dev/goxide/rusty/result"
+
+// Must returns 1 or bubbles up.
+//
+//goxide:bubbles
+func Must() int { return result.Ok(1).BubbleUp() }
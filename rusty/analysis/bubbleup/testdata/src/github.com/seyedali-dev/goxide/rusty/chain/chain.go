@@ -0,0 +1,14 @@
+// Package chain is a minimal stand-in for the real chain package, for analyzer tests.
+package chain
+
+import "github.com/seyedali-dev/goxide/rusty/result"
+
+type ApplyToResult[Out, In any] struct {
+	result result.Result[In]
+}
+
+func Chain[Out, T any](r result.Result[T]) *ApplyToResult[Out, T] {
+	return &ApplyToResult[Out, T]{result: r}
+}
+
+func (a *ApplyToResult[Out, In]) Bubble() In { return a.result.BubbleUp() }
@@ -0,0 +1,25 @@
+// Package result is a minimal stand-in for the real result package, for analyzer tests.
+package result
+
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+func Ok[T any](v T) Result[T] { return Result[T]{value: v} }
+
+func (r Result[T]) BubbleUp() T {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+func CatchInto[T any](fn func() T) Result[T]             { return Ok(fn()) }
+func SafeGo[T any](fn func() Result[T]) <-chan Result[T] { return nil }
+
+func Catch[T any](res *Result[T])                                     {}
+func CatchErr[T any](out *T, err *error)                              {}
+func CatchWith[T any](res *Result[T], h func(error) T, when ...error) {}
+func Fallback[T any](res *Result[T], fallback T, when ...error)       {}
+func OnErrDefer[T any](res *Result[T], fn func(error))                {}
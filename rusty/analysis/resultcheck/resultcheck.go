@@ -0,0 +1,90 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package resultcheck. resultcheck provides an analyzer that reports calls whose Result is silently dropped,
+// the Result equivalent of errcheck: an Err that nobody looks at is an error that never happened.
+//
+// Example - Flagged and accepted forms:
+//
+//	repo.Save(ctx, user)       // flagged: the Result is discarded
+//	_ = repo.Save(ctx, user)   // accepted: discarded on purpose
+//	repo.Save(ctx, user).Unwrap()
+//
+// The analyzer runs under go vet through the goxidevet command:
+//
+//	go vet -vettool=$(which goxidevet) ./...
+package resultcheck
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// resultPath is the import path of the result package.
+const resultPath = "github.com/seyedali-dev/goxide/rusty/result"
+
+// Analyzer reports expression statements, go statements and defer statements whose call returns a
+// result.Result that is never used. Assigning the Result to _ marks the discard as intentional.
+var Analyzer = &analysis.Analyzer{
+	Name:     "resultcheck",
+	Doc:      "report calls whose result.Result return value is discarded",
+	URL:      "https://pkg.go.dev/github.com/seyedali-dev/goxide/rusty/analysis/resultcheck",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// run checks every statement that evaluates a call only for its side effects.
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	filter := []ast.Node{(*ast.ExprStmt)(nil), (*ast.GoStmt)(nil), (*ast.DeferStmt)(nil)}
+
+	insp.Preorder(filter, func(n ast.Node) {
+		var call *ast.CallExpr
+		switch stmt := n.(type) {
+		case *ast.ExprStmt:
+			call, _ = ast.Unparen(stmt.X).(*ast.CallExpr)
+		case *ast.GoStmt:
+			call = stmt.Call
+		case *ast.DeferStmt:
+			call = stmt.Call
+		}
+		if call == nil || !isResult(pass.TypesInfo.TypeOf(call)) {
+			return
+		}
+		pass.Reportf(call.Pos(), "Result of %s is not used; handle it or assign it to _", callName(call))
+	})
+	return nil, nil
+}
+
+// isResult reports whether t is an instantiation of result.Result.
+func isResult(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Origin().Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == resultPath && obj.Name() == "Result"
+}
+
+// callName returns a short name for the function called by call, for diagnostics.
+func callName(call *ast.CallExpr) string {
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	case *ast.IndexExpr:
+		return callName(&ast.CallExpr{Fun: fun.X})
+	case *ast.IndexListExpr:
+		return callName(&ast.CallExpr{Fun: fun.X})
+	}
+	return "call"
+}
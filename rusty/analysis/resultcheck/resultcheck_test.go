@@ -0,0 +1,16 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package resultcheck_test. resultcheck_test runs the analyzer over the cases in testdata/src/a.
+package resultcheck_test
+
+import (
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/analysis/resultcheck"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), resultcheck.Analyzer, "a")
+}
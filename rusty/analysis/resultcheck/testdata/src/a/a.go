@@ -0,0 +1,32 @@
+package a
+
+import "github.com/seyedali-dev/goxide/rusty/result"
+
+type Alias = result.Result[int]
+
+type repo struct{}
+
+func (repo) Save(id int) result.Result[int] { return result.Ok(id) }
+
+func load() Alias { return result.Ok(1) }
+
+func get[T any](v T) result.Result[T] { return result.Ok(v) }
+
+func log() int { return 0 }
+
+func discarded(r repo) {
+	r.Save(1)                                            // want `Result of Save is not used`
+	(load())                                             // want `Result of load is not used`
+	get[string]("x")                                     // want `Result of get is not used`
+	r.Save(2).MapError(func(e error) error { return e }) // want `Result of MapError is not used`
+	go r.Save(3)                                         // want `Result of Save is not used`
+	defer r.Save(4)                                      // want `Result of Save is not used`
+}
+
+func used(r repo) int {
+	_ = r.Save(1)
+	res := load()
+	log()
+	func() {}()
+	return res.Unwrap() + r.Save(2).Unwrap()
+}
@@ -0,0 +1,13 @@
+// Package result is a minimal stand-in for the real result package, for analyzer tests.
+package result
+
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+func Ok[T any](v T) Result[T] { return Result[T]{value: v} }
+
+func (r Result[T]) Unwrap() T { return r.value }
+
+func (r Result[T]) MapError(fn func(error) error) Result[T] { return r }
@@ -0,0 +1,206 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package batch. batch splits a slice into fixed-size chunks and runs a Result-returning function per chunk,
+// sequentially or in parallel, for bulk database writes and API calls with request-size limits.
+//
+// Example - Traditional vs batch.Process:
+//
+//	// Traditional Go
+//	var ids []int64
+//	for start := 0; start < len(users); start += 500 {
+//	    end := min(start+500, len(users))
+//	    inserted, err := repo.InsertMany(ctx, users[start:end])
+//	    if err != nil {
+//	        return nil, fmt.Errorf("batch %d: %w", start/500, err)
+//	    }
+//	    ids = append(ids, inserted...)
+//	}
+//
+//	// With batch.Process
+//	res := batch.Process(users, 500, func(chunk []User) result.Result[[]int64] {
+//	    return repo.InsertMany(ctx, chunk)
+//	}, batch.Parallel(4))
+package batch
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Error reports the failure of one batch: its position and the item range [Start, End) it covered.
+type Error struct {
+	Batch      int
+	Start, End int
+	Err        error
+}
+
+// Option configures Process and ProcessEach.
+type Option func(*config)
+
+// config holds the settings of a single run.
+type config struct {
+	parallel        int
+	collectFailures bool
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Parallel runs up to n batches concurrently (default: 1, sequential).
+func Parallel(n int) Option {
+	return func(c *config) {
+		c.parallel = max(n, 1)
+	}
+}
+
+// CollectFailures makes Process run every batch even after a failure and report all failed batches,
+// instead of stopping at the first one.
+func CollectFailures() Option {
+	return func(c *config) {
+		c.collectFailures = true
+	}
+}
+
+// Process runs fn on consecutive chunks of up to size items and returns the per-batch values in order.
+// By default it stops at the first failed batch (batches not yet started are skipped) and returns that
+// *Error. With CollectFailures, every batch runs and the Err joins one *Error per failed batch;
+// use FailuresOf to inspect them.
+//
+// When to use:
+//   - Bulk inserts/updates limited by statement or parameter counts
+//   - Remote APIs that accept at most N items per request
+//
+// Example - Partial failures:
+//
+//	res := batch.Process(events, 100, publishBatch, batch.Parallel(8), batch.CollectFailures())
+//	for _, f := range batch.FailuresOf(res.Err()) {
+//	    log.Printf("events[%d:%d] failed: %v", f.Start, f.End, f.Err)
+//	}
+func Process[T, R any](items []T, size int, fn func([]T) result.Result[R], opts ...Option) result.Result[[]R] {
+	cfg := config{parallel: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	results := run(items, size, fn, cfg)
+	values := make([]R, 0, len(results))
+	var failures []error
+	for _, res := range results {
+		if res.IsErr() {
+			failures = append(failures, res.Err())
+			continue
+		}
+		values = append(values, res.Unwrap())
+	}
+
+	switch {
+	case len(failures) == 0:
+		return result.Ok(values)
+	case cfg.collectFailures:
+		return result.Err[[]R](errors.Join(failures...))
+	default:
+		return result.Err[[]R](failures[0])
+	}
+}
+
+// ProcessEach is Process returning one Result per batch, in order, so successful batches can be used
+// even when others failed. Batches skipped after a failure (without CollectFailures) are omitted.
+func ProcessEach[T, R any](items []T, size int, fn func([]T) result.Result[R], opts ...Option) []result.Result[R] {
+	cfg := config{parallel: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return run(items, size, fn, cfg)
+}
+
+// FailuresOf returns every *Error carried by err, which may be a single *Error or a join of them.
+func FailuresOf(err error) []*Error {
+	var out []*Error
+	var walk func(error)
+	walk = func(err error) {
+		var be *Error
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				walk(e)
+			}
+		} else if errors.As(err, &be) {
+			out = append(out, be)
+		}
+	}
+	if err != nil {
+		walk(err)
+	}
+	return out
+}
+
+// -------------------------------------------- Error Methods --------------------------------------------
+
+// Error describes the failed batch and its cause.
+func (e *Error) Error() string {
+	return fmt.Sprintf("batch %d (items %d-%d): %v", e.Batch, e.Start, e.End-1, e.Err)
+}
+
+// Unwrap returns the cause.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// run executes fn over every chunk with the configured parallelism, returning the Results of the
+// batches that ran, in batch order.
+func run[T, R any](items []T, size int, fn func([]T) result.Result[R], cfg config) []result.Result[R] {
+	size = max(size, 1)
+	count := (len(items) + size - 1) / size
+	results := make([]result.Result[R], count)
+	ran := make([]bool, count)
+
+	var failed atomic.Bool
+	call := func(i int) {
+		if failed.Load() && !cfg.collectFailures {
+			return
+		}
+		start, end := i*size, min((i+1)*size, len(items))
+		res := fn(items[start:end:end])
+		if res.IsErr() {
+			failed.Store(true)
+			res = result.Err[R](&Error{Batch: i, Start: start, End: end, Err: res.Err()})
+		}
+		results[i], ran[i] = res, true
+	}
+
+	if cfg.parallel == 1 {
+		for i := range count {
+			call(i)
+		}
+	} else {
+		next := make(chan int)
+		var wg sync.WaitGroup
+		for range min(cfg.parallel, count) {
+			wg.Go(func() {
+				for i := range next {
+					call(i)
+				}
+			})
+		}
+		for i := range count {
+			next <- i
+		}
+		close(next)
+		wg.Wait()
+	}
+
+	out := results[:0]
+	for i, res := range results {
+		if ran[i] {
+			out = append(out, res)
+		}
+	}
+	return out
+}
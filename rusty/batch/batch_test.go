@@ -0,0 +1,76 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package batch_test. batch_test verifies chunking, ordering, early stop and failure collection.
+package batch_test
+
+import (
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/batch"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+var ErrRejected = errors.New("batch rejected")
+
+// sum adds a chunk, rejecting chunks that contain a negative number.
+func sum(chunk []int) result.Result[int] {
+	total := 0
+	for _, n := range chunk {
+		if n < 0 {
+			return result.Err[int](ErrRejected)
+		}
+		total += n
+	}
+	return result.Ok(total)
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestProcess_ChunksInOrder(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	for _, opts := range [][]batch.Option{nil, {batch.Parallel(3)}} {
+		got := batch.Process(items, 3, sum, opts...).Unwrap()
+		if want := []int{6, 15, 7}; !slices.Equal(got, want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestProcess_StopsAtFirstFailure(t *testing.T) {
+	var calls atomic.Int32
+	counting := func(chunk []int) result.Result[int] {
+		calls.Add(1)
+		return sum(chunk)
+	}
+
+	res := batch.Process([]int{1, 2, -3, 4, 5, 6}, 2, counting)
+
+	var be *batch.Error
+	if !errors.As(res.Err(), &be) || be.Batch != 1 || be.Start != 2 || !errors.Is(res.Err(), ErrRejected) {
+		t.Fatalf("unexpected error: %v", res.Err())
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("expected %v calls, got %v", 2, calls.Load())
+	}
+}
+
+func TestProcess_CollectFailures(t *testing.T) {
+	items := []int{-1, 2, 3, 4, -5, 6}
+	res := batch.Process(items, 2, sum, batch.Parallel(2), batch.CollectFailures())
+
+	failures := batch.FailuresOf(res.Err())
+	if len(failures) != 2 || failures[0].Batch != 0 || failures[1].Batch != 2 {
+		t.Fatalf("unexpected failures: %v", res.Err())
+	}
+
+	each := batch.ProcessEach(items, 2, sum, batch.CollectFailures())
+	if len(each) != 3 || each[1].Unwrap() != 7 {
+		t.Fatalf("unexpected per-batch results: %v", each)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package benchx provides paired benchmarks comparing a traditional (T, error) implementation with its
+// Result-based equivalent, so the cost of adopting Result on a hot path can be measured rather than guessed.
+//
+// Example - Side-by-side sub-benchmarks under go test -bench:
+//
+//	func BenchmarkLoadUser(b *testing.B) {
+//	    benchx.Bench(b, "LoadUser",
+//	        func() (User, error) { return repo.LoadUser(id) },
+//	        func() result.Result[User] { return rrepo.LoadUser(id) },
+//	    )
+//	}
+//
+// Example - A comparison report from a plain program:
+//
+//	c := benchx.Compare("LoadUser", loadUser, loadUserResult)
+//	benchx.WriteReport(os.Stdout, c)
+//
+//	// name      traditional ns/op  result ns/op  delta   traditional allocs/op  result allocs/op  delta
+//	// LoadUser  41.20              43.05         +4.49%  1                      1                 +0
+package benchx
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Comparison holds the measurements of one traditional/Result pair.
+type Comparison struct {
+	Name        string
+	Traditional testing.BenchmarkResult
+	Result      testing.BenchmarkResult
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Bench runs traditional and rusty as the sub-benchmarks name/traditional and name/result of b,
+// reporting allocations for both. Use it inside a Benchmark function so go test -bench, benchstat
+// and friends see the pair.
+func Bench[T any](b *testing.B, name string, traditional func() (T, error), rusty func() result.Result[T]) {
+	b.Run(name+"/traditional", traditionalBench(traditional))
+	b.Run(name+"/result", resultBench(rusty))
+}
+
+// Compare measures traditional and rusty with testing.Benchmark and returns their Comparison.
+// Unlike Bench it needs no *testing.B, so it suits example programs and ad-hoc measurement scripts.
+func Compare[T any](name string, traditional func() (T, error), rusty func() result.Result[T]) Comparison {
+	return Comparison{
+		Name:        name,
+		Traditional: testing.Benchmark(traditionalBench(traditional)),
+		Result:      testing.Benchmark(resultBench(rusty)),
+	}
+}
+
+// WriteReport writes cs to w as an aligned table of ns/op and allocs/op with the Result-minus-traditional deltas.
+func WriteReport(w io.Writer, cs ...Comparison) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "name\ttraditional ns/op\tresult ns/op\tdelta\ttraditional allocs/op\tresult allocs/op\tdelta")
+	for _, c := range cs {
+		fmt.Fprintf(tw, "%s\t%.2f\t%.2f\t%+.2f%%\t%d\t%d\t%+d\n",
+			c.Name,
+			nsPerOp(c.Traditional), nsPerOp(c.Result), c.NsDelta(),
+			c.Traditional.AllocsPerOp(), c.Result.AllocsPerOp(), c.AllocsDelta(),
+		)
+	}
+	return tw.Flush()
+}
+
+// -------------------------------------------- Comparison Methods --------------------------------------------
+
+// NsDelta returns how much slower (positive) or faster (negative) the Result version is, as a percentage
+// of the traditional ns/op. Returns 0 if the traditional run took no measurable time.
+func (c Comparison) NsDelta() float64 {
+	base := nsPerOp(c.Traditional)
+	if base == 0 {
+		return 0
+	}
+	return (nsPerOp(c.Result) - base) / base * 100
+}
+
+// AllocsDelta returns the Result version's allocs/op minus the traditional version's.
+func (c Comparison) AllocsDelta() int64 {
+	return c.Result.AllocsPerOp() - c.Traditional.AllocsPerOp()
+}
+
+// BytesDelta returns the Result version's B/op minus the traditional version's.
+func (c Comparison) BytesDelta() int64 {
+	return c.Result.AllocedBytesPerOp() - c.Traditional.AllocedBytesPerOp()
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// traditionalBench wraps fn as a benchmark body. b.Loop keeps the call from being optimized away.
+func traditionalBench[T any](fn func() (T, error)) func(*testing.B) {
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_, _ = fn()
+		}
+	}
+}
+
+// resultBench wraps fn as a benchmark body that checks the Result the way a caller would.
+func resultBench[T any](fn func() result.Result[T]) func(*testing.B) {
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if res := fn(); res.IsOk() {
+				_ = res.Unwrap()
+			}
+		}
+	}
+}
+
+// nsPerOp returns the precise ns/op of r; BenchmarkResult.NsPerOp truncates to whole nanoseconds.
+func nsPerOp(r testing.BenchmarkResult) float64 {
+	if r.N == 0 {
+		return 0
+	}
+	return float64(r.T.Nanoseconds()) / float64(r.N)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package benchx_test. benchx_test verifies paired measurement and the comparison report.
+package benchx_test
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/benchx"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+func parse(s string) (int, error) { return strconv.Atoi(s) }
+
+func parseResult(s string) result.Result[int] { return result.Wrap(strconv.Atoi(s)) }
+
+func TestCompare(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs two real benchmarks")
+	}
+
+	c := benchx.Compare("Atoi",
+		func() (int, error) { return parse("42") },
+		func() result.Result[int] { return parseResult("42") },
+	)
+	if c.Traditional.N == 0 || c.Result.N == 0 {
+		t.Fatalf("expected both sides to run, got %d and %d", c.Traditional.N, c.Result.N)
+	}
+
+	var buf bytes.Buffer
+	if err := benchx.WriteReport(&buf, c); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[1], "Atoi") {
+		t.Fatalf("expected header and one Atoi row, got %q", buf.String())
+	}
+}
+
+func TestComparison_Deltas(t *testing.T) {
+	c := benchx.Comparison{
+		Traditional: testing.BenchmarkResult{N: 10, T: 100, MemAllocs: 10, MemBytes: 80},
+		Result:      testing.BenchmarkResult{N: 10, T: 150, MemAllocs: 20, MemBytes: 240},
+	}
+	if got := c.NsDelta(); got != 50 {
+		t.Fatalf("expected %v, got %v", 50, got)
+	}
+	if got := c.AllocsDelta(); got != 1 {
+		t.Fatalf("expected %v, got %v", 1, got)
+	}
+	if got := c.BytesDelta(); got != 16 {
+		t.Fatalf("expected %v, got %v", 16, got)
+	}
+	if got := (benchx.Comparison{}).NsDelta(); got != 0 {
+		t.Fatalf("expected %v, got %v", 0, got)
+	}
+}
+
+func BenchmarkAtoi(b *testing.B) {
+	benchx.Bench(b, "Valid",
+		func() (int, error) { return parse("42") },
+		func() result.Result[int] { return parseResult("42") },
+	)
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package cache. cache provides an in-memory, concurrency-safe cache with per-entry TTL and a size bound,
+// exposing lookups as Option and read-through loads as Result.
+//
+// Example - Traditional vs Cache:
+//
+//	// Traditional Go
+//	mu.Lock()
+//	user, ok := users[id]
+//	mu.Unlock()
+//	if !ok {
+//	    user, err = repo.FindUser(id)
+//	    if err != nil {
+//	        return User{}, err
+//	    }
+//	    mu.Lock()
+//	    users[id] = user
+//	    mu.Unlock()
+//	}
+//
+//	// With Cache
+//	users := cache.New[int, User](cache.WithTTL(5*time.Minute), cache.WithMaxSize(10_000))
+//	res := users.GetOrLoad(id, func() result.Result[User] {
+//	    return repo.FindUser(id)
+//	})
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/clock"
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/syncx"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Cache [K, V] maps keys to values that expire after a TTL. When the size bound is reached,
+// expired entries are dropped first, then the oldest inserted entry is evicted.
+// A Cache is safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	cfg     config
+	entries map[K]*list.Element
+	order   *list.List // of *entry[K, V], oldest first
+	loads   syncx.Singleflight[K, V]
+}
+
+// Option configures a Cache.
+type Option func(*config)
+
+// config holds the settings shared by every Cache instantiation.
+type config struct {
+	ttl     time.Duration
+	maxSize int
+	now     func() time.Time
+}
+
+// entry is a cached value with its expiry (zero means it never expires).
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithTTL sets the default time-to-live of entries (default: no expiry).
+func WithTTL(ttl time.Duration) Option {
+	return func(c *config) {
+		c.ttl = ttl
+	}
+}
+
+// WithMaxSize bounds the number of entries (default: unbounded).
+func WithMaxSize(n int) Option {
+	return func(c *config) {
+		c.maxSize = n
+	}
+}
+
+// WithClock sets the clock entries expire by (default: clock.System), e.g. a clocktest.FakeClock to
+// test expiry without sleeping.
+func WithClock(clk clock.Clock) Option {
+	return func(c *config) {
+		c.now = clk.Now
+	}
+}
+
+// New creates an empty Cache.
+func New[K comparable, V any](opts ...Option) *Cache[K, V] {
+	cfg := config{now: clock.System.Now}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Cache[K, V]{cfg: cfg, entries: make(map[K]*list.Element), order: list.New()}
+}
+
+// Get returns the value for key, or None if it is missing or expired.
+func (c *Cache[K, V]) Get(key K) option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lookup(key)
+}
+
+// Set stores value under key with the cache's default TTL.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.cfg.ttl)
+}
+
+// SetWithTTL stores value under key, expiring after ttl. A ttl of 0 means the entry never expires.
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.store(key, value, ttl)
+}
+
+// GetOrLoad returns the cached value for key, or calls loader and caches its Ok value.
+// Err results are returned as-is and not cached, so the next call retries the load.
+// The loader runs without holding the cache lock; concurrent misses on the same key share a single load.
+//
+// When to use:
+//   - Read-through caching in front of a database or remote API
+//   - As the cache layer of the CatchWith fallback patterns in the examples package
+//
+// Example:
+//
+//	func (s *UserService) Find(id int) result.Result[User] {
+//	    return s.cache.GetOrLoad(id, func() result.Result[User] {
+//	        return s.repo.FindUser(id)
+//	    })
+//	}
+func (c *Cache[K, V]) GetOrLoad(key K, loader func() result.Result[V]) result.Result[V] {
+	if cached := c.Get(key); cached.IsSome() {
+		return result.Ok(cached.Unwrap())
+	}
+
+	return c.loads.Do(key, func() result.Result[V] {
+		res := loader()
+		if res.IsOk() {
+			c.Set(key, res.Unwrap())
+		}
+		return res
+	})
+}
+
+// Delete removes key, reporting whether a live entry was present.
+func (c *Cache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	present := c.lookup(key).IsSome()
+	c.remove(key)
+	return present
+}
+
+// Len returns the number of live (unexpired) entries.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purgeExpired()
+	return c.order.Len()
+}
+
+// Clear removes every entry.
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.order.Init()
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// lookup returns the live value for key, dropping it if expired. Callers must hold mu.
+func (c *Cache[K, V]) lookup(key K) option.Option[V] {
+	elem, ok := c.entries[key]
+	if !ok {
+		return option.None[V]()
+	}
+	e := elem.Value.(*entry[K, V])
+	if c.expired(e) {
+		c.remove(key)
+		return option.None[V]()
+	}
+	return option.Some(e.value)
+}
+
+// store inserts or replaces key, evicting entries if the size bound is reached. Callers must hold mu.
+func (c *Cache[K, V]) store(key K, value V, ttl time.Duration) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.cfg.now().Add(ttl)
+	}
+
+	c.remove(key)
+	if c.cfg.maxSize > 0 && c.order.Len() >= c.cfg.maxSize {
+		c.purgeExpired()
+	}
+	for c.cfg.maxSize > 0 && c.order.Len() >= c.cfg.maxSize {
+		c.remove(c.order.Front().Value.(*entry[K, V]).key)
+	}
+	c.entries[key] = c.order.PushBack(&entry[K, V]{key: key, value: value, expires: expires})
+}
+
+// remove deletes key if present. Callers must hold mu.
+func (c *Cache[K, V]) remove(key K) {
+	if elem, ok := c.entries[key]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+}
+
+// purgeExpired drops every expired entry. Callers must hold mu.
+func (c *Cache[K, V]) purgeExpired() {
+	for elem := c.order.Front(); elem != nil; {
+		next := elem.Next()
+		if e := elem.Value.(*entry[K, V]); c.expired(e) {
+			c.remove(e.key)
+		}
+		elem = next
+	}
+}
+
+// expired reports whether e has passed its expiry.
+func (c *Cache[K, V]) expired(e *entry[K, V]) bool {
+	return !e.expires.IsZero() && !c.cfg.now().Before(e.expires)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package cache_test. cache_test verifies lookups, expiry, eviction and read-through loading.
+package cache_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/cache"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+var ErrLoadFailed = errors.New("load failed")
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestCache_GetSet(t *testing.T) {
+	c := cache.New[string, int]()
+	if c.Get("a").IsSome() {
+		t.Fatal("expected None for missing key")
+	}
+
+	c.Set("a", 1)
+	if got := c.Get("a").Unwrap(); got != 1 {
+		t.Fatalf("expected %v, got %v", 1, got)
+	}
+	if !c.Delete("a") || c.Get("a").IsSome() {
+		t.Fatal("expected key to be deleted")
+	}
+}
+
+func TestCache_TTL(t *testing.T) {
+	c := cache.New[string, int](cache.WithTTL(time.Hour))
+	c.SetWithTTL("short", 1, 5*time.Millisecond)
+	c.Set("long", 2)
+
+	time.Sleep(20 * time.Millisecond)
+
+	if c.Get("short").IsSome() {
+		t.Fatal("expected short-lived entry to expire")
+	}
+	if c.Get("long").IsNone() || c.Len() != 1 {
+		t.Fatalf("expected long-lived entry to remain, len %d", c.Len())
+	}
+}
+
+func TestCache_MaxSizeEvictsOldest(t *testing.T) {
+	c := cache.New[int, string](cache.WithMaxSize(2))
+	c.Set(1, "one")
+	c.Set(2, "two")
+	c.Set(3, "three")
+
+	if c.Get(1).IsSome() {
+		t.Fatal("expected oldest entry to be evicted")
+	}
+	if c.Len() != 2 || c.Get(3).IsNone() {
+		t.Fatalf("expected newest entries to remain, len %d", c.Len())
+	}
+}
+
+func TestCache_GetOrLoad(t *testing.T) {
+	c := cache.New[int, string]()
+	calls := 0
+	loader := func() result.Result[string] {
+		calls++
+		return result.Ok("loaded")
+	}
+
+	if first := c.GetOrLoad(1, loader); first.Unwrap() != "loaded" {
+		t.Fatalf("expected %v, got %v", "loaded", first)
+	}
+	res := c.GetOrLoad(1, loader)
+	if res.Unwrap() != "loaded" || calls != 1 {
+		t.Fatalf("expected single load, got %d calls", calls)
+	}
+
+	failed := c.GetOrLoad(2, func() result.Result[string] { return result.Err[string](ErrLoadFailed) })
+	if !errors.Is(failed.Err(), ErrLoadFailed) || c.Get(2).IsSome() {
+		t.Fatal("expected failed load to be returned and not cached")
+	}
+}
+
+func TestCache_GetOrLoadSharesConcurrentMisses(t *testing.T) {
+	c := cache.New[int, string]()
+	var calls atomic.Int32
+	entered, release := make(chan struct{}), make(chan struct{})
+	loader := func() result.Result[string] {
+		if calls.Add(1) == 1 {
+			close(entered)
+		}
+		<-release
+		return result.Ok("loaded")
+	}
+
+	var wg sync.WaitGroup
+	load := func() {
+		if res := c.GetOrLoad(1, loader); res.IsErr() || res.Unwrap() != "loaded" {
+			t.Errorf("expected %v, got %v", "loaded", res)
+		}
+	}
+	wg.Go(load)
+	<-entered
+	for range 5 {
+		wg.Go(load)
+	}
+	time.Sleep(20 * time.Millisecond) // let the other misses join the load in flight
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected %v load, got %v", 1, calls.Load())
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package cache. lru provides LRU[K, V], a concurrency-safe cache bounded by total entry cost that evicts the
+// least recently used entries, for working sets without a natural expiry such as memoized computations.
+//
+// Example - Memoizing an expensive render:
+//
+//	thumbs := cache.NewLRU[string, []byte](64<<20, // 64 MiB
+//	    cache.WithCost(func(_ string, img []byte) int { return len(img) }),
+//	    cache.OnEvict(func(path string, _ []byte) { metrics.Evictions.Inc() }),
+//	)
+//
+//	img := thumbs.GetOrLoad(path, func() result.Result[[]byte] {
+//	    return render(path)
+//	})
+package cache
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/syncx"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// LRU [K, V] maps keys to values, evicting the least recently used entries once the total cost of its
+// entries would exceed its capacity. Each entry costs 1 unless WithCost is given. An LRU is safe for
+// concurrent use.
+type LRU[K comparable, V any] struct {
+	mu       sync.Mutex
+	cfg      lruConfig[K, V]
+	capacity int
+	used     int
+	entries  map[K]*list.Element
+	order    *list.List // of *lruEntry[K, V], most recently used first
+	loads    syncx.Singleflight[K, V]
+}
+
+// LRUOption [K, V] configures an LRU.
+type LRUOption[K comparable, V any] func(*lruConfig[K, V])
+
+// lruConfig holds the settings applied by LRUOption.
+type lruConfig[K comparable, V any] struct {
+	cost    func(K, V) int
+	onEvict func(K, V)
+}
+
+// lruEntry is a cached value with the cost it was charged when stored.
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+	cost  int
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithCost sets the cost charged for an entry against the LRU's capacity (default: 1 per entry), e.g. its
+// size in bytes. Negative costs are treated as 0.
+func WithCost[K comparable, V any](fn func(K, V) int) LRUOption[K, V] {
+	return func(c *lruConfig[K, V]) {
+		c.cost = fn
+	}
+}
+
+// OnEvict registers a callback run for every entry evicted to make room, and for the old value of a key
+// overwritten by Set, after the LRU's lock is released. Entries removed by Delete or Clear are not reported.
+func OnEvict[K comparable, V any](fn func(K, V)) LRUOption[K, V] {
+	return func(c *lruConfig[K, V]) {
+		c.onEvict = fn
+	}
+}
+
+// NewLRU creates an empty LRU holding entries up to a total cost of capacity. capacity below 1 is treated as 1.
+func NewLRU[K comparable, V any](capacity int, opts ...LRUOption[K, V]) *LRU[K, V] {
+	cfg := lruConfig[K, V]{cost: func(K, V) int { return 1 }}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &LRU[K, V]{cfg: cfg, capacity: max(capacity, 1), entries: make(map[K]*list.Element), order: list.New()}
+}
+
+// Get returns the value for key, or None if it is missing, and marks the entry as most recently used.
+func (c *LRU[K, V]) Get(key K) option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return option.None[V]()
+	}
+	c.order.MoveToFront(elem)
+	return option.Some(elem.Value.(*lruEntry[K, V]).value)
+}
+
+// Peek returns the value for key, or None if it is missing, without affecting its recency.
+func (c *LRU[K, V]) Peek(key K) option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return option.None[V]()
+	}
+	return option.Some(elem.Value.(*lruEntry[K, V]).value)
+}
+
+// Set stores value under key as the most recently used entry, evicting least recently used entries until
+// it fits. An entry costing more than the whole capacity is not stored and is reported to OnEvict at once.
+func (c *LRU[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	evicted := c.store(key, value)
+	c.mu.Unlock()
+
+	if c.cfg.onEvict != nil {
+		for _, e := range evicted {
+			c.cfg.onEvict(e.key, e.value)
+		}
+	}
+}
+
+// GetOrLoad returns the cached value for key, or calls loader and caches its Ok value.
+// Err results are returned as-is and not cached. Concurrent misses on the same key share a single load.
+func (c *LRU[K, V]) GetOrLoad(key K, loader func() result.Result[V]) result.Result[V] {
+	if cached := c.Get(key); cached.IsSome() {
+		return result.Ok(cached.Unwrap())
+	}
+	return c.loads.Do(key, func() result.Result[V] {
+		res := loader()
+		if res.IsOk() {
+			c.Set(key, res.Unwrap())
+		}
+		return res
+	})
+}
+
+// Delete removes key, reporting whether it was present.
+func (c *LRU[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.entries[key]
+	c.remove(key)
+	return ok
+}
+
+// Len returns the number of entries.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Cost returns the total cost of the entries, at most the capacity.
+func (c *LRU[K, V]) Cost() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.used
+}
+
+// Clear removes every entry.
+func (c *LRU[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.order.Init()
+	c.used = 0
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// store inserts or replaces key and returns the entries evicted to make room, led by the replaced entry
+// if any. Callers must hold mu.
+func (c *LRU[K, V]) store(key K, value V) []*lruEntry[K, V] {
+	var evicted []*lruEntry[K, V]
+	if elem, ok := c.entries[key]; ok {
+		evicted = append(evicted, elem.Value.(*lruEntry[K, V]))
+		c.remove(key)
+	}
+	e := &lruEntry[K, V]{key: key, value: value, cost: max(c.cfg.cost(key, value), 0)}
+	if e.cost > c.capacity {
+		return append(evicted, e)
+	}
+
+	for c.used+e.cost > c.capacity {
+		oldest := c.order.Back().Value.(*lruEntry[K, V])
+		c.remove(oldest.key)
+		evicted = append(evicted, oldest)
+	}
+	c.entries[key] = c.order.PushFront(e)
+	c.used += e.cost
+	return evicted
+}
+
+// remove deletes key if present. Callers must hold mu.
+func (c *LRU[K, V]) remove(key K) {
+	if elem, ok := c.entries[key]; ok {
+		c.used -= elem.Value.(*lruEntry[K, V]).cost
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+}
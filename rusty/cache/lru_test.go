@@ -0,0 +1,92 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package cache_test. lru_test verifies recency-based eviction, entry costs and eviction callbacks.
+package cache_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/cache"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	var evicted []string
+	c := cache.NewLRU(2, cache.OnEvict(func(k string, _ int) { evicted = append(evicted, k) }))
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a") // b is now the least recently used
+	c.Set("c", 3)
+
+	if c.Get("b").IsSome() || c.Get("a").Unwrap() != 1 || c.Get("c").Unwrap() != 3 {
+		t.Fatal("expected b to be evicted")
+	}
+	c.Peek("a") // does not refresh a
+	c.Set("d", 4)
+	if !slices.Equal(evicted, []string{"b", "a"}) {
+		t.Fatalf("unexpected evictions: %v", evicted)
+	}
+	if !c.Delete("c") || c.Delete("c") || c.Len() != 1 {
+		t.Fatal("expected Delete to remove c once")
+	}
+}
+
+func TestLRU_Cost(t *testing.T) {
+	var evicted []string
+	c := cache.NewLRU(10,
+		cache.WithCost(func(_ string, v []byte) int { return len(v) }),
+		cache.OnEvict(func(k string, _ []byte) { evicted = append(evicted, k) }),
+	)
+
+	c.Set("small", make([]byte, 3))
+	c.Set("medium", make([]byte, 5))
+	c.Set("large", make([]byte, 6))
+	if c.Cost() != 6 || c.Len() != 1 || !slices.Equal(evicted, []string{"small", "medium"}) {
+		t.Fatalf("expected only large to remain, got cost %v after evicting %v", c.Cost(), evicted)
+	}
+
+	c.Set("huge", make([]byte, 11))
+	if c.Get("huge").IsSome() || c.Get("large").IsNone() || evicted[len(evicted)-1] != "huge" {
+		t.Fatal("expected an entry larger than the capacity to be rejected")
+	}
+
+	c.Set("large", make([]byte, 2))
+	if c.Cost() != 2 {
+		t.Fatalf("expected a replaced entry to be recharged, got cost %v", c.Cost())
+	}
+}
+
+func TestLRU_GetOrLoad(t *testing.T) {
+	c := cache.NewLRU[int, string](8)
+	calls := 0
+	loader := func() result.Result[string] {
+		calls++
+		return result.Ok("loaded")
+	}
+
+	if first := c.GetOrLoad(1, loader); first.Unwrap() != "loaded" {
+		t.Fatalf("expected %v, got %v", "loaded", first)
+	}
+	if res := c.GetOrLoad(1, loader); res.Unwrap() != "loaded" || calls != 1 {
+		t.Fatalf("expected single load, got %d calls", calls)
+	}
+	failed := c.GetOrLoad(2, func() result.Result[string] { return result.Err[string](ErrLoadFailed) })
+	if !errors.Is(failed.Err(), ErrLoadFailed) || c.Peek(2).IsSome() {
+		t.Fatal("expected failed load to be returned and not cached")
+	}
+}
+
+func TestLRU_OverwriteReportsOldValue(t *testing.T) {
+	var evicted []int
+	c := cache.NewLRU(2, cache.OnEvict(func(_ string, v int) { evicted = append(evicted, v) }))
+
+	c.Set("a", 1)
+	c.Set("a", 2)
+	if c.Get("a").Unwrap() != 2 || c.Len() != 1 || !slices.Equal(evicted, []int{1}) {
+		t.Fatalf("expected %v to be reported, got %v", []int{1}, evicted)
+	}
+}
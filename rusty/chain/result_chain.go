@@ -29,6 +29,9 @@
 package chain
 
 import (
+	"fmt"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
 	"github.com/seyedali-dev/goxide/rusty/result"
 )
 
@@ -38,6 +41,43 @@ import (
 // It holds a Result[In] and provides methods that transform it to Result[Out].
 type ApplyToResult[Out, In any] struct {
 	result result.Result[In]
+	cfg    config
+}
+
+// StepError reports which named step of a chain failed. It is produced by Step and unwraps to the step's error,
+// so errors.Is still matches the underlying cause.
+//
+// Example:
+//
+//	var stepErr *chain.StepError
+//	if errors.As(res.Err(), &stepErr) {
+//	    metrics.StepFailures.WithLabelValues(stepErr.Step).Inc()
+//	}
+type StepError struct {
+	Step string
+	Err  error
+}
+
+// Option configures a chain started with Chain.
+type Option func(*config)
+
+// config holds the settings applied by Option.
+type config struct {
+	wrap func(error) error
+}
+
+// WrapErrors makes the chain wrap every error it returns with context, as Result.WrapErr(format, args...)
+// would. Unlike a WrapErr call in the middle of the chain, this also covers errors produced by the final
+// Map or AndThen step.
+//
+// Example:
+//
+//	chain.Chain[Receipt](findOrder(id), chain.WrapErrors("checkout order %d", id)).
+//	    AndThen(charge) // Err: "checkout order 7: card declined"
+func WrapErrors(format string, args ...any) Option {
+	return func(c *config) {
+		c.wrap = func(err error) error { return fmt.Errorf(format+": %w", append(args[:len(args):len(args)], err)...) }
+	}
 }
 
 // Chain starts a new chaining pipeline with a Result[In].
@@ -50,8 +90,12 @@ type ApplyToResult[Out, In any] struct {
 //		    Map(func(u User) string { return u.Name }).
 //		    AndThen(validateName).
 //		    Unwrap()
-func Chain[Out, T any](r result.Result[T]) *ApplyToResult[Out, T] {
-	return &ApplyToResult[Out, T]{result: r}
+func Chain[Out, T any](r result.Result[T], opts ...Option) *ApplyToResult[Out, T] {
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &ApplyToResult[Out, T]{result: r, cfg: cfg}
 }
 
 // Map transforms the value inside the Result using fn.
@@ -63,7 +107,7 @@ func Chain[Out, T any](r result.Result[T]) *ApplyToResult[Out, T] {
 //	    Map(func(x int) string { return fmt.Sprintf("value: %d", x) }).
 //	    Unwrap() // Result[string] with "value: 42"
 func (applyToResult *ApplyToResult[Out, In]) Map(fn func(In) Out) result.Result[Out] {
-	return result.Map(applyToResult.result, fn)
+	return finish(result.Map(applyToResult.result, fn), applyToResult.cfg)
 }
 
 // AndThen chains a Result-returning function.
@@ -77,7 +121,23 @@ func (applyToResult *ApplyToResult[Out, In]) Map(fn func(In) Out) result.Result[
 //	    }).
 //	    Unwrap()
 func (applyToResult *ApplyToResult[Out, In]) AndThen(fn func(In) result.Result[Out]) result.Result[Out] {
-	return result.AndThen(applyToResult.result, fn)
+	return finish(result.AndThen(applyToResult.result, fn), applyToResult.cfg)
+}
+
+// AndThenOpt chains an Option-returning function, treating None as a failure with errIfNone.
+// Use it where a pipeline step "may be absent" rather than "may fail", such as a cache or map lookup.
+//
+// Example:
+//
+//	chain.Chain[Profile](findUser(id)).
+//	    AndThenOpt(func(u User) option.Option[Profile] {
+//	        return profiles.Get(u.ID)
+//	    }, ErrProfileNotFound)
+func (applyToResult *ApplyToResult[Out, In]) AndThenOpt(fn func(In) option.Option[Out], errIfNone error) result.Result[Out] {
+	return applyToResult.AndThen(func(in In) result.Result[Out] {
+		var out Out
+		return result.OkIf(fn(in).Some(&out), out, errIfNone)
+	})
 }
 
 // MapError transforms the error if the Result is in error state.
@@ -92,6 +152,39 @@ func (applyToResult *ApplyToResult[Out, In]) AndThen(fn func(In) result.Result[O
 func (applyToResult *ApplyToResult[Out, In]) MapError(fn func(error) error) *ApplyToResult[Out, In] {
 	return &ApplyToResult[Out, In]{
 		result: applyToResult.result.MapError(fn),
+		cfg:    applyToResult.cfg,
+	}
+}
+
+// Step runs fn as a named step that keeps the chain's value type, skipping it if an earlier step failed.
+// If fn fails, its error is wrapped in a *StepError, rendered as `step "name": <err>`, so the failing stage
+// of a multi-step pipeline is visible in logs and available through errors.As.
+//
+// Example:
+//
+//	receipt := chain.Chain[Receipt](parseOrder(body)).
+//	    Step("validate order", validateOrder).
+//	    Step("reserve stock", reserveStock).
+//	    AndThen(charge)
+//	// Err: step "reserve stock": insufficient stock for sku 42
+func (applyToResult *ApplyToResult[Out, In]) Step(name string, fn func(In) result.Result[In]) *ApplyToResult[Out, In] {
+	res := result.AndThen(applyToResult.result, func(in In) result.Result[In] {
+		return fn(in).MapError(func(err error) error { return &StepError{Step: name, Err: err} })
+	})
+	return &ApplyToResult[Out, In]{result: res, cfg: applyToResult.cfg}
+}
+
+// WrapErr adds context to the error if the Result is in error state, as Result.WrapErr does.
+//
+// Example:
+//
+//	chain.Chain[Profile](findUser(id)).
+//	    WrapErr("load profile for user %d", id).
+//	    AndThen(findProfile)
+func (applyToResult *ApplyToResult[Out, In]) WrapErr(format string, args ...any) *ApplyToResult[Out, In] {
+	return &ApplyToResult[Out, In]{
+		result: applyToResult.result.WrapErr(format, args...),
+		cfg:    applyToResult.cfg,
 	}
 }
 
@@ -101,7 +194,7 @@ func (applyToResult *ApplyToResult[Out, In]) Unwrap() result.Result[Out] {
 	// We need to handle the case where Out != In (after transformations)
 	// This is a type-safe way to extract the final result
 	if applyToResult.result.IsErr() {
-		return result.Err[Out](applyToResult.result.Err())
+		return finish(result.Err[Out](applyToResult.result.Err()), applyToResult.cfg)
 	}
 
 	// If we're at the end of a chain where types match, return directly
@@ -113,6 +206,23 @@ func (applyToResult *ApplyToResult[Out, In]) Unwrap() result.Result[Out] {
 	panic("type mismatch in chain unwrap")
 }
 
+// Bubble terminates the chain and returns the current value, or propagates the error to the enclosing
+// result.Catch like Result.BubbleUp, so fluent chains and BubbleUp-style code can mix in one function.
+// Map and AndThen already return a Result; call BubbleUp on that instead.
+//
+// Example:
+//
+//	func Checkout(id int) (res result.Result[Receipt]) {
+//	    defer result.Catch(&res)
+//	    user := chain.Chain[Receipt](findUser(id)).
+//	        WrapErr("checkout for user %d", id).
+//	        Bubble()
+//	    return issueReceipt(user)
+//	}
+func (applyToResult *ApplyToResult[Out, In]) Bubble() In {
+	return finish(applyToResult.result, applyToResult.cfg).BubbleUp()
+}
+
 // OrElse terminates the chain and returns the value or fallback.
 func (applyToResult *ApplyToResult[Out, In]) OrElse(fallback Out) Out {
 	return applyToResult.Unwrap().UnwrapOr(fallback)
@@ -122,3 +232,33 @@ func (applyToResult *ApplyToResult[Out, In]) OrElse(fallback Out) Out {
 func (applyToResult *ApplyToResult[Out, In]) OrElseGet(fn func(error) Out) Out {
 	return applyToResult.Unwrap().UnwrapOrElse(fn)
 }
+
+// Fold terminates chain c by converting its current Result into a non-Result value: onOk receives the value,
+// onErr the error. It is a function rather than a method because Go methods cannot declare the type
+// parameter R.
+//
+// Example - Ending a pipeline in an HTTP status:
+//
+//	status := chain.Fold(
+//	    chain.Chain[Order](parseOrder(body)).Step("validate order", validateOrder),
+//	    func(Order) int { return http.StatusAccepted },
+//	    func(err error) int { return goxerrors.HTTPStatusOf(err) },
+//	)
+func Fold[R, Out, In any](c *ApplyToResult[Out, In], onOk func(In) R, onErr func(error) R) R {
+	return result.If(finish(c.result, c.cfg), onOk, onErr)
+}
+
+// -------------------------------------------- StepError Methods --------------------------------------------
+
+func (e *StepError) Error() string { return fmt.Sprintf("step %q: %v", e.Step, e.Err) }
+func (e *StepError) Unwrap() error { return e.Err }
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// finish applies the chain-wide error wrapping of cfg to a Result leaving the chain.
+func finish[T any](r result.Result[T], cfg config) result.Result[T] {
+	if cfg.wrap == nil {
+		return r
+	}
+	return r.MapError(cfg.wrap)
+}
@@ -37,3 +37,9 @@ func (applyToResult2 ApplyToResult2[Out1, Out2, T]) AndThen(fn func(T) result.Re
 func (applyToResult2 ApplyToResult2[Out1, Out2, T]) Map(fn func(T) Out1) *ApplyToResult[Out2, Out1] {
 	return Chain[Out2](result.Map(applyToResult2.result, fn))
 }
+
+// Bubble terminates the chain and returns the current value, or propagates the error to the enclosing
+// result.Catch like Result.BubbleUp.
+func (applyToResult2 ApplyToResult2[Out1, Out2, T]) Bubble() T {
+	return applyToResult2.result.BubbleUp()
+}
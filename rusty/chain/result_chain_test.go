@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/seyedali-dev/goxide/rusty/chain"
+	"github.com/seyedali-dev/goxide/rusty/option"
 	"github.com/seyedali-dev/goxide/rusty/result"
 )
 
@@ -199,3 +200,97 @@ func TestResultChain_MapError4_TransformsError(t *testing.T) {
 		t.Errorf("expected %q, got %q", expectedMsg, chained.Err().Error())
 	}
 }
+
+func TestResultChain_WrapErr(t *testing.T) {
+	chained := chain.Chain[string](result.Err[int](ErrUserNotFound)).
+		WrapErr("load user %d", 7).
+		Map(intToString)
+	if !errors.Is(chained.Err(), ErrUserNotFound) || chained.Err().Error() != "load user 7: user not found" {
+		t.Fatalf("expected %q, got %v", "load user 7: user not found", chained.Err())
+	}
+}
+
+func TestResultChain_WrapErrors(t *testing.T) {
+	chained := chain.Chain[string](result.Ok(3), chain.WrapErrors("step %s", "parity")).AndThen(failOnOdd)
+	if chained.Err() == nil || chained.Err().Error() != "step parity: odd number not allowed" {
+		t.Fatalf("expected %q, got %v", "step parity: odd number not allowed", chained.Err())
+	}
+
+	ok := chain.Chain[string](result.Ok(2), chain.WrapErrors("step")).AndThen(failOnOdd)
+	if ok.Unwrap() != "even: 2" {
+		t.Fatalf("expected %q, got %q", "even: 2", ok.Unwrap())
+	}
+}
+
+func TestResultChain_Bubble(t *testing.T) {
+	compute := func(r result.Result[int]) (res result.Result[string]) {
+		defer result.Catch(&res)
+		n := chain.Chain[string](r).WrapErr("load").Bubble()
+		return chain.Chain[string](result.Ok(n)).Map(intToString)
+	}
+
+	if got := compute(result.Ok(4)); got.Unwrap() != "num: 4" {
+		t.Fatalf("expected %q, got %q", "num: 4", got.Unwrap())
+	}
+	got := compute(result.Err[int](ErrUserNotFound))
+	if !errors.Is(got.Err(), ErrUserNotFound) || got.Err().Error() != "load: user not found" {
+		t.Fatalf("expected %q, got %v", "load: user not found", got.Err())
+	}
+}
+
+func TestResultChain_Step(t *testing.T) {
+	positive := func(x int) result.Result[int] {
+		return result.OkIf(x > 0, x, ErrInvalidEmail)
+	}
+	even := func(x int) result.Result[int] {
+		return result.OkIf(x%2 == 0, x, ErrUserNotFound)
+	}
+
+	got := chain.Chain[string](result.Ok(3)).Step("positive", positive).Step("even", even).Map(intToString)
+	var stepErr *chain.StepError
+	if !errors.As(got.Err(), &stepErr) || stepErr.Step != "even" || !errors.Is(got.Err(), ErrUserNotFound) {
+		t.Fatalf("expected failure in step %q, got %v", "even", got.Err())
+	}
+	if got.Err().Error() != `step "even": user not found` {
+		t.Fatalf("expected %q, got %q", `step "even": user not found`, got.Err().Error())
+	}
+
+	ok := chain.Chain[string](result.Ok(4)).Step("positive", positive).Step("even", even).Map(intToString)
+	if ok.Unwrap() != "num: 4" {
+		t.Fatalf("expected %q, got %q", "num: 4", ok.Unwrap())
+	}
+}
+
+func TestResultChain_AndThenOpt(t *testing.T) {
+	names := map[int]string{1: "ali"}
+	lookup := func(id int) option.Option[string] {
+		if name, ok := names[id]; ok {
+			return option.Some(name)
+		}
+		return option.None[string]()
+	}
+
+	if got := chain.Chain[string](result.Ok(1)).AndThenOpt(lookup, ErrUserNotFound); got.Unwrap() != "ali" {
+		t.Fatalf("expected %q, got %q", "ali", got.Unwrap())
+	}
+	if got := chain.Chain[string](result.Ok(2)).AndThenOpt(lookup, ErrUserNotFound); !errors.Is(got.Err(), ErrUserNotFound) {
+		t.Fatalf("expected %v, got %v", ErrUserNotFound, got.Err())
+	}
+	if got := chain.Chain[string](result.Err[int](ErrDBConnection)).AndThenOpt(lookup, ErrUserNotFound); !errors.Is(got.Err(), ErrDBConnection) {
+		t.Fatalf("expected %v, got %v", ErrDBConnection, got.Err())
+	}
+}
+
+func TestFold(t *testing.T) {
+	status := func(r result.Result[int]) int {
+		return chain.Fold(chain.Chain[string](r).Step("even", func(x int) result.Result[int] {
+			return result.OkIf(x%2 == 0, x, ErrInvalidEmail)
+		}), func(int) int { return 200 }, func(error) int { return 400 })
+	}
+	if got := status(result.Ok(2)); got != 200 {
+		t.Fatalf("expected %v, got %v", 200, got)
+	}
+	if got := status(result.Ok(3)); got != 400 {
+		t.Fatalf("expected %v, got %v", 400, got)
+	}
+}
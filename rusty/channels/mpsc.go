@@ -0,0 +1,136 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package channels. mpsc provides a multi-producer, single-consumer channel split into Sender and
+// Receiver halves, modeled on Rust's std::sync::mpsc. Sending after Close returns Err(ErrClosed)
+// instead of panicking, which makes producer shutdown safe.
+//
+// Example:
+//
+//	tx, rx := channels.New[Event](64)
+//
+//	// Producers (any number of goroutines)
+//	go func() {
+//	    if res := tx.Send(evt); res.IsErr() {
+//	        log.Printf("dropped event: %v", res.Err()) // ErrClosed after shutdown, never a panic
+//	    }
+//	}()
+//
+//	// Shutdown
+//	tx.Close()
+//
+//	// Consumer
+//	for res := rx.Recv(); res.IsOk(); res = rx.Recv() {
+//	    handle(res.Unwrap())
+//	}
+package channels
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Sender [T] is the sending half of a channel created by New. It is safe for concurrent use
+// by any number of producers.
+type Sender[T any] struct {
+	ch       chan T
+	done     chan struct{}
+	mu       sync.Mutex
+	closed   bool
+	inflight sync.WaitGroup
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrFull is returned by TrySend when the channel buffer is full.
+var ErrFull = errors.New("channel full")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// New creates a channel with the given buffer capacity and returns its Sender and Receiver halves.
+func New[T any](capacity int) (*Sender[T], *Receiver[T]) {
+	ch := make(chan T, capacity)
+	return &Sender[T]{ch: ch, done: make(chan struct{})}, NewReceiver(ch)
+}
+
+// Send blocks until v is delivered to the buffer or the channel is closed.
+// Returns Err(ErrClosed) if the channel is closed before or while waiting.
+func (s *Sender[T]) Send(v T) result.Result[types.Unit] {
+	return s.SendCtx(context.Background(), v)
+}
+
+// SendCtx is Send with cancellation: it returns Err(ctx.Err()) if ctx is done before v is delivered.
+func (s *Sender[T]) SendCtx(ctx context.Context, v T) result.Result[types.Unit] {
+	if !s.acquire() {
+		return result.Err[types.Unit](ErrClosed)
+	}
+	defer s.inflight.Done()
+
+	select {
+	case s.ch <- v:
+		return result.Ok(types.Unit{})
+	case <-s.done:
+		return result.Err[types.Unit](ErrClosed)
+	case <-ctx.Done():
+		return result.Err[types.Unit](ctx.Err())
+	}
+}
+
+// TrySend delivers v only if buffer space is immediately available.
+// Returns Err(ErrFull) when the buffer is full and Err(ErrClosed) when the channel is closed.
+func (s *Sender[T]) TrySend(v T) result.Result[types.Unit] {
+	if !s.acquire() {
+		return result.Err[types.Unit](ErrClosed)
+	}
+	defer s.inflight.Done()
+
+	select {
+	case s.ch <- v:
+		return result.Ok(types.Unit{})
+	default:
+		return result.Err[types.Unit](ErrFull)
+	}
+}
+
+// Close closes the channel. Pending and future sends return Err(ErrClosed); values already buffered
+// remain available to the Receiver. Close is idempotent and safe to call from any producer.
+func (s *Sender[T]) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.done)
+	s.mu.Unlock()
+
+	// Wait for in-flight sends to observe done before closing the data channel.
+	s.inflight.Wait()
+	close(s.ch)
+}
+
+// IsClosed reports whether Close has been called.
+func (s *Sender[T]) IsClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// acquire registers an in-flight send, reporting false if the channel is already closed.
+func (s *Sender[T]) acquire() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.inflight.Add(1)
+	return true
+}
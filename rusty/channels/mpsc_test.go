@@ -0,0 +1,65 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package channels_test. mpsc_test verifies panic-free shutdown of the Sender/Receiver pair.
+package channels_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/channels"
+)
+
+func TestSender_SendAfterClose(t *testing.T) {
+	tx, rx := channels.New[int](2)
+
+	if tx.Send(1).IsErr() {
+		t.Fatal("expected Send on open channel to succeed")
+	}
+	tx.Close()
+	tx.Close() // idempotent
+
+	if res := tx.Send(2); !errors.Is(res.Err(), channels.ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", res.Err())
+	}
+	if got := rx.Recv().Unwrap(); got != 1 {
+		t.Fatalf("expected buffered value 1, got %d", got)
+	}
+	if res := rx.Recv(); !errors.Is(res.Err(), channels.ErrClosed) {
+		t.Fatalf("expected ErrClosed after drain, got %v", res.Err())
+	}
+}
+
+func TestSender_TrySendFull(t *testing.T) {
+	tx, _ := channels.New[int](1)
+
+	if res := tx.TrySend(1); res.IsErr() {
+		t.Fatalf("expected Ok, got %v", res.Err())
+	}
+	if res := tx.TrySend(2); !errors.Is(res.Err(), channels.ErrFull) {
+		t.Fatalf("expected ErrFull, got %v", res.Err())
+	}
+}
+
+func TestSender_CloseUnblocksPendingSends(t *testing.T) {
+	tx, _ := channels.New[int](0)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := range 5 {
+		wg.Go(func() {
+			errs <- tx.Send(i).Err()
+		})
+	}
+	tx.Close()
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if !errors.Is(err, channels.ErrClosed) {
+			t.Fatalf("expected ErrClosed for pending send, got %v", err)
+		}
+	}
+}
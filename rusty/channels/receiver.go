@@ -0,0 +1,131 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package channels. receiver provides Receiver[T], a wrapper over a receive-only channel whose methods
+// make the closed, empty and timed-out states explicit instead of relying on the ambiguous comma-ok.
+//
+// Example - Traditional vs Receiver:
+//
+//	// Traditional Go: was it closed, or did we time out?
+//	select {
+//	case v, ok := <-ch:
+//	    if !ok { /* closed */ }
+//	case <-time.After(time.Second):
+//	    /* timed out */
+//	}
+//
+//	// With Receiver
+//	res := channels.NewReceiver(ch).RecvTimeout(time.Second)
+//	switch {
+//	case errors.Is(res.Err(), channels.ErrClosed):  // producer is done
+//	case errors.Is(res.Err(), channels.ErrTimeout): // nothing arrived in time
+//	}
+package channels
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Receiver [T] is the receiving side of a channel of T.
+type Receiver[T any] struct {
+	ch     <-chan T
+	closed atomic.Bool
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+var (
+	// ErrClosed is returned when receiving from, or sending to, a closed channel.
+	ErrClosed = errors.New("channel closed")
+	// ErrTimeout is returned when no value arrives before the timeout.
+	ErrTimeout = errors.New("channel receive timed out")
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewReceiver wraps ch.
+func NewReceiver[T any](ch <-chan T) *Receiver[T] {
+	return &Receiver[T]{ch: ch}
+}
+
+// Recv blocks until a value arrives, returning Err(ErrClosed) once the channel is closed and drained.
+func (r *Receiver[T]) Recv() result.Result[T] {
+	v, ok := <-r.ch
+	return r.received(v, ok)
+}
+
+// TryRecv returns a value if one is immediately available, otherwise None.
+// None means either empty or closed; use Closed to tell them apart.
+//
+// Example - Draining without blocking:
+//
+//	for v := rx.TryRecv(); v.IsSome(); v = rx.TryRecv() {
+//	    batch = append(batch, v.Unwrap())
+//	}
+func (r *Receiver[T]) TryRecv() option.Option[T] {
+	select {
+	case v, ok := <-r.ch:
+		return r.received(v, ok).Value()
+	default:
+		return option.None[T]()
+	}
+}
+
+// RecvTimeout waits up to d for a value.
+// Returns Err(ErrTimeout) if nothing arrives in time and Err(ErrClosed) if the channel is closed.
+func (r *Receiver[T]) RecvTimeout(d time.Duration) result.Result[T] {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case v, ok := <-r.ch:
+		return r.received(v, ok)
+	case <-timer.C:
+		return result.Err[T](ErrTimeout)
+	}
+}
+
+// RecvCtx waits for a value until ctx is done.
+// Returns Err(ctx.Err()) on cancellation and Err(ErrClosed) if the channel is closed.
+//
+// Example:
+//
+//	for {
+//	    res := jobs.RecvCtx(ctx)
+//	    if res.IsErr() {
+//	        return res.Err() // ErrClosed: clean shutdown; context error: cancelled
+//	    }
+//	    handle(res.Unwrap())
+//	}
+func (r *Receiver[T]) RecvCtx(ctx context.Context) result.Result[T] {
+	select {
+	case v, ok := <-r.ch:
+		return r.received(v, ok)
+	case <-ctx.Done():
+		return result.Err[T](ctx.Err())
+	}
+}
+
+// Closed reports whether a receive on this Receiver has observed the channel as closed.
+func (r *Receiver[T]) Closed() bool {
+	return r.closed.Load()
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// received converts a comma-ok receive into a Result, recording closure.
+func (r *Receiver[T]) received(v T, ok bool) result.Result[T] {
+	if !ok {
+		r.closed.Store(true)
+		return result.Err[T](ErrClosed)
+	}
+	return result.Ok(v)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package channels_test. receiver_test verifies explicit closed/empty/timeout receive states.
+package channels_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/channels"
+)
+
+func TestReceiver_TryRecv(t *testing.T) {
+	ch := make(chan int, 1)
+	rx := channels.NewReceiver(ch)
+
+	if rx.TryRecv().IsSome() || rx.Closed() {
+		t.Fatal("expected None from empty, open channel")
+	}
+	ch <- 5
+	if got := rx.TryRecv().Unwrap(); got != 5 {
+		t.Fatalf("expected 5, got %d", got)
+	}
+	close(ch)
+	if rx.TryRecv().IsSome() || !rx.Closed() {
+		t.Fatal("expected None and Closed after close")
+	}
+}
+
+func TestReceiver_RecvTimeout(t *testing.T) {
+	ch := make(chan string)
+	rx := channels.NewReceiver(ch)
+
+	if res := rx.RecvTimeout(5 * time.Millisecond); !errors.Is(res.Err(), channels.ErrTimeout) {
+		t.Fatalf("expected ErrTimeout, got %v", res.Err())
+	}
+	close(ch)
+	if res := rx.RecvTimeout(time.Second); !errors.Is(res.Err(), channels.ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", res.Err())
+	}
+}
+
+func TestReceiver_RecvCtx(t *testing.T) {
+	ch := make(chan int, 1)
+	rx := channels.NewReceiver(ch)
+
+	ch <- 1
+	if got := rx.RecvCtx(context.Background()).Unwrap(); got != 1 {
+		t.Fatalf("expected 1, got %d", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if res := rx.RecvCtx(ctx); !errors.Is(res.Err(), context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", res.Err())
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package channels. select provides typed multi-channel receives with cancellation, so waiting on
+// channels of different element types needs neither a hand-written select nor reflect.Select.
+package channels
+
+import (
+	"context"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Select2 waits for a value from a or b until ctx is done.
+// The received value comes back as Left (from a) or Right (from b); if both are ready, one is chosen at random.
+// Returns Err(ctx.Err()) on cancellation and Err(ErrClosed) if the channel that became ready is closed.
+//
+// When to use:
+//   - Waiting on two differently typed channels, e.g. results and errors, with a deadline
+//   - Replacing a select whose branches only differ in what they send on
+//
+// Example:
+//
+//	res := channels.Select2(ctx, results, failures)
+//	if res.IsErr() {
+//	    return res.Err()
+//	}
+//	if v, ok := res.Unwrap().Left(); ok {
+//	    handle(v)
+//	}
+func Select2[A, B any](ctx context.Context, a <-chan A, b <-chan B) result.Result[types.Either[A, B]] {
+	select {
+	case v, ok := <-a:
+		return selected(types.Left[A, B](v), ok)
+	case v, ok := <-b:
+		return selected(types.Right[A](v), ok)
+	case <-ctx.Done():
+		return result.Err[types.Either[A, B]](ctx.Err())
+	}
+}
+
+// Select3 waits for a value from a, b or c until ctx is done, reporting which one arrived through OneOf3.
+// Cancellation and closure are reported as in Select2.
+func Select3[A, B, C any](ctx context.Context, a <-chan A, b <-chan B, c <-chan C) result.Result[types.OneOf3[A, B, C]] {
+	select {
+	case v, ok := <-a:
+		return selected(types.OneOfFirst[A, B, C](v), ok)
+	case v, ok := <-b:
+		return selected(types.OneOfSecond[A, B, C](v), ok)
+	case v, ok := <-c:
+		return selected(types.OneOfThird[A, B](v), ok)
+	case <-ctx.Done():
+		return result.Err[types.OneOf3[A, B, C]](ctx.Err())
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// selected converts a comma-ok receive from one select branch into a Result.
+func selected[T any](v T, ok bool) result.Result[T] {
+	if !ok {
+		return result.Err[T](ErrClosed)
+	}
+	return result.Ok(v)
+}
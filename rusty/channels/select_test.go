@@ -0,0 +1,76 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package channels_test. select_test verifies typed multi-channel receives, closure and cancellation.
+package channels_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/channels"
+)
+
+func TestSelect2(t *testing.T) {
+	ints := make(chan int, 1)
+	strs := make(chan string, 1)
+
+	strs <- "hi"
+	res := channels.Select2(context.Background(), ints, strs)
+	if res.IsErr() {
+		t.Fatalf("expected Ok, got %v", res.Err())
+	}
+	e := res.Unwrap()
+	if s, ok := e.Right(); !ok || s != "hi" || e.IsLeft() {
+		t.Fatalf("expected Right(hi), got %v %v", s, ok)
+	}
+
+	ints <- 7
+	e = channels.Select2(context.Background(), ints, strs).Unwrap()
+	if n, ok := e.Left(); !ok || n != 7 || e.IsRight() {
+		t.Fatalf("expected Left(7), got %v %v", n, ok)
+	}
+}
+
+func TestSelect2_ClosedAndCancelled(t *testing.T) {
+	ints := make(chan int)
+	strs := make(chan string)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := channels.Select2(ctx, ints, strs).Err(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+
+	close(ints)
+	if err := channels.Select2(context.Background(), ints, strs).Err(); !errors.Is(err, channels.ErrClosed) {
+		t.Fatalf("expected %v, got %v", channels.ErrClosed, err)
+	}
+}
+
+func TestSelect3(t *testing.T) {
+	a := make(chan int)
+	b := make(chan string)
+	c := make(chan bool, 1)
+
+	c <- true
+	o := channels.Select3(context.Background(), a, b, c).Unwrap()
+	if v, ok := o.Third(); !ok || !v || o.Index() != 2 {
+		t.Fatalf("expected Third(true), got %v %v", v, ok)
+	}
+	if _, ok := o.First(); ok {
+		t.Fatalf("expected First to be absent")
+	}
+
+	go func() { b <- "x" }()
+	o = channels.Select3(context.Background(), a, b, c).Unwrap()
+	if v, ok := o.Second(); !ok || v != "x" || o.Index() != 1 {
+		t.Fatalf("expected Second(x), got %v %v", v, ok)
+	}
+
+	close(c)
+	if err := channels.Select3(context.Background(), a, b, c).Err(); !errors.Is(err, channels.ErrClosed) {
+		t.Fatalf("expected %v, got %v", channels.ErrClosed, err)
+	}
+}
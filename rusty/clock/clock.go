@@ -0,0 +1,70 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package clock. clock provides Clock, the time source accepted by time-dependent packages (retry backoff,
+// cache TTLs, ...), so tests can substitute a controllable clock such as clocktest.FakeClock for the
+// system one.
+//
+// Example - Accepting a Clock:
+//
+//	type Limiter struct {
+//	    clock clock.Clock
+//	}
+//
+//	func NewLimiter(c clock.Clock) *Limiter { return &Limiter{clock: c} }
+//
+//	// production: NewLimiter(clock.System)
+//	// tests:      NewLimiter(clocktest.NewFakeClock(start))
+package clock
+
+import "time"
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Clock tells the time and creates timers.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Since returns the time elapsed since t.
+	Since(t time.Time) time.Duration
+	// NewTimer creates a Timer that fires once after d.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single-shot timer created by a Clock, mirroring *time.Timer.
+type Timer interface {
+	// C returns the channel the current time is sent on when the timer fires.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing, reporting whether it was still pending.
+	Stop() bool
+	// Reset re-arms the timer to fire after d, reporting whether it was still pending.
+	Reset(d time.Duration) bool
+}
+
+// systemClock is the Clock backed by package time.
+type systemClock struct{}
+
+// systemTimer adapts *time.Timer to Timer.
+type systemTimer struct{ t *time.Timer }
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// System is the Clock backed by the real time.
+var System Clock = systemClock{}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Sleep blocks until d has passed on c.
+func Sleep(c Clock, d time.Duration) {
+	<-c.NewTimer(d).C()
+}
+
+// -------------------------------------------- System Clock Methods --------------------------------------------
+
+func (systemClock) Now() time.Time                  { return time.Now() }
+func (systemClock) Since(t time.Time) time.Duration { return time.Since(t) }
+func (systemClock) NewTimer(d time.Duration) Timer  { return systemTimer{time.NewTimer(d)} }
+
+func (t systemTimer) C() <-chan time.Time        { return t.t.C }
+func (t systemTimer) Stop() bool                 { return t.t.Stop() }
+func (t systemTimer) Reset(d time.Duration) bool { return t.t.Reset(d) }
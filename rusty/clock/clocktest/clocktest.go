@@ -0,0 +1,159 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package clocktest. clocktest provides FakeClock, a clock.Clock whose time only moves when a test advances it,
+// so retry backoff, cache TTLs and timeouts can be tested deterministically and instantly.
+//
+// Example - Testing retry backoff without sleeping:
+//
+//	clk := clocktest.NewFakeClock(time.Now())
+//	done := make(chan result.Result[int])
+//	go func() { done <- retry.Do(ctx, flaky, retry.WithClock(clk)) }()
+//
+//	clk.BlockUntil(1)             // retry is now waiting for its first backoff
+//	clk.Advance(100 * time.Millisecond)
+package clocktest
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/clock"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// FakeClock is a clock.Clock that stands still until Advance or Set moves it, firing every timer that
+// becomes due. It is safe for concurrent use.
+type FakeClock struct {
+	mu      sync.Mutex
+	changed *sync.Cond // broadcast whenever timers are added or removed
+	now     time.Time
+	timers  []*fakeTimer
+}
+
+// fakeTimer is a Timer of a FakeClock.
+type fakeTimer struct {
+	clock    *FakeClock
+	ch       chan time.Time
+	deadline time.Time
+	pending  bool
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewFakeClock creates a FakeClock reading start.
+func NewFakeClock(start time.Time) *FakeClock {
+	c := &FakeClock{now: start}
+	c.changed = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the clock's current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Since returns the clock time elapsed since t.
+func (c *FakeClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// NewTimer creates a timer firing once the clock has advanced by d. A non-positive d fires immediately.
+func (c *FakeClock) NewTimer(d time.Duration) clock.Timer {
+	t := &fakeTimer{clock: c, ch: make(chan time.Time, 1)}
+	t.Reset(d)
+	return t
+}
+
+// Advance moves the clock forward by d, firing due timers in deadline order.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the clock to t, firing due timers in deadline order. Moving backwards fires nothing.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+
+	sort.SliceStable(c.timers, func(i, j int) bool { return c.timers[i].deadline.Before(c.timers[j].deadline) })
+	remaining := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.deadline.After(t) {
+			remaining = append(remaining, timer)
+			continue
+		}
+		timer.pending = false
+		timer.ch <- t // buffered and drained on Reset, so never blocks
+	}
+	c.timers = remaining
+	c.changed.Broadcast()
+}
+
+// Pending returns the number of timers waiting to fire.
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// BlockUntil waits until at least n timers are pending, i.e. until the code under test has reached the
+// waits a test wants to release with Advance.
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.timers) < n {
+		c.changed.Wait()
+	}
+}
+
+// -------------------------------------------- Fake Timer Methods --------------------------------------------
+
+func (t *fakeTimer) C() <-chan time.Time { return t.ch }
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.stopLocked()
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	wasPending := t.stopLocked()
+	select {
+	case <-t.ch: // drop a stale fire, as time.Timer does since Go 1.23
+	default:
+	}
+	t.deadline = c.now.Add(d)
+	if d <= 0 {
+		t.ch <- c.now
+		return wasPending
+	}
+	t.pending = true
+	c.timers = append(c.timers, t)
+	c.changed.Broadcast()
+	return wasPending
+}
+
+// stopLocked removes t from the pending timers. c.mu must be held.
+func (t *fakeTimer) stopLocked() bool {
+	if !t.pending {
+		return false
+	}
+	t.pending = false
+	for i, timer := range t.clock.timers {
+		if timer == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			break
+		}
+	}
+	t.clock.changed.Broadcast()
+	return true
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package clocktest_test. clocktest_test verifies FakeClock timers and drives retry and cache with it.
+package clocktest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/cache"
+	"github.com/seyedali-dev/goxide/rusty/clock/clocktest"
+	"github.com/seyedali-dev/goxide/rusty/resilience/retry"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+var start = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestFakeClock_Timers(t *testing.T) {
+	clk := clocktest.NewFakeClock(start)
+	late, early := clk.NewTimer(2*time.Second), clk.NewTimer(time.Second)
+	stopped := clk.NewTimer(time.Second)
+	if !stopped.Stop() {
+		t.Fatal("expected Stop to report a pending timer")
+	}
+
+	clk.Advance(time.Second)
+	select {
+	case at := <-early.C():
+		if !at.Equal(start.Add(time.Second)) {
+			t.Fatalf("expected %v, got %v", start.Add(time.Second), at)
+		}
+	default:
+		t.Fatal("expected early timer to fire")
+	}
+	select {
+	case <-late.C():
+		t.Fatal("late timer fired too soon")
+	case <-stopped.C():
+		t.Fatal("stopped timer fired")
+	default:
+	}
+	if clk.Pending() != 1 || clk.Since(start) != time.Second {
+		t.Fatalf("expected 1 pending timer after 1s, got %d after %v", clk.Pending(), clk.Since(start))
+	}
+}
+
+func TestFakeClock_Retry(t *testing.T) {
+	clk := clocktest.NewFakeClock(start)
+	errFlaky := errors.New("flaky")
+	attempts := 0
+	done := make(chan result.Result[int])
+
+	go func() {
+		done <- retry.Do(context.Background(), func(context.Context) result.Result[int] {
+			if attempts++; attempts < 3 {
+				return result.Err[int](errFlaky)
+			}
+			return result.Ok(attempts)
+		}, retry.WithClock(clk), retry.RetryIf(func(error) bool { return true }),
+			retry.WithPolicy(retry.Constant(time.Minute, 5)))
+	}()
+
+	for range 2 {
+		clk.BlockUntil(1)
+		clk.Advance(time.Minute)
+	}
+	if got := (<-done).Unwrap(); got != 3 || clk.Since(start) != 2*time.Minute {
+		t.Fatalf("expected 3 attempts after 2m, got %d after %v", got, clk.Since(start))
+	}
+}
+
+func TestFakeClock_CacheTTL(t *testing.T) {
+	clk := clocktest.NewFakeClock(start)
+	c := cache.New[string, int](cache.WithTTL(time.Minute), cache.WithClock(clk))
+	c.Set("a", 1)
+
+	clk.Advance(59 * time.Second)
+	if c.Get("a").IsNone() {
+		t.Fatal("expected entry before TTL")
+	}
+	clk.Advance(time.Second)
+	if c.Get("a").IsSome() {
+		t.Fatal("expected entry to expire at TTL")
+	}
+}
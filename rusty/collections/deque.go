@@ -0,0 +1,198 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections. deque provides Deque[T], a double-ended queue backed by a ring buffer with O(1)
+// pushes and pops at both ends, optionally bounded so that it acts as a sliding window.
+//
+// Example - Traditional slice vs Deque:
+//
+//	// Traditional Go: O(n) front removal, panics when empty
+//	next := queue[0]
+//	queue = queue[1:]
+//
+//	// With Deque
+//	next := queue.PopFront() // Option[T], O(1)
+package collections
+
+import (
+	"iter"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Deque [T] is a double-ended queue. The zero value is an empty, unbounded Deque ready to use.
+type Deque[T any] struct {
+	buf   []T
+	head  int
+	size  int
+	bound int // 0 means unbounded
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewDeque creates an unbounded Deque containing items, front to back.
+func NewDeque[T any](items ...T) *Deque[T] {
+	d := &Deque[T]{}
+	for _, item := range items {
+		d.PushBack(item)
+	}
+	return d
+}
+
+// NewBoundedDeque creates a Deque holding at most capacity elements. Pushing onto a full bounded Deque
+// evicts the element at the opposite end, which makes it a fixed-size sliding window.
+// capacity below 1 is treated as 1.
+//
+// Example - Moving average over the last 10 samples:
+//
+//	window := collections.NewBoundedDeque[float64](10)
+//	for sample := range samples {
+//	    window.PushBack(sample) // oldest sample drops out once 10 are held
+//	    report(average(window.Values()))
+//	}
+func NewBoundedDeque[T any](capacity int) *Deque[T] {
+	capacity = max(capacity, 1)
+	return &Deque[T]{buf: make([]T, capacity), bound: capacity}
+}
+
+// Len returns the number of elements.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// IsEmpty reports whether the Deque has no elements.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// IsFull reports whether a bounded Deque holds its maximum number of elements. Always false when unbounded.
+func (d *Deque[T]) IsFull() bool {
+	return d.bound > 0 && d.size == d.bound
+}
+
+// PushBack appends x at the back. On a full bounded Deque the front element is evicted and returned.
+func (d *Deque[T]) PushBack(x T) option.Option[T] {
+	evicted := option.None[T]()
+	if d.IsFull() {
+		evicted = d.PopFront()
+	}
+	d.grow()
+	d.buf[d.index(d.size)] = x
+	d.size++
+	return evicted
+}
+
+// PushFront prepends x at the front. On a full bounded Deque the back element is evicted and returned.
+func (d *Deque[T]) PushFront(x T) option.Option[T] {
+	evicted := option.None[T]()
+	if d.IsFull() {
+		evicted = d.PopBack()
+	}
+	d.grow()
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = x
+	d.size++
+	return evicted
+}
+
+// PopFront removes and returns the front element, or None if the Deque is empty.
+func (d *Deque[T]) PopFront() option.Option[T] {
+	if d.size == 0 {
+		return option.None[T]()
+	}
+	x := d.buf[d.head]
+	var zero T
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	return option.Some(x)
+}
+
+// PopBack removes and returns the back element, or None if the Deque is empty.
+func (d *Deque[T]) PopBack() option.Option[T] {
+	if d.size == 0 {
+		return option.None[T]()
+	}
+	i := d.index(d.size - 1)
+	x := d.buf[i]
+	var zero T
+	d.buf[i] = zero
+	d.size--
+	return option.Some(x)
+}
+
+// Front returns the front element without removing it, or None if the Deque is empty.
+func (d *Deque[T]) Front() option.Option[T] {
+	return d.Get(0)
+}
+
+// Back returns the back element without removing it, or None if the Deque is empty.
+func (d *Deque[T]) Back() option.Option[T] {
+	return d.Get(d.size - 1)
+}
+
+// Get returns the element at position i counted from the front, or None if i is out of range.
+func (d *Deque[T]) Get(i int) option.Option[T] {
+	if i < 0 || i >= d.size {
+		return option.None[T]()
+	}
+	return option.Some(d.buf[d.index(i)])
+}
+
+// Clear removes every element, keeping the allocated capacity.
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+	d.head, d.size = 0, 0
+}
+
+// ToSlice returns the elements front to back as a new slice.
+func (d *Deque[T]) ToSlice() []T {
+	out := make([]T, 0, d.size)
+	for x := range d.Values() {
+		out = append(out, x)
+	}
+	return out
+}
+
+// All returns an iterator over positions and elements, front to back.
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range d.size {
+			if !yield(i, d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements, front to back.
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range d.size {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// index maps a position relative to head onto the ring buffer.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+// grow doubles the ring buffer when it is full, unwrapping the elements to start at index 0.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(2*len(d.buf), 4))
+	for i := range d.size {
+		buf[i] = d.buf[d.index(i)]
+	}
+	d.buf, d.head = buf, 0
+}
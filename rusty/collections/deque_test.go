@@ -0,0 +1,64 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections_test. deque_test verifies both-end operations, growth and bounded eviction.
+package collections_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/collections"
+)
+
+func TestDeque_BothEnds(t *testing.T) {
+	var d collections.Deque[int]
+	if d.PopFront().IsSome() || d.Back().IsSome() {
+		t.Fatal("expected None on empty deque")
+	}
+
+	for i := 1; i <= 5; i++ {
+		d.PushBack(i)
+	}
+	d.PushFront(0)
+	d.PushFront(-1)
+
+	if got := d.ToSlice(); !slices.Equal(got, []int{-1, 0, 1, 2, 3, 4, 5}) {
+		t.Fatalf("unexpected contents: %v", got)
+	}
+	if d.PopFront().Unwrap() != -1 || d.PopBack().Unwrap() != 5 {
+		t.Fatal("unexpected popped values")
+	}
+	if d.Front().Unwrap() != 0 || d.Back().Unwrap() != 4 || d.Get(2).Unwrap() != 2 || d.Len() != 5 {
+		t.Fatalf("unexpected state: %v", d.ToSlice())
+	}
+}
+
+func TestDeque_WrapAround(t *testing.T) {
+	d := collections.NewDeque(1, 2, 3)
+	for i := 4; i <= 20; i++ {
+		d.PushBack(i)
+		d.PopFront()
+	}
+	if got := d.ToSlice(); !slices.Equal(got, []int{18, 19, 20}) {
+		t.Fatalf("unexpected contents: %v", got)
+	}
+}
+
+func TestDeque_BoundedEvicts(t *testing.T) {
+	d := collections.NewBoundedDeque[int](3)
+	for i := 1; i <= 3; i++ {
+		if d.PushBack(i).IsSome() {
+			t.Fatal("expected no eviction before full")
+		}
+	}
+	if !d.IsFull() || d.PushBack(4).Unwrap() != 1 {
+		t.Fatal("expected front element to be evicted")
+	}
+	if d.PushFront(0).Unwrap() != 4 {
+		t.Fatal("expected back element to be evicted")
+	}
+	if got := d.ToSlice(); !slices.Equal(got, []int{0, 2, 3}) {
+		t.Fatalf("unexpected contents: %v", got)
+	}
+}
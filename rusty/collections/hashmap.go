@@ -0,0 +1,184 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections. hashmap provides HashMap[K, V], a map wrapper whose lookups return Option and
+// whose Entry API replaces the comma-ok/double-lookup boilerplate around plain maps.
+//
+// Example - Traditional map vs HashMap:
+//
+//	// Traditional Go
+//	count, ok := counts[word]
+//	if !ok {
+//	    count = 0
+//	}
+//	counts[word] = count + 1
+//
+//	// With HashMap
+//	counts.Entry(word).AndModify(func(c *int) { *c++ }).OrInsert(1)
+package collections
+
+import (
+	"iter"
+	"maps"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// HashMap [K, V] is an unordered key/value collection. The zero value is an empty map ready to use.
+type HashMap[K comparable, V any] struct {
+	items map[K]V
+}
+
+// Entry [K, V] is a view into a single key of a HashMap, which may or may not be present.
+// It is obtained from HashMap.Entry and is valid until the map is modified by other means.
+type Entry[K comparable, V any] struct {
+	m        *HashMap[K, V]
+	key      K
+	value    V
+	occupied bool
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewHashMap creates an empty HashMap.
+func NewHashMap[K comparable, V any]() *HashMap[K, V] {
+	return &HashMap[K, V]{items: make(map[K]V)}
+}
+
+// FromMap creates a HashMap holding a copy of m.
+func FromMap[K comparable, V any](m map[K]V) *HashMap[K, V] {
+	return &HashMap[K, V]{items: maps.Clone(m)}
+}
+
+// ToMap returns a copy of the HashMap's contents as a plain map.
+func (h *HashMap[K, V]) ToMap() map[K]V {
+	out := make(map[K]V, len(h.items))
+	maps.Copy(out, h.items)
+	return out
+}
+
+// Len returns the number of entries.
+func (h *HashMap[K, V]) Len() int {
+	return len(h.items)
+}
+
+// Get returns the value for key, or None if key is absent.
+//
+// Example:
+//
+//	theme := prefs.Get("theme").UnwrapOr("light")
+func (h *HashMap[K, V]) Get(key K) option.Option[V] {
+	if v, ok := h.items[key]; ok {
+		return option.Some(v)
+	}
+	return option.None[V]()
+}
+
+// ContainsKey reports whether key is present.
+func (h *HashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := h.items[key]
+	return ok
+}
+
+// Insert sets key to value and returns the previous value, or None if key was absent.
+func (h *HashMap[K, V]) Insert(key K, value V) option.Option[V] {
+	previous := h.Get(key)
+	h.set(key, value)
+	return previous
+}
+
+// Remove deletes key and returns its value, or None if key was absent.
+func (h *HashMap[K, V]) Remove(key K) option.Option[V] {
+	previous := h.Get(key)
+	delete(h.items, key)
+	return previous
+}
+
+// Entry returns the entry for key, performing a single lookup that the Entry methods reuse.
+//
+// When to use:
+//   - When inserting only if a key is missing (OrInsert, OrInsertWith)
+//   - When updating a value in place if present (AndModify)
+//   - When both must happen in one expression, e.g. counters and groupings
+//
+// Example - Grouping orders by user:
+//
+//	byUser := collections.NewHashMap[int, []Order]()
+//	for _, o := range orders {
+//	    byUser.Entry(o.UserID).AndModify(func(list *[]Order) {
+//	        *list = append(*list, o)
+//	    }).OrInsert([]Order{o})
+//	}
+func (h *HashMap[K, V]) Entry(key K) Entry[K, V] {
+	v, ok := h.items[key]
+	return Entry[K, V]{m: h, key: key, value: v, occupied: ok}
+}
+
+// Keys returns an iterator over the keys in unspecified order.
+func (h *HashMap[K, V]) Keys() iter.Seq[K] {
+	return maps.Keys(h.items)
+}
+
+// Values returns an iterator over the values in unspecified order.
+func (h *HashMap[K, V]) Values() iter.Seq[V] {
+	return maps.Values(h.items)
+}
+
+// All returns an iterator over key/value pairs in unspecified order.
+func (h *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return maps.All(h.items)
+}
+
+// -------------------------------------------- Entry Methods --------------------------------------------
+
+// Key returns the entry's key.
+func (e Entry[K, V]) Key() K {
+	return e.key
+}
+
+// IsOccupied reports whether the key was present when the entry was obtained.
+func (e Entry[K, V]) IsOccupied() bool {
+	return e.occupied
+}
+
+// OrInsert inserts value if the key is absent and returns the value now stored for the key.
+func (e Entry[K, V]) OrInsert(value V) V {
+	if e.occupied {
+		return e.value
+	}
+	e.m.set(e.key, value)
+	return value
+}
+
+// OrInsertWith inserts the result of fn if the key is absent and returns the value now stored for the key.
+// fn is only called when the key is absent.
+func (e Entry[K, V]) OrInsertWith(fn func() V) V {
+	if e.occupied {
+		return e.value
+	}
+	value := fn()
+	e.m.set(e.key, value)
+	return value
+}
+
+// AndModify calls fn with the current value if the key is present and stores the modified value.
+// Returns the (possibly updated) entry so an OrInsert/OrInsertWith can follow.
+func (e Entry[K, V]) AndModify(fn func(*V)) Entry[K, V] {
+	if e.occupied {
+		fn(&e.value)
+		e.m.set(e.key, e.value)
+	}
+	return e
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// set stores value for key, lazily allocating the map for zero-value HashMaps.
+func (h *HashMap[K, V]) set(key K, value V) {
+	if h.items == nil {
+		h.items = make(map[K]V)
+	}
+	h.items[key] = value
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections_test. hashmap_test verifies Option lookups and the Entry API.
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/collections"
+)
+
+func TestHashMap_GetInsertRemove(t *testing.T) {
+	var m collections.HashMap[string, int]
+
+	if m.Get("a").IsSome() {
+		t.Fatal("expected None for missing key")
+	}
+	if prev := m.Insert("a", 1); prev.IsSome() {
+		t.Fatal("expected no previous value")
+	}
+	if prev := m.Insert("a", 2); prev.Unwrap() != 1 {
+		t.Fatalf("expected previous value 1, got %v", prev)
+	}
+	if got := m.Remove("a").Unwrap(); got != 2 {
+		t.Fatalf("expected removed 2, got %d", got)
+	}
+	if m.ContainsKey("a") {
+		t.Fatal("expected key to be removed")
+	}
+}
+
+func TestHashMap_EntryCounter(t *testing.T) {
+	counts := collections.NewHashMap[string, int]()
+	for _, w := range []string{"go", "rust", "go", "go"} {
+		counts.Entry(w).AndModify(func(c *int) { *c++ }).OrInsert(1)
+	}
+
+	if got := counts.Get("go").Unwrap(); got != 3 {
+		t.Fatalf("expected 3, got %d", got)
+	}
+	if got := counts.Get("rust").Unwrap(); got != 1 {
+		t.Fatalf("expected 1, got %d", got)
+	}
+}
+
+func TestHashMap_OrInsertWithIsLazy(t *testing.T) {
+	m := collections.FromMap(map[string]int{"present": 7})
+	calls := 0
+	build := func() int { calls++; return 42 }
+
+	if got := m.Entry("present").OrInsertWith(build); got != 7 {
+		t.Fatalf("expected existing 7, got %d", got)
+	}
+	if got := m.Entry("absent").OrInsertWith(build); got != 42 {
+		t.Fatalf("expected inserted 42, got %d", got)
+	}
+	if calls != 1 {
+		t.Fatalf("expected builder called once, got %d", calls)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections. hashset provides HashSet[T], the map[T]struct{} set every project re-implements,
+// with set algebra and iter.Seq iteration.
+package collections
+
+import (
+	"iter"
+	"maps"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// HashSet [T] is an unordered set of unique values. The zero value is an empty set ready to use.
+type HashSet[T comparable] struct {
+	items map[T]struct{}
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewHashSet creates a set containing items.
+//
+// Example:
+//
+//	admins := collections.NewHashSet("alice", "bob")
+//	if admins.Contains(user.Name) { ... }
+func NewHashSet[T comparable](items ...T) *HashSet[T] {
+	s := &HashSet[T]{items: make(map[T]struct{}, len(items))}
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+	return s
+}
+
+// CollectSet creates a set from the values of seq.
+func CollectSet[T comparable](seq iter.Seq[T]) *HashSet[T] {
+	s := NewHashSet[T]()
+	for item := range seq {
+		s.Add(item)
+	}
+	return s
+}
+
+// Len returns the number of elements.
+func (s *HashSet[T]) Len() int {
+	return len(s.items)
+}
+
+// IsEmpty reports whether the set has no elements.
+func (s *HashSet[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Add inserts item, reporting true if it was not already present.
+func (s *HashSet[T]) Add(item T) bool {
+	if s.Contains(item) {
+		return false
+	}
+	if s.items == nil {
+		s.items = make(map[T]struct{})
+	}
+	s.items[item] = struct{}{}
+	return true
+}
+
+// Remove deletes item, reporting true if it was present.
+func (s *HashSet[T]) Remove(item T) bool {
+	if !s.Contains(item) {
+		return false
+	}
+	delete(s.items, item)
+	return true
+}
+
+// Contains reports whether item is in the set.
+func (s *HashSet[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Values returns an iterator over the elements in unspecified order.
+func (s *HashSet[T]) Values() iter.Seq[T] {
+	return maps.Keys(s.items)
+}
+
+// ToSlice returns the elements as a slice in unspecified order.
+func (s *HashSet[T]) ToSlice() []T {
+	out := make([]T, 0, len(s.items))
+	for item := range s.items {
+		out = append(out, item)
+	}
+	return out
+}
+
+// Union returns a new set with the elements of s and other.
+//
+// Example:
+//
+//	allTags := postTags.Union(commentTags)
+func (s *HashSet[T]) Union(other *HashSet[T]) *HashSet[T] {
+	out := &HashSet[T]{items: maps.Clone(s.items)}
+	for item := range other.items {
+		out.Add(item)
+	}
+	return out
+}
+
+// Intersection returns a new set with the elements present in both s and other.
+func (s *HashSet[T]) Intersection(other *HashSet[T]) *HashSet[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	out := NewHashSet[T]()
+	for item := range small.items {
+		if large.Contains(item) {
+			out.Add(item)
+		}
+	}
+	return out
+}
+
+// Difference returns a new set with the elements of s that are not in other.
+//
+// Example:
+//
+//	missing := required.Difference(granted) // permissions still to request
+func (s *HashSet[T]) Difference(other *HashSet[T]) *HashSet[T] {
+	out := NewHashSet[T]()
+	for item := range s.items {
+		if !other.Contains(item) {
+			out.Add(item)
+		}
+	}
+	return out
+}
+
+// IsSubset reports whether every element of s is also in other.
+func (s *HashSet[T]) IsSubset(other *HashSet[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for item := range s.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
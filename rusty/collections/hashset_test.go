@@ -0,0 +1,58 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections_test. hashset_test verifies set membership and set algebra.
+package collections_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/collections"
+)
+
+func sorted(s *collections.HashSet[int]) []int {
+	return slices.Sorted(s.Values())
+}
+
+func TestHashSet_AddRemove(t *testing.T) {
+	var s collections.HashSet[string]
+
+	if !s.Add("a") || s.Add("a") {
+		t.Fatal("expected first Add to insert and second to be a no-op")
+	}
+	if !s.Contains("a") || s.Len() != 1 {
+		t.Fatal("expected set to contain a single element")
+	}
+	if !s.Remove("a") || s.Remove("a") {
+		t.Fatal("expected first Remove to delete and second to be a no-op")
+	}
+}
+
+func TestHashSet_Algebra(t *testing.T) {
+	a := collections.NewHashSet(1, 2, 3, 4)
+	b := collections.NewHashSet(3, 4, 5)
+
+	if got := sorted(a.Union(b)); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("unexpected union: %v", got)
+	}
+	if got := sorted(a.Intersection(b)); !slices.Equal(got, []int{3, 4}) {
+		t.Errorf("unexpected intersection: %v", got)
+	}
+	if got := sorted(a.Difference(b)); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("unexpected difference: %v", got)
+	}
+	if !collections.NewHashSet(3, 4).IsSubset(a) || b.IsSubset(a) {
+		t.Error("unexpected IsSubset result")
+	}
+	if a.Len() != 4 || b.Len() != 3 {
+		t.Error("expected set operations to leave operands unchanged")
+	}
+}
+
+func TestCollectSet(t *testing.T) {
+	s := collections.CollectSet(slices.Values([]int{1, 1, 2}))
+	if got := sorted(s); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("unexpected set: %v", got)
+	}
+}
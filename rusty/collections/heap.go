@@ -0,0 +1,194 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections. heap provides Heap[T], a binary-heap priority queue ordered by a comparator, with
+// Option-returning Peek and Pop and an optional bound that keeps only the highest-priority elements.
+//
+// Example - Traditional container/heap vs Heap:
+//
+//	// Traditional Go: implement heap.Interface, then type-assert every Pop
+//	heap.Push(&jobs, job)
+//	next := heap.Pop(&jobs).(Job) // panics when empty
+//
+//	// With Heap
+//	jobs := collections.NewHeap(func(a, b Job) int { return a.Deadline.Compare(b.Deadline) })
+//	jobs.Push(job)
+//	next := jobs.Pop() // Option[Job], earliest deadline first
+package collections
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Heap [T] is a priority queue that pops the least element according to its comparator first.
+// A Heap must be created with NewHeap or NewBoundedHeap.
+type Heap[T any] struct {
+	items []T
+	cmp   func(a, b T) int
+	bound int // 0 means unbounded
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewHeap creates an unbounded Heap containing items. cmp orders elements as for slices.SortFunc; the least
+// element is popped first, so reverse cmp for a max-heap.
+func NewHeap[T any](cmp func(a, b T) int, items ...T) *Heap[T] {
+	h := &Heap[T]{items: slices.Clone(items), cmp: cmp}
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+// NewBoundedHeap creates a Heap holding at most capacity elements. Pushing onto a full bounded Heap evicts
+// the least element, so the Heap retains the capacity greatest elements seen, which is how top-K is
+// computed in O(n log k). capacity below 1 is treated as 1.
+//
+// Example - The 10 slowest requests:
+//
+//	slowest := collections.NewBoundedHeap(func(a, b Request) int { return cmp.Compare(a.Latency, b.Latency) }, 10)
+//	for req := range requests {
+//	    slowest.Push(req)
+//	}
+func NewBoundedHeap[T any](cmp func(a, b T) int, capacity int) *Heap[T] {
+	capacity = max(capacity, 1)
+	return &Heap[T]{items: make([]T, 0, capacity), cmp: cmp, bound: capacity}
+}
+
+// TopK returns the k greatest elements of seq according to cmp, greatest first.
+//
+// Example:
+//
+//	hottest := collections.TopK(maps.Values(hits), 5, func(a, b Page) int { return cmp.Compare(a.Views, b.Views) })
+func TopK[T any](seq iter.Seq[T], k int, cmp func(a, b T) int) []T {
+	if k < 1 {
+		return nil
+	}
+	h := NewBoundedHeap(cmp, k)
+	for x := range seq {
+		h.Push(x)
+	}
+	out := slices.Collect(h.Drain())
+	slices.Reverse(out)
+	return out
+}
+
+// Len returns the number of elements.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// IsEmpty reports whether the Heap has no elements.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// IsFull reports whether a bounded Heap holds its maximum number of elements. Always false when unbounded.
+func (h *Heap[T]) IsFull() bool {
+	return h.bound > 0 && len(h.items) == h.bound
+}
+
+// Push adds x. On a full bounded Heap the least of the held elements and x is evicted and returned,
+// which is x itself when it is not greater than every held element.
+func (h *Heap[T]) Push(x T) option.Option[T] {
+	if h.IsFull() {
+		if h.cmp(x, h.items[0]) <= 0 {
+			return option.Some(x)
+		}
+		evicted := h.items[0]
+		h.items[0] = x
+		h.down(0)
+		return option.Some(evicted)
+	}
+	h.items = append(h.items, x)
+	h.up(len(h.items) - 1)
+	return option.None[T]()
+}
+
+// Peek returns the least element without removing it, or None if the Heap is empty.
+func (h *Heap[T]) Peek() option.Option[T] {
+	if len(h.items) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(h.items[0])
+}
+
+// Pop removes and returns the least element, or None if the Heap is empty.
+func (h *Heap[T]) Pop() option.Option[T] {
+	n := len(h.items) - 1
+	if n < 0 {
+		return option.None[T]()
+	}
+	x := h.items[0]
+	h.items[0] = h.items[n]
+	var zero T
+	h.items[n] = zero
+	h.items = h.items[:n]
+	h.down(0)
+	return option.Some(x)
+}
+
+// Clear removes every element, keeping the allocated capacity.
+func (h *Heap[T]) Clear() {
+	clear(h.items)
+	h.items = h.items[:0]
+}
+
+// Values returns an iterator over the elements in no particular order, leaving the Heap unchanged.
+func (h *Heap[T]) Values() iter.Seq[T] {
+	return slices.Values(h.items)
+}
+
+// Drain returns an iterator that pops elements least first until the Heap is empty or iteration stops.
+//
+// Example:
+//
+//	for job := range jobs.Drain() {
+//	    run(job) // earliest deadline first
+//	}
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !h.IsEmpty() {
+			if !yield(h.Pop().Unwrap()) {
+				return
+			}
+		}
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// up moves the element at i towards the root until its parent is not greater.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if h.cmp(h.items[i], h.items[parent]) >= 0 {
+			return
+		}
+		h.items[i], h.items[parent] = h.items[parent], h.items[i]
+		i = parent
+	}
+}
+
+// down moves the element at i towards the leaves until neither child is less.
+func (h *Heap[T]) down(i int) {
+	n := len(h.items)
+	for {
+		least := i
+		for _, child := range [2]int{2*i + 1, 2*i + 2} {
+			if child < n && h.cmp(h.items[child], h.items[least]) < 0 {
+				least = child
+			}
+		}
+		if least == i {
+			return
+		}
+		h.items[i], h.items[least] = h.items[least], h.items[i]
+		i = least
+	}
+}
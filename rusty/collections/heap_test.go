@@ -0,0 +1,67 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections_test. heap_test verifies priority order, bounded eviction and top-K selection.
+package collections_test
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/collections"
+)
+
+func TestHeap_PopsInOrder(t *testing.T) {
+	h := collections.NewHeap(cmp.Compare[int], 5, 3, 8, 1)
+	if h.Peek().Unwrap() != 1 || h.Len() != 4 {
+		t.Fatalf("expected %v on top of %v elements, got %v", 1, 4, h.Peek())
+	}
+	h.Push(4)
+	h.Push(0)
+
+	if got := slices.Collect(h.Drain()); !slices.Equal(got, []int{0, 1, 3, 4, 5, 8}) {
+		t.Fatalf("unexpected order: %v", got)
+	}
+	if h.Pop().IsSome() || h.Peek().IsSome() {
+		t.Fatal("expected None on empty heap")
+	}
+}
+
+func TestHeap_MaxHeap(t *testing.T) {
+	h := collections.NewHeap(func(a, b string) int { return cmp.Compare(b, a) }, "b", "d", "a", "c")
+	if h.Pop().Unwrap() != "d" || h.Pop().Unwrap() != "c" {
+		t.Fatal("expected a reversed comparator to pop the greatest first")
+	}
+}
+
+func TestHeap_Bounded(t *testing.T) {
+	h := collections.NewBoundedHeap(cmp.Compare[int], 3)
+	for _, x := range []int{4, 9, 1} {
+		if h.Push(x).IsSome() {
+			t.Fatalf("expected no eviction pushing %v", x)
+		}
+	}
+	if !h.IsFull() {
+		t.Fatal("expected a full heap")
+	}
+	if evicted := h.Push(7); evicted.Unwrap() != 1 {
+		t.Fatalf("expected %v, got %v", 1, evicted)
+	}
+	if evicted := h.Push(2); evicted.Unwrap() != 2 {
+		t.Fatalf("expected the pushed element to be rejected, got %v", evicted)
+	}
+	if got := slices.Sorted(h.Values()); !slices.Equal(got, []int{4, 7, 9}) {
+		t.Fatalf("unexpected contents: %v", got)
+	}
+}
+
+func TestTopK(t *testing.T) {
+	got := collections.TopK(slices.Values([]int{5, 1, 9, 3, 7, 9, 2}), 3, cmp.Compare[int])
+	if !slices.Equal(got, []int{9, 9, 7}) {
+		t.Fatalf("unexpected top 3: %v", got)
+	}
+	if got := collections.TopK(slices.Values([]int{1, 2}), 5, cmp.Compare[int]); !slices.Equal(got, []int{2, 1}) {
+		t.Fatalf("unexpected top 5 of 2: %v", got)
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections. linkedmap provides LinkedMap[K, V], a hash map that remembers insertion order,
+// so iteration and JSON encoding are deterministic without sorting keys.
+//
+// Example - Traditional map vs LinkedMap:
+//
+//	// Traditional Go: iteration order is random, so output differs run to run
+//	for k, v := range attrs { ... }
+//
+//	// With LinkedMap: keys come back in the order they were inserted
+//	attrs := collections.NewLinkedMap[string, any]()
+//	attrs.Insert("id", 42)
+//	attrs.Insert("name", "ali")
+//	out, _ := json.Marshal(attrs) // {"id":42,"name":"ali"}
+package collections
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"iter"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// LinkedMap [K, V] maps keys to values and iterates them in insertion order. Re-inserting an existing key
+// updates its value but keeps its position. The zero value is an empty LinkedMap ready to use.
+type LinkedMap[K comparable, V any] struct {
+	index map[K]*list.Element // values are *KeyValue[K, V]
+	order list.List
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewLinkedMap creates an empty LinkedMap.
+func NewLinkedMap[K comparable, V any]() *LinkedMap[K, V] {
+	return &LinkedMap[K, V]{}
+}
+
+// Len returns the number of entries.
+func (m *LinkedMap[K, V]) Len() int {
+	return len(m.index)
+}
+
+// Get returns the value for key, or None if it is absent.
+func (m *LinkedMap[K, V]) Get(key K) option.Option[V] {
+	if elem, ok := m.index[key]; ok {
+		return option.Some(elem.Value.(*KeyValue[K, V]).Value)
+	}
+	return option.None[V]()
+}
+
+// ContainsKey reports whether key is present.
+func (m *LinkedMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.index[key]
+	return ok
+}
+
+// Insert sets key to value and returns the previous value, or None if key was absent.
+// New keys are appended at the end; existing keys keep their position.
+func (m *LinkedMap[K, V]) Insert(key K, value V) option.Option[V] {
+	if elem, ok := m.index[key]; ok {
+		entry := elem.Value.(*KeyValue[K, V])
+		prev := entry.Value
+		entry.Value = value
+		return option.Some(prev)
+	}
+	if m.index == nil {
+		m.index = make(map[K]*list.Element)
+	}
+	m.index[key] = m.order.PushBack(&KeyValue[K, V]{Key: key, Value: value})
+	return option.None[V]()
+}
+
+// Remove deletes key and returns its value, or None if key was absent.
+func (m *LinkedMap[K, V]) Remove(key K) option.Option[V] {
+	elem, ok := m.index[key]
+	if !ok {
+		return option.None[V]()
+	}
+	delete(m.index, key)
+	return option.Some(m.order.Remove(elem).(*KeyValue[K, V]).Value)
+}
+
+// First returns the earliest inserted entry, or None if the map is empty.
+func (m *LinkedMap[K, V]) First() option.Option[KeyValue[K, V]] {
+	return m.entryAt(m.order.Front())
+}
+
+// Last returns the most recently inserted entry, or None if the map is empty.
+func (m *LinkedMap[K, V]) Last() option.Option[KeyValue[K, V]] {
+	return m.entryAt(m.order.Back())
+}
+
+// All returns an iterator over the entries in insertion order.
+func (m *LinkedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for elem := m.order.Front(); elem != nil; elem = elem.Next() {
+			entry := elem.Value.(*KeyValue[K, V])
+			if !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys in insertion order.
+func (m *LinkedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in insertion order.
+func (m *LinkedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON encodes the map as a JSON object with keys in insertion order.
+// Keys are encoded as encoding/json encodes map keys (strings, integers or encoding.TextMarshaler).
+func (m *LinkedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for k, v := range m.All() {
+		key, err := encodeKey(k)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object, inserting its entries in document order.
+//
+// Example:
+//
+//	var headers collections.LinkedMap[string, string]
+//	_ = json.Unmarshal(data, &headers) // iteration follows the order in data
+func (m *LinkedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return fmt.Errorf("collections: LinkedMap expects a JSON object")
+	}
+
+	*m = LinkedMap[K, V]{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, err := decodeKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		m.Insert(key, value)
+	}
+	_, err := dec.Token()
+	return err
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// entryAt returns the entry stored in elem, or None for a nil element.
+func (m *LinkedMap[K, V]) entryAt(elem *list.Element) option.Option[KeyValue[K, V]] {
+	if elem == nil {
+		return option.None[KeyValue[K, V]]()
+	}
+	return option.Some(*elem.Value.(*KeyValue[K, V]))
+}
+
+// encodeKey encodes k exactly as encoding/json would encode it as a map key.
+func encodeKey[K comparable](k K) ([]byte, error) {
+	obj, err := json.Marshal(map[K]struct{}{k: {}})
+	if err != nil {
+		return nil, err
+	}
+	// obj is {"<key>":{}}; strip the braces and the trailing :{}.
+	return obj[1 : len(obj)-4], nil
+}
+
+// decodeKey decodes a JSON object key into K exactly as encoding/json would for a map key.
+func decodeKey[K comparable](raw string) (K, error) {
+	quoted, err := json.Marshal(raw)
+	if err != nil {
+		var zero K
+		return zero, err
+	}
+	var single map[K]struct{}
+	if err := json.Unmarshal([]byte("{"+string(quoted)+":{}}"), &single); err != nil {
+		var zero K
+		return zero, err
+	}
+	for k := range single {
+		return k, nil
+	}
+	var zero K
+	return zero, fmt.Errorf("collections: cannot decode key %q", raw)
+}
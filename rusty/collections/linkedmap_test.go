@@ -0,0 +1,56 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections_test. linkedmap_test verifies insertion-ordered iteration and JSON round-trips.
+package collections_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/collections"
+)
+
+func TestLinkedMap_InsertionOrder(t *testing.T) {
+	var m collections.LinkedMap[string, int]
+	for i, k := range []string{"zeta", "alpha", "mid"} {
+		m.Insert(k, i)
+	}
+
+	if prev := m.Insert("zeta", 9); prev.Unwrap() != 0 {
+		t.Fatalf("expected %v, got %v", 0, prev.Unwrap())
+	}
+	m.Remove("alpha")
+	m.Insert("alpha", 5)
+
+	if got := slices.Collect(m.Keys()); !slices.Equal(got, []string{"zeta", "mid", "alpha"}) {
+		t.Fatalf("unexpected order: %v", got)
+	}
+	if m.First().Unwrap().Value != 9 || m.Last().Unwrap().Key != "alpha" || m.Get("nope").IsSome() {
+		t.Fatal("unexpected lookups")
+	}
+}
+
+func TestLinkedMap_JSONRoundTrip(t *testing.T) {
+	input := `{"b":1,"a":2,"c":3}`
+	var m collections.LinkedMap[string, int]
+	if err := json.Unmarshal([]byte(input), &m); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := json.Marshal(&m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != input {
+		t.Fatalf("expected %v, got %v", input, string(out))
+	}
+
+	ints := collections.NewLinkedMap[int, string]()
+	ints.Insert(10, "x")
+	ints.Insert(2, "y")
+	if out, _ := json.Marshal(ints); string(out) != `{"10":"x","2":"y"}` {
+		t.Fatalf("unexpected encoding: %s", out)
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections. orderedmap provides OrderedMap[K, V], a sorted map modeled on Rust's BTreeMap.
+// Keys are kept in ascending order in a balanced (AVL) tree, so lookups, inserts and removals are
+// O(log n) and iteration, range queries and nearest-key lookups come for free.
+//
+// Example - Traditional map vs OrderedMap:
+//
+//	// Traditional Go: sort the keys every time order matters
+//	keys := slices.Sorted(maps.Keys(prices))
+//	for _, k := range keys { ... }
+//
+//	// With OrderedMap
+//	for ts, price := range prices.Range(from, to) { ... }
+//	prev := prices.Floor(ts) // Option[KeyValue[time.Time, float64]]
+package collections
+
+import (
+	"cmp"
+	"iter"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// OrderedMap [K, V] maps keys to values in ascending key order.
+// The zero value is an empty OrderedMap ready to use.
+type OrderedMap[K cmp.Ordered, V any] struct {
+	root *avlNode[K, V]
+	size int
+}
+
+// KeyValue [K, V] is a key together with its value, returned by lookups that search for a key.
+type KeyValue[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// avlNode is a node of the balanced tree backing OrderedMap.
+type avlNode[K cmp.Ordered, V any] struct {
+	key         K
+	value       V
+	left, right *avlNode[K, V]
+	height      int
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewOrderedMap creates an empty OrderedMap.
+func NewOrderedMap[K cmp.Ordered, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{}
+}
+
+// Len returns the number of entries.
+func (m *OrderedMap[K, V]) Len() int {
+	return m.size
+}
+
+// Get returns the value for key, or None if it is absent.
+func (m *OrderedMap[K, V]) Get(key K) option.Option[V] {
+	for n := m.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return option.Some(n.value)
+		}
+	}
+	return option.None[V]()
+}
+
+// ContainsKey reports whether key is present.
+func (m *OrderedMap[K, V]) ContainsKey(key K) bool {
+	return m.Get(key).IsSome()
+}
+
+// Insert sets key to value and returns the previous value, or None if key was absent.
+func (m *OrderedMap[K, V]) Insert(key K, value V) option.Option[V] {
+	prev := option.None[V]()
+	m.root = m.root.insert(key, value, &prev)
+	if prev.IsNone() {
+		m.size++
+	}
+	return prev
+}
+
+// Remove deletes key and returns its value, or None if key was absent.
+func (m *OrderedMap[K, V]) Remove(key K) option.Option[V] {
+	removed := option.None[V]()
+	m.root = m.root.remove(key, &removed)
+	if removed.IsSome() {
+		m.size--
+	}
+	return removed
+}
+
+// First returns the entry with the smallest key, or None if the map is empty.
+func (m *OrderedMap[K, V]) First() option.Option[KeyValue[K, V]] {
+	if m.root == nil {
+		return option.None[KeyValue[K, V]]()
+	}
+	return option.Some(m.root.min().entry())
+}
+
+// Last returns the entry with the largest key, or None if the map is empty.
+func (m *OrderedMap[K, V]) Last() option.Option[KeyValue[K, V]] {
+	if m.root == nil {
+		return option.None[KeyValue[K, V]]()
+	}
+	n := m.root
+	for n.right != nil {
+		n = n.right
+	}
+	return option.Some(n.entry())
+}
+
+// Floor returns the entry with the largest key less than or equal to key, or None if there is none.
+//
+// Example - Price in effect at a given time:
+//
+//	price := option.Map(prices.Floor(orderTime), func(kv collections.KeyValue[time.Time, Price]) Price {
+//	    return kv.Value
+//	})
+func (m *OrderedMap[K, V]) Floor(key K) option.Option[KeyValue[K, V]] {
+	found := option.None[KeyValue[K, V]]()
+	for n := m.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			found = option.Some(n.entry())
+			n = n.right
+		default:
+			return option.Some(n.entry())
+		}
+	}
+	return found
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key, or None if there is none.
+func (m *OrderedMap[K, V]) Ceiling(key K) option.Option[KeyValue[K, V]] {
+	found := option.None[KeyValue[K, V]]()
+	for n := m.root; n != nil; {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			found = option.Some(n.entry())
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return option.Some(n.entry())
+		}
+	}
+	return found
+}
+
+// Range returns an iterator over the entries with lo <= key < hi, in ascending key order.
+//
+// Example:
+//
+//	for day, total := range sales.Range(monthStart, nextMonthStart) {
+//	    fmt.Println(day, total)
+//	}
+func (m *OrderedMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.walk(yield, option.Some(lo), option.Some(hi))
+	}
+}
+
+// All returns an iterator over every entry in ascending key order.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.walk(yield, option.None[K](), option.None[K]())
+	}
+}
+
+// Keys returns an iterator over the keys in ascending order.
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in ascending key order.
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// entry returns the node's key and value.
+func (n *avlNode[K, V]) entry() KeyValue[K, V] {
+	return KeyValue[K, V]{Key: n.key, Value: n.value}
+}
+
+// walk yields the subtree's entries within [lo, hi) in order, reporting false once yield stops.
+// A None bound is unbounded.
+func (n *avlNode[K, V]) walk(yield func(K, V) bool, lo, hi option.Option[K]) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := lo.IsNone() || n.key >= lo.Unwrap()
+	belowHi := hi.IsNone() || n.key < hi.Unwrap()
+
+	if aboveLo && !n.left.walk(yield, lo, hi) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return n.right.walk(yield, lo, hi)
+	}
+	return true
+}
+
+// insert adds or replaces key in the subtree rooted at n, storing a replaced value in prev.
+func (n *avlNode[K, V]) insert(key K, value V, prev *option.Option[V]) *avlNode[K, V] {
+	if n == nil {
+		return &avlNode[K, V]{key: key, value: value, height: 1}
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = n.left.insert(key, value, prev)
+	case c > 0:
+		n.right = n.right.insert(key, value, prev)
+	default:
+		*prev = option.Some(n.value)
+		n.value = value
+		return n
+	}
+	return n.rebalance()
+}
+
+// remove deletes key from the subtree rooted at n, storing the removed value in removed.
+func (n *avlNode[K, V]) remove(key K, removed *option.Option[V]) *avlNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = n.left.remove(key, removed)
+	case c > 0:
+		n.right = n.right.remove(key, removed)
+	default:
+		*removed = option.Some(n.value)
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		successor := n.right.min()
+		n.key, n.value = successor.key, successor.value
+		var discard option.Option[V]
+		n.right = n.right.remove(successor.key, &discard)
+	}
+	return n.rebalance()
+}
+
+// min returns the leftmost node of the subtree.
+func (n *avlNode[K, V]) min() *avlNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// rebalance restores the AVL invariant at n after a child changed height.
+func (n *avlNode[K, V]) rebalance() *avlNode[K, V] {
+	n.fixHeight()
+	switch balance := n.left.h() - n.right.h(); {
+	case balance > 1:
+		if n.left.left.h() < n.left.right.h() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case balance < -1:
+		if n.right.right.h() < n.right.left.h() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// rotateLeft lifts the right child above n.
+func (n *avlNode[K, V]) rotateLeft() *avlNode[K, V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	n.fixHeight()
+	r.fixHeight()
+	return r
+}
+
+// rotateRight lifts the left child above n.
+func (n *avlNode[K, V]) rotateRight() *avlNode[K, V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	n.fixHeight()
+	l.fixHeight()
+	return l
+}
+
+// h returns the height of the subtree, 0 for nil.
+func (n *avlNode[K, V]) h() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// fixHeight recomputes n's height from its children.
+func (n *avlNode[K, V]) fixHeight() {
+	n.height = 1 + max(n.left.h(), n.right.h())
+}
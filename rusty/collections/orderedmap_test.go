@@ -0,0 +1,76 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections_test. orderedmap_test verifies ordering, balancing and nearest-key lookups.
+package collections_test
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/collections"
+)
+
+func TestOrderedMap_MatchesBuiltinMap(t *testing.T) {
+	var m collections.OrderedMap[int, int]
+	want := map[int]int{}
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 2000 {
+		k := rng.IntN(300)
+		if rng.IntN(3) == 0 {
+			_, had := want[k]
+			if got := m.Remove(k); got.IsSome() != had {
+				t.Fatalf("remove %d: expected present=%v", k, had)
+			}
+			delete(want, k)
+			continue
+		}
+		m.Insert(k, k*10)
+		want[k] = k * 10
+	}
+
+	if m.Len() != len(want) {
+		t.Fatalf("expected %v, got %v", len(want), m.Len())
+	}
+	if got := slices.Collect(m.Keys()); !slices.Equal(got, slices.Sorted(maps.Keys(want))) {
+		t.Fatal("keys are not in ascending order or differ from reference")
+	}
+}
+
+func TestOrderedMap_Lookups(t *testing.T) {
+	m := collections.NewOrderedMap[int, string]()
+	for _, k := range []int{10, 20, 30, 40} {
+		m.Insert(k, "v")
+	}
+
+	if prev := m.Insert(20, "w"); prev.Unwrap() != "v" || m.Get(20).Unwrap() != "w" {
+		t.Fatal("expected Insert to replace and return previous value")
+	}
+	if m.First().Unwrap().Key != 10 || m.Last().Unwrap().Key != 40 {
+		t.Fatal("unexpected First/Last")
+	}
+	if m.Floor(25).Unwrap().Key != 20 || m.Floor(5).IsSome() || m.Floor(30).Unwrap().Key != 30 {
+		t.Fatal("unexpected Floor")
+	}
+	if m.Ceiling(25).Unwrap().Key != 30 || m.Ceiling(45).IsSome() {
+		t.Fatal("unexpected Ceiling")
+	}
+
+	var keys []int
+	for k := range m.Range(15, 40) {
+		keys = append(keys, k)
+	}
+	if !slices.Equal(keys, []int{20, 30}) {
+		t.Fatalf("expected %v, got %v", []int{20, 30}, keys)
+	}
+}
+
+func TestOrderedMap_Empty(t *testing.T) {
+	var m collections.OrderedMap[string, int]
+	if m.First().IsSome() || m.Last().IsSome() || m.Get("x").IsSome() || m.Remove("x").IsSome() {
+		t.Fatal("expected None on empty map")
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections. vec provides Vec[T], a growable slice wrapper whose accessors return Option
+// instead of panicking, so indexing bugs surface as None rather than runtime crashes.
+//
+// Example - Traditional slice vs Vec:
+//
+//	// Traditional Go
+//	last := items[len(items)-1] // panics when items is empty
+//
+//	// With Vec
+//	last := vec.Last().UnwrapOr(defaultItem)
+package collections
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Vec [T] is a growable, ordered collection of T. The zero value is an empty Vec ready to use.
+type Vec[T any] struct {
+	items []T
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewVec creates a Vec containing items in order.
+//
+// Example:
+//
+//	v := collections.NewVec(1, 2, 3)
+func NewVec[T any](items ...T) *Vec[T] {
+	return FromSlice(items)
+}
+
+// FromSlice creates a Vec holding a copy of s. Later changes to s do not affect the Vec.
+func FromSlice[T any](s []T) *Vec[T] {
+	return &Vec[T]{items: slices.Clone(s)}
+}
+
+// ToSlice returns a copy of the Vec's elements as a plain slice.
+func (v *Vec[T]) ToSlice() []T {
+	return slices.Clone(v.items)
+}
+
+// Len returns the number of elements.
+func (v *Vec[T]) Len() int {
+	return len(v.items)
+}
+
+// IsEmpty reports whether the Vec has no elements.
+func (v *Vec[T]) IsEmpty() bool {
+	return len(v.items) == 0
+}
+
+// Get returns the element at index i, or None if i is out of range.
+//
+// Example:
+//
+//	name := names.Get(idx).UnwrapOr("unknown")
+func (v *Vec[T]) Get(i int) option.Option[T] {
+	if i < 0 || i >= len(v.items) {
+		return option.None[T]()
+	}
+	return option.Some(v.items[i])
+}
+
+// Set replaces the element at index i, reporting false if i is out of range.
+func (v *Vec[T]) Set(i int, x T) bool {
+	if i < 0 || i >= len(v.items) {
+		return false
+	}
+	v.items[i] = x
+	return true
+}
+
+// First returns the first element, or None if the Vec is empty.
+func (v *Vec[T]) First() option.Option[T] {
+	return v.Get(0)
+}
+
+// Last returns the last element, or None if the Vec is empty.
+func (v *Vec[T]) Last() option.Option[T] {
+	return v.Get(len(v.items) - 1)
+}
+
+// Push appends x to the end of the Vec.
+func (v *Vec[T]) Push(x T) {
+	v.items = append(v.items, x)
+}
+
+// Pop removes and returns the last element, or None if the Vec is empty.
+//
+// Example - Stack processing:
+//
+//	for next := stack.Pop(); next.IsSome(); next = stack.Pop() {
+//	    visit(next.Unwrap())
+//	}
+func (v *Vec[T]) Pop() option.Option[T] {
+	last := v.Last()
+	if last.IsSome() {
+		var zero T
+		v.items[len(v.items)-1] = zero // release reference for GC
+		v.items = v.items[:len(v.items)-1]
+	}
+	return last
+}
+
+// Insert places x at index i, shifting later elements right.
+// i may equal Len() to append. Reports false if i is out of range.
+func (v *Vec[T]) Insert(i int, x T) bool {
+	if i < 0 || i > len(v.items) {
+		return false
+	}
+	v.items = slices.Insert(v.items, i, x)
+	return true
+}
+
+// Remove deletes and returns the element at index i, shifting later elements left.
+// Returns None if i is out of range.
+func (v *Vec[T]) Remove(i int) option.Option[T] {
+	removed := v.Get(i)
+	if removed.IsSome() {
+		v.items = slices.Delete(v.items, i, i+1)
+	}
+	return removed
+}
+
+// Retain keeps only the elements for which keep returns true, preserving order.
+//
+// Example:
+//
+//	orders.Retain(func(o Order) bool { return o.Status != "cancelled" })
+func (v *Vec[T]) Retain(keep func(T) bool) {
+	v.items = slices.DeleteFunc(v.items, func(x T) bool { return !keep(x) })
+}
+
+// ContainsFunc reports whether any element satisfies pred.
+func (v *Vec[T]) ContainsFunc(pred func(T) bool) bool {
+	return slices.ContainsFunc(v.items, pred)
+}
+
+// SortFunc sorts the Vec in place using cmp, which returns a negative number when a < b,
+// zero when a == b and a positive number when a > b. The sort is stable.
+func (v *Vec[T]) SortFunc(cmp func(a, b T) int) {
+	slices.SortStableFunc(v.items, cmp)
+}
+
+// All returns an iterator over index/element pairs in order.
+func (v *Vec[T]) All() iter.Seq2[int, T] {
+	return slices.All(v.items)
+}
+
+// Values returns an iterator over the elements in order.
+func (v *Vec[T]) Values() iter.Seq[T] {
+	return slices.Values(v.items)
+}
+
+// Contains reports whether v contains x.
+// It is a function rather than a method because it requires T to be comparable.
+func Contains[T comparable](v *Vec[T], x T) bool {
+	return slices.Contains(v.items, x)
+}
+
+// Sort sorts v in ascending order.
+// It is a function rather than a method because it requires T to be ordered.
+func Sort[T cmp.Ordered](v *Vec[T]) {
+	slices.Sort(v.items)
+}
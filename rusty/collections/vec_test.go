@@ -0,0 +1,74 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package collections_test. vec_test verifies the Option-returning Vec accessors.
+package collections_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/collections"
+)
+
+func TestVec_AccessorsOnEmpty(t *testing.T) {
+	var v collections.Vec[int]
+
+	if v.Get(0).IsSome() || v.First().IsSome() || v.Last().IsSome() || v.Pop().IsSome() {
+		t.Fatal("expected None from accessors on empty Vec")
+	}
+	if v.Remove(0).IsSome() {
+		t.Fatal("expected None when removing from empty Vec")
+	}
+	if v.Insert(1, 5) {
+		t.Fatal("expected Insert past the end to fail")
+	}
+}
+
+func TestVec_PushPopInsertRemove(t *testing.T) {
+	v := collections.NewVec(1, 2, 4)
+
+	if !v.Insert(2, 3) {
+		t.Fatal("expected Insert in range to succeed")
+	}
+	v.Push(5)
+	if got := v.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("unexpected contents: %v", got)
+	}
+	if got := v.Remove(0).Unwrap(); got != 1 {
+		t.Fatalf("expected removed 1, got %d", got)
+	}
+	if got := v.Pop().Unwrap(); got != 5 {
+		t.Fatalf("expected popped 5, got %d", got)
+	}
+	if got := v.Get(-1); got.IsSome() {
+		t.Fatal("expected None for negative index")
+	}
+}
+
+func TestVec_RetainSortContains(t *testing.T) {
+	v := collections.FromSlice([]int{5, 2, 8, 1, 6})
+
+	v.Retain(func(x int) bool { return x%2 == 0 })
+	collections.Sort(v)
+
+	if got := v.ToSlice(); !slices.Equal(got, []int{2, 6, 8}) {
+		t.Fatalf("unexpected contents: %v", got)
+	}
+	if !collections.Contains(v, 6) || collections.Contains(v, 5) {
+		t.Fatal("unexpected Contains result")
+	}
+	if v.First().Unwrap() != 2 || v.Last().Unwrap() != 8 {
+		t.Fatal("unexpected First/Last")
+	}
+}
+
+func TestVec_FromSliceCopies(t *testing.T) {
+	src := []string{"a", "b"}
+	v := collections.FromSlice(src)
+	src[0] = "changed"
+
+	if v.Get(0).Unwrap() != "a" {
+		t.Fatal("expected Vec to be independent of source slice")
+	}
+}
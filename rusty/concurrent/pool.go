@@ -0,0 +1,168 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package concurrent. pool provides a bounded worker pool whose jobs return Results, so fan-out work
+// keeps per-job errors instead of collapsing them into a single errgroup error.
+//
+// Example - Fetching many users concurrently:
+//
+//	pool := concurrent.NewPool(8, func(ctx context.Context, id int) result.Result[User] {
+//	    return repo.FindUser(ctx, id)
+//	})
+//	results := pool.Run(ctx, ids) // []Result[User], same order as ids
+package concurrent
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Pool [J, T] runs a Result-returning function over jobs of type J using a fixed number of workers.
+// A Pool holds no goroutines between runs and is safe for concurrent use.
+type Pool[J, T any] struct {
+	workers   int
+	queueSize int
+	fn        func(context.Context, J) result.Result[T]
+}
+
+// Option configures a Pool.
+type Option func(*poolConfig)
+
+// poolConfig holds tunables shared by every Pool instantiation.
+type poolConfig struct {
+	queueSize int
+}
+
+// indexedJob carries a job together with its position in the input.
+type indexedJob[J any] struct {
+	index int
+	job   J
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithQueueSize bounds the number of jobs buffered ahead of the workers (default: number of workers).
+func WithQueueSize(n int) Option {
+	return func(c *poolConfig) {
+		c.queueSize = n
+	}
+}
+
+// NewPool creates a Pool running fn on up to workers goroutines. workers below 1 is treated as 1.
+func NewPool[J, T any](workers int, fn func(context.Context, J) result.Result[T], opts ...Option) *Pool[J, T] {
+	workers = max(workers, 1)
+	cfg := poolConfig{queueSize: workers}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Pool[J, T]{workers: workers, queueSize: max(cfg.queueSize, 0), fn: fn}
+}
+
+// Run processes every job and returns the Results in the same order as jobs.
+// Jobs not started before ctx is done get Err(ctx.Err()); jobs already running see the cancelled ctx.
+//
+// When to use:
+//   - When the whole batch is known up front and order matters
+//   - When every job's outcome is needed, not just the first failure
+//
+// Example:
+//
+//	results := pool.Run(ctx, orderIDs)
+//	for i, res := range results {
+//	    if res.IsErr() {
+//	        log.Printf("order %d failed: %v", orderIDs[i], res.Err())
+//	    }
+//	}
+func (p *Pool[J, T]) Run(ctx context.Context, jobs []J) []result.Result[T] {
+	results := make([]result.Result[T], len(jobs))
+	queue := make(chan indexedJob[J], p.queueSize)
+
+	var wg sync.WaitGroup
+	for range min(p.workers, len(jobs)) {
+		wg.Go(func() {
+			for item := range queue {
+				results[item.index] = p.call(ctx, item.job)
+			}
+		})
+	}
+
+	for i, job := range jobs {
+		select {
+		case queue <- indexedJob[J]{index: i, job: job}:
+		case <-ctx.Done():
+			for j := i; j < len(jobs); j++ {
+				results[j] = result.Err[T](ctx.Err())
+			}
+			close(queue)
+			wg.Wait()
+			return results
+		}
+	}
+	close(queue)
+	wg.Wait()
+	return results
+}
+
+// Stream processes jobs as they arrive and sends each Result on the returned channel in completion order.
+// The channel is closed once all jobs are processed, or after in-flight jobs finish when ctx is done.
+//
+// When to use:
+//   - When jobs come from a producer (iterator, queue consumer) rather than a slice
+//   - When results should be handled as soon as they are ready
+//
+// Example:
+//
+//	for res := range pool.Stream(ctx, slices.Values(urls)) {
+//	    if res.IsOk() {
+//	        index(res.Unwrap())
+//	    }
+//	}
+func (p *Pool[J, T]) Stream(ctx context.Context, jobs iter.Seq[J]) <-chan result.Result[T] {
+	out := make(chan result.Result[T], p.queueSize)
+	queue := make(chan J, p.queueSize)
+
+	var wg sync.WaitGroup
+	for range p.workers {
+		wg.Go(func() {
+			for job := range queue {
+				res := p.call(ctx, job)
+				select {
+				case out <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		})
+	}
+
+	go func() {
+		defer func() {
+			close(queue)
+			wg.Wait()
+			close(out)
+		}()
+		for job := range jobs {
+			select {
+			case queue <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// call runs the pool function unless ctx is already done.
+func (p *Pool[J, T]) call(ctx context.Context, job J) result.Result[T] {
+	if err := ctx.Err(); err != nil {
+		return result.Err[T](err)
+	}
+	return p.fn(ctx, job)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package concurrent_test. pool_test verifies ordered and streamed worker pool execution.
+package concurrent_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/concurrent"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+var ErrOdd = errors.New("odd job")
+
+func square(_ context.Context, x int) result.Result[int] {
+	if x%2 == 1 {
+		return result.Err[int](ErrOdd)
+	}
+	return result.Ok(x * x)
+}
+
+func TestPool_RunOrdered(t *testing.T) {
+	pool := concurrent.NewPool(3, square)
+
+	results := pool.Run(context.Background(), []int{2, 3, 4, 6})
+
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+	if results[0].Unwrap() != 4 || results[2].Unwrap() != 16 || results[3].Unwrap() != 36 {
+		t.Fatal("expected results in input order")
+	}
+	if !errors.Is(results[1].Err(), ErrOdd) {
+		t.Fatalf("expected ErrOdd for job 1, got %v", results[1].Err())
+	}
+}
+
+func TestPool_RunCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls atomic.Int32
+	pool := concurrent.NewPool(2, func(ctx context.Context, x int) result.Result[int] {
+		calls.Add(1)
+		return result.Ok(x)
+	})
+
+	for _, res := range pool.Run(ctx, []int{1, 2, 3, 4, 5}) {
+		if !errors.Is(res.Err(), context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", res.Err())
+		}
+	}
+	if calls.Load() != 0 {
+		t.Fatalf("expected no job to run, ran %d", calls.Load())
+	}
+}
+
+func TestPool_Stream(t *testing.T) {
+	pool := concurrent.NewPool(4, square, concurrent.WithQueueSize(1))
+
+	var values []int
+	var errs int
+	for res := range pool.Stream(context.Background(), slices.Values([]int{1, 2, 3, 4})) {
+		if res.IsErr() {
+			errs++
+			continue
+		}
+		values = append(values, res.Unwrap())
+	}
+	slices.Sort(values)
+
+	if !slices.Equal(values, []int{4, 16}) || errs != 2 {
+		t.Fatalf("unexpected stream output: values=%v errs=%d", values, errs)
+	}
+}
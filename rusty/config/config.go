@@ -0,0 +1,212 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package config. config binds environment variables, optionally overridden by dotenv files, into a struct
+// through field tags. Every missing or invalid variable is reported at once in the returned Result,
+// and Option[T] fields model settings that may legitimately be absent.
+//
+// Example - Traditional vs config.Load:
+//
+//	// Traditional Go
+//	port, err := strconv.Atoi(os.Getenv("PORT"))
+//	if err != nil {
+//	    return Config{}, fmt.Errorf("PORT: %w", err)
+//	}
+//	dbURL := os.Getenv("DATABASE_URL")
+//	if dbURL == "" {
+//	    return Config{}, errors.New("DATABASE_URL is required")
+//	}
+//
+//	// With config.Load
+//	type Config struct {
+//	    Port      int                   `env:"PORT" default:"8080"`
+//	    DBURL     string                `env:"DATABASE_URL,required"`
+//	    Timeout   time.Duration         `env:"TIMEOUT" default:"5s"`
+//	    Hosts     []string              `env:"ALLOWED_HOSTS"`
+//	    SentryDSN option.Option[string] `env:"SENTRY_DSN"`
+//	    DB        DBConfig              `envPrefix:"DB_"`
+//	}
+//	cfg := config.Load[Config](config.WithPrefix("APP_"), config.WithFile(".env")).BubbleUp()
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/seyedali-dev/goxide/internal/textconv"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Option configures Load.
+type Option func(*loader)
+
+// loader holds the sources a single Load call reads from.
+type loader struct {
+	prefix    string
+	files     []string
+	lookupEnv func(string) (string, bool)
+	overrides map[string]string
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+var (
+	// ErrMissing is reported for each required variable that is not set and has no default.
+	ErrMissing = errors.New("required variable is not set")
+	// ErrInvalid is reported for each variable whose value cannot be parsed into its field type.
+	ErrInvalid = errors.New("invalid variable value")
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithPrefix prepends prefix to every variable name, e.g. "APP_" turns `env:"PORT"` into APP_PORT.
+func WithPrefix(prefix string) Option {
+	return func(l *loader) {
+		l.prefix = prefix
+	}
+}
+
+// WithFile reads KEY=VALUE overrides from a dotenv file. Values from files take precedence over
+// the environment, and later files over earlier ones. A file that does not exist is skipped.
+func WithFile(path string) Option {
+	return func(l *loader) {
+		l.files = append(l.files, path)
+	}
+}
+
+// WithLookup replaces os.LookupEnv as the source of variables, e.g. to load from a map in tests.
+func WithLookup(lookup func(string) (string, bool)) Option {
+	return func(l *loader) {
+		l.lookupEnv = lookup
+	}
+}
+
+// Load builds a T from its field tags:
+//   - `env:"NAME"` binds the field to NAME; `env:"NAME,required"` reports ErrMissing when it is unset
+//   - `default:"value"` is used when the variable is unset
+//   - untagged struct fields are loaded recursively, with an optional `envPrefix:"PREFIX_"`
+//
+// Supported field types are strings, bools, integers, floats, time.Duration, encoding.TextUnmarshaler
+// implementations, comma-separated slices of those, and Option[T] of any of them (None when unset).
+// On failure the Err joins one error per offending variable, each matching ErrMissing or ErrInvalid.
+//
+// When to use:
+//   - At program start-up, to fail fast with the full list of configuration problems
+//   - In tests, together with WithLookup, to build configs from literal maps
+//
+// Example:
+//
+//	res := config.Load[Config](config.WithFile(".env"), config.WithFile(".env.local"))
+//	if res.IsErr() {
+//	    log.Fatalf("invalid configuration:\n%v", res.Err())
+//	}
+func Load[T any](opts ...Option) result.Result[T] {
+	l := loader{lookupEnv: os.LookupEnv, overrides: map[string]string{}}
+	for _, opt := range opts {
+		opt(&l)
+	}
+	if err := l.readFiles(); err != nil {
+		return result.Err[T](err)
+	}
+
+	var cfg T
+	target := reflect.ValueOf(&cfg).Elem()
+	if target.Kind() != reflect.Struct {
+		return result.Err[T](fmt.Errorf("config: %T is not a struct", cfg))
+	}
+	if errs := l.bind(target, l.prefix); len(errs) > 0 {
+		return result.Err[T](errors.Join(errs...))
+	}
+	return result.Ok(cfg)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// readFiles loads every configured dotenv file into overrides.
+func (l *loader) readFiles() error {
+	for _, path := range l.files {
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("config: %w", err)
+		}
+		if err := parseDotenv(path, string(data), l.overrides); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookup returns the value of name from the overrides or the environment.
+func (l *loader) lookup(name string) (string, bool) {
+	if v, ok := l.overrides[name]; ok {
+		return v, true
+	}
+	return l.lookupEnv(name)
+}
+
+// bind fills the fields of v, collecting one error per missing or invalid variable.
+func (l *loader) bind(v reflect.Value, prefix string) []error {
+	var errs []error
+	for i := range v.NumField() {
+		field, sf := v.Field(i), v.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		tag, tagged := sf.Tag.Lookup("env")
+		if !tagged {
+			if field.Kind() == reflect.Struct && !textconv.IsScalar(field.Type()) {
+				errs = append(errs, l.bind(field, prefix+sf.Tag.Get("envPrefix"))...)
+			}
+			continue
+		}
+
+		name, flags, _ := strings.Cut(tag, ",")
+		name = prefix + name
+		raw, ok := l.lookup(name)
+		if !ok {
+			raw, ok = sf.Tag.Lookup("default")
+		}
+		if !ok {
+			if flags == "required" {
+				errs = append(errs, fmt.Errorf("%s: %w", name, ErrMissing))
+			}
+			continue
+		}
+
+		if err := textconv.Set(field, raw); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w %q: %w", name, ErrInvalid, raw, err))
+		}
+	}
+	return errs
+}
+
+// parseDotenv parses KEY=VALUE lines into out. Blank lines, # comments and an "export " prefix are
+// allowed, and values may be wrapped in single or double quotes.
+func parseDotenv(path, data string, out map[string]string) error {
+	for i, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return fmt.Errorf("config: %s:%d: expected KEY=VALUE", path, i+1)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		out[key] = value
+	}
+	return nil
+}
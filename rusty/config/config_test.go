@@ -0,0 +1,100 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package config_test. config_test verifies tag binding, defaults, Option fields and error aggregation.
+package config_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/config"
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+type DBConfig struct {
+	Host string `env:"HOST" default:"localhost"`
+	Port int    `env:"PORT" default:"5432"`
+}
+
+type AppConfig struct {
+	Port      int                   `env:"PORT" default:"8080"`
+	DBURL     string                `env:"DATABASE_URL,required"`
+	Timeout   time.Duration         `env:"TIMEOUT" default:"5s"`
+	Hosts     []string              `env:"ALLOWED_HOSTS"`
+	SentryDSN option.Option[string] `env:"SENTRY_DSN"`
+	MaxConns  option.Option[int]    `env:"MAX_CONNS"`
+	DB        DBConfig              `envPrefix:"DB_"`
+}
+
+func lookupFrom(vars map[string]string) config.Option {
+	return config.WithLookup(func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	})
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestLoad_BindsFields(t *testing.T) {
+	res := config.Load[AppConfig](lookupFrom(map[string]string{
+		"APP_DATABASE_URL":  "postgres://db",
+		"APP_ALLOWED_HOSTS": "a.com, b.com",
+		"APP_MAX_CONNS":     "20",
+		"APP_DB_HOST":       "db.internal",
+	}), config.WithPrefix("APP_"))
+
+	cfg := res.Unwrap()
+	if cfg.Port != 8080 || cfg.Timeout != 5*time.Second || cfg.DBURL != "postgres://db" {
+		t.Fatalf("unexpected scalar fields: %+v", cfg)
+	}
+	if !slices.Equal(cfg.Hosts, []string{"a.com", "b.com"}) {
+		t.Fatalf("expected %v, got %v", []string{"a.com", "b.com"}, cfg.Hosts)
+	}
+	if cfg.SentryDSN.IsSome() || cfg.MaxConns.UnwrapOr(0) != 20 {
+		t.Fatalf("unexpected Option fields: %+v", cfg)
+	}
+	if cfg.DB.Host != "db.internal" || cfg.DB.Port != 5432 {
+		t.Fatalf("unexpected nested fields: %+v", cfg.DB)
+	}
+}
+
+func TestLoad_ReportsEveryProblem(t *testing.T) {
+	res := config.Load[AppConfig](lookupFrom(map[string]string{
+		"PORT":      "http",
+		"MAX_CONNS": "many",
+	}))
+
+	err := res.Err()
+	if !errors.Is(err, config.ErrMissing) || !errors.Is(err, config.ErrInvalid) {
+		t.Fatalf("expected missing and invalid errors, got %v", err)
+	}
+	if n := len(err.(interface{ Unwrap() []error }).Unwrap()); n != 3 {
+		t.Fatalf("expected %v errors, got %v: %v", 3, n, err)
+	}
+}
+
+func TestLoad_FileOverridesEnvironment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	content := "# local overrides\nexport DATABASE_URL=\"postgres://file\"\nPORT=9090\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	res := config.Load[AppConfig](
+		lookupFrom(map[string]string{"DATABASE_URL": "postgres://env", "PORT": "1"}),
+		config.WithFile(path),
+		config.WithFile(filepath.Join(t.TempDir(), "missing.env")),
+	)
+
+	cfg := res.Unwrap()
+	if cfg.DBURL != "postgres://file" || cfg.Port != 9090 {
+		t.Fatalf("expected file values to win, got %+v", cfg)
+	}
+}
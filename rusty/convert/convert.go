@@ -0,0 +1,41 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package convert. convert provides Result-returning conversions between types: FromString, the shared
+// string-conversion mechanism behind the tag-driven binders (config, flagx, csvx, ...), extensible per type
+// with RegisterFromString, and TryInto, a checked conversion of loosely typed values extensible with
+// RegisterConverter. Both report failures as *Error.
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Error describes a failed conversion. It unwraps to the underlying cause, which is ErrUnsupported when no
+// conversion to the target type exists.
+type Error struct {
+	Value any          // The value that could not be converted
+	To    reflect.Type // The target type
+	Err   error        // The cause
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrUnsupported is wrapped by the *Error of conversions to a type that has no conversion from the input.
+var ErrUnsupported = errors.New("convert: unsupported conversion")
+
+// -------------------------------------------- Error Methods --------------------------------------------
+
+// Error describes the value, its type, the target type and the cause.
+func (e *Error) Error() string {
+	return fmt.Sprintf("convert: cannot convert %#v (%T) to %v: %v", e.Value, e.Value, e.To, e.Err)
+}
+
+// Unwrap returns the cause.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
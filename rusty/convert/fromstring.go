@@ -0,0 +1,69 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package convert. fromstring provides FromString, Go's counterpart of Rust's FromStr: one parser per type,
+// used alike by environment, flag, CSV and HTTP-parameter binding, and extensible with RegisterFromString.
+//
+// Example - Teaching every binder a new type:
+//
+//	func init() {
+//	    convert.RegisterFromString(func(s string) result.Result[Currency] {
+//	        return currency.Lookup(strings.ToUpper(s))
+//	    })
+//	}
+//
+//	price := convert.FromString[Currency]("eur").BubbleUp()
+//	cfg := config.Load[Config]().BubbleUp() // Currency fields now load from the environment too
+package convert
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/seyedali-dev/goxide/internal/textconv"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// RegisterFromString makes fn the parser for T in FromString and in every binder built on it, taking
+// precedence over the built-in rules. Registering T again replaces its parser. Register in init functions,
+// before any conversion runs; a BubbleUp inside fn becomes its Err.
+func RegisterFromString[T any](fn func(string) result.Result[T]) {
+	textconv.Register(reflect.TypeFor[T](), func(raw string) (reflect.Value, error) {
+		res := call(fn, raw)
+		if res.IsErr() {
+			return reflect.Value{}, res.Err()
+		}
+		value := res.Unwrap()
+		return reflect.ValueOf(&value).Elem(), nil
+	})
+}
+
+// FromString parses s into a T. Besides registered types, it supports strings, bools, integers and floats of
+// every size, time.Duration, encoding.TextUnmarshaler implementations (time.Time in RFC 3339, UUID, net/netip
+// addresses, ...), comma-separated slices of those and option.Option of any of them. Failures are
+// reported as *Error.
+//
+// Example:
+//
+//	port := convert.FromString[uint16](os.Getenv("PORT")).UnwrapOr(8080)
+//	id := convert.FromString[convert.UUID](r.PathValue("id")).BubbleUp()
+func FromString[T any](s string) result.Result[T] {
+	var value T
+	if err := textconv.Set(reflect.ValueOf(&value).Elem(), s); err != nil {
+		if errors.Is(err, textconv.ErrUnsupported) {
+			err = ErrUnsupported
+		}
+		return result.Err[T](&Error{Value: s, To: reflect.TypeFor[T](), Err: err})
+	}
+	return result.Ok(value)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// call runs fn, turning a BubbleUp panic into an Err Result.
+func call[A, T any](fn func(A) result.Result[T], arg A) (res result.Result[T]) {
+	defer result.Catch(&res)
+	return fn(arg)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package convert_test. fromstring_test verifies built-in parsing, registration and binder integration.
+package convert_test
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/convert"
+	"github.com/seyedali-dev/goxide/rusty/flagx"
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// Level is a type only parseable through a registered parser.
+type Level struct{ N int }
+
+var ErrUnknownLevel = errors.New("unknown level")
+
+func init() {
+	convert.RegisterFromString(func(s string) result.Result[Level] {
+		switch strings.ToLower(s) {
+		case "low":
+			return result.Ok(Level{1})
+		case "high":
+			return result.Ok(Level{9})
+		}
+		return result.Err[Level](ErrUnknownLevel)
+	})
+}
+
+func TestFromString_BuiltIns(t *testing.T) {
+	if got := convert.FromString[int8]("-12").Unwrap(); got != -12 {
+		t.Fatalf("expected %v, got %v", -12, got)
+	}
+	if got := convert.FromString[time.Duration]("1m30s").Unwrap(); got != 90*time.Second {
+		t.Fatalf("expected %v, got %v", 90*time.Second, got)
+	}
+	if got := convert.FromString[bool]("true").Unwrap(); !got {
+		t.Fatal("expected true")
+	}
+	if got := convert.FromString[[]float64]("1.5, 2").Unwrap(); !slices.Equal(got, []float64{1.5, 2}) {
+		t.Fatalf("unexpected slice: %v", got)
+	}
+	if got := convert.FromString[option.Option[uint]]("7").Unwrap(); got.Unwrap() != 7 {
+		t.Fatalf("expected Some(7), got %v", got)
+	}
+	id := convert.FromString[convert.UUID]("123e4567-e89b-12d3-a456-426614174000").Unwrap()
+	if id.String() != "123e4567-e89b-12d3-a456-426614174000" {
+		t.Fatalf("unexpected UUID: %v", id)
+	}
+}
+
+func TestFromString_Errors(t *testing.T) {
+	err := convert.FromString[uint8]("300").Err()
+	var convErr *convert.Error
+	if !errors.As(err, &convErr) || convErr.Value != "300" || !errors.Is(err, strconv.ErrRange) {
+		t.Fatalf("expected a *convert.Error caused by %v, got %v", strconv.ErrRange, err)
+	}
+	if err := convert.FromString[map[string]int]("a=1").Err(); !errors.Is(err, convert.ErrUnsupported) {
+		t.Fatalf("expected %v, got %v", convert.ErrUnsupported, err)
+	}
+}
+
+func TestRegisterFromString(t *testing.T) {
+	if got := convert.FromString[Level]("HIGH").Unwrap(); got.N != 9 {
+		t.Fatalf("expected %v, got %v", 9, got.N)
+	}
+	if err := convert.FromString[Level]("medium").Err(); !errors.Is(err, ErrUnknownLevel) {
+		t.Fatalf("expected %v, got %v", ErrUnknownLevel, err)
+	}
+
+	type Flags struct {
+		Level Level `flag:"level" default:"low"`
+	}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	if got := flagx.Bind[Flags](fs, []string{"-level", "high"}).Unwrap(); got.Level.N != 9 {
+		t.Fatalf("expected binders to use the registered parser, got %v", got.Level)
+	}
+}
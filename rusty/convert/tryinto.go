@@ -0,0 +1,178 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package convert. tryinto provides TryInto, Go's counterpart of Rust's TryInto: a checked conversion of a
+// loosely typed value (decoded JSON, map entries, driver values) into a concrete type, extensible with
+// RegisterConverter and reported as *Error instead of a panic or a silently truncated value.
+//
+// Example - Traditional vs TryInto:
+//
+//	// Traditional Go
+//	n, ok := payload["count"].(float64) // JSON numbers decode as float64
+//	if !ok || n != math.Trunc(n) {
+//	    return fmt.Errorf("bad count %v", payload["count"])
+//	}
+//	count := int(n)
+//
+//	// With TryInto
+//	count := convert.TryInto[int](payload["count"]).BubbleUp()
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+
+	"github.com/seyedali-dev/goxide/internal/textconv"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// converter converts a value of the From type of its registration into its To type.
+type converter func(reflect.Value) (reflect.Value, error)
+
+// conversion is the registry key of a converter.
+type conversion struct {
+	from, to reflect.Type
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrOverflow is wrapped by the *Error of numeric conversions that would change the value, such as 300 into
+// a uint8 or 1.5 into an int.
+var ErrOverflow = errors.New("convert: value does not fit the target type")
+
+// converters holds the converters added by RegisterConverter.
+var converters sync.Map
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// RegisterConverter makes fn the conversion from From to To in TryInto and Assign, taking precedence over
+// the built-in rules. Registering the same pair again replaces its converter. Register in init functions,
+// before any conversion runs; a BubbleUp inside fn becomes its Err.
+//
+// Example:
+//
+//	convert.RegisterConverter(func(c Cents) result.Result[Money] {
+//	    return result.Ok(Money{Amount: int64(c), Currency: "USD"})
+//	})
+func RegisterConverter[From, To any](fn func(From) result.Result[To]) {
+	key := conversion{from: reflect.TypeFor[From](), to: reflect.TypeFor[To]()}
+	converters.Store(key, converter(func(v reflect.Value) (reflect.Value, error) {
+		res := call(fn, v.Interface().(From))
+		if res.IsErr() {
+			return reflect.Value{}, res.Err()
+		}
+		out := res.Unwrap()
+		return reflect.ValueOf(&out).Elem(), nil
+	}))
+}
+
+// TryInto converts v into a T. The rules, tried in order:
+//   - nil becomes the zero T
+//   - a converter registered for the dynamic type of v and T is used
+//   - values assignable to T are assigned
+//   - strings are parsed as FromString does
+//   - numbers convert to other numeric types if the value is preserved exactly (otherwise ErrOverflow)
+//   - slices and arrays convert element by element into slice types
+//
+// Anything else fails with ErrUnsupported. Failures are reported as *Error.
+//
+// Example:
+//
+//	ids := convert.TryInto[[]int64](body["ids"]).BubbleUp() // from []any{1.0, 2.0, 3.0}
+func TryInto[T any](v any) result.Result[T] {
+	var out T
+	if err := Assign(reflect.ValueOf(&out).Elem(), v); err != nil {
+		return result.Err[T](err)
+	}
+	return result.Ok(out)
+}
+
+// Assign converts v into dst following the rules of TryInto, for reflection-based code that only knows the
+// target type at runtime. dst must be settable. The error, if any, is an *Error.
+func Assign(dst reflect.Value, v any) error {
+	if err := assign(dst, v); err != nil {
+		return &Error{Value: v, To: dst.Type(), Err: err}
+	}
+	return nil
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// assign implements Assign, returning the bare cause of a failure.
+func assign(dst reflect.Value, v any) error {
+	src := reflect.ValueOf(v)
+	if !src.IsValid() {
+		dst.SetZero()
+		return nil
+	}
+	if conv, ok := converters.Load(conversion{from: src.Type(), to: dst.Type()}); ok {
+		out, err := conv.(converter)(src)
+		if err != nil {
+			return err
+		}
+		dst.Set(out)
+		return nil
+	}
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+	if s, ok := v.(string); ok {
+		return textconv.Set(dst, s)
+	}
+
+	switch {
+	case isNumber(src.Kind()) && isNumber(dst.Kind()):
+		out := src.Convert(dst.Type())
+		nan := src.CanFloat() && math.IsNaN(src.Float()) && out.CanFloat()
+		if !nan && (!out.Convert(src.Type()).Equal(src) || sign(src) != sign(out)) {
+			return ErrOverflow
+		}
+		dst.Set(out)
+		return nil
+	case (src.Kind() == reflect.Slice || src.Kind() == reflect.Array) && dst.Kind() == reflect.Slice:
+		out := reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+		for i := range src.Len() {
+			if err := assign(out.Index(i), src.Index(i).Interface()); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		dst.Set(out)
+		return nil
+	}
+	return ErrUnsupported
+}
+
+// isNumber reports whether k is an integer or floating-point kind.
+func isNumber(k reflect.Kind) bool {
+	return (k >= reflect.Int && k <= reflect.Uintptr) || k == reflect.Float32 || k == reflect.Float64
+}
+
+// sign returns -1, 0 or 1 for a numeric value, so that conversions wrapping around through unsigned types,
+// which round-trip exactly, are still caught.
+func sign(v reflect.Value) int {
+	switch {
+	case v.CanInt():
+		return cmpZero(float64(v.Int()))
+	case v.CanUint():
+		return cmpZero(float64(v.Uint()))
+	default:
+		return cmpZero(v.Float())
+	}
+}
+
+// cmpZero compares f with zero.
+func cmpZero(f float64) int {
+	switch {
+	case f < 0:
+		return -1
+	case f > 0:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package convert_test. tryinto_test verifies checked conversions, custom converters and error details.
+package convert_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/convert"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+type (
+	Cents int64
+	Money struct {
+		Amount   int64
+		Currency string
+	}
+)
+
+func init() {
+	convert.RegisterConverter(func(c Cents) result.Result[Money] {
+		return result.Ok(Money{Amount: int64(c), Currency: "USD"})
+	})
+}
+
+func TestTryInto_BuiltIns(t *testing.T) {
+	if got := convert.TryInto[int](20.0).Unwrap(); got != 20 {
+		t.Fatalf("expected %v, got %v", 20, got)
+	}
+	if got := convert.TryInto[float32](int16(-3)).Unwrap(); got != -3 {
+		t.Fatalf("expected %v, got %v", -3, got)
+	}
+	if got := convert.TryInto[uint16]("8080").Unwrap(); got != 8080 {
+		t.Fatalf("expected %v, got %v", 8080, got)
+	}
+	if got := convert.TryInto[[]int64]([]any{1.0, "2", int8(3)}).Unwrap(); !slices.Equal(got, []int64{1, 2, 3}) {
+		t.Fatalf("unexpected slice: %v", got)
+	}
+	if got := convert.TryInto[*int](nil); got.IsErr() || got.Unwrap() != nil {
+		t.Fatalf("expected nil to become the zero value, got %v", got)
+	}
+	if got := convert.TryInto[float32](math.NaN()).Unwrap(); !math.IsNaN(float64(got)) {
+		t.Fatalf("expected NaN, got %v", got)
+	}
+}
+
+func TestTryInto_Errors(t *testing.T) {
+	for _, tt := range []struct {
+		res    error
+		target error
+	}{
+		{convert.TryInto[int](1.5).Err(), convert.ErrOverflow},
+		{convert.TryInto[uint8](300).Err(), convert.ErrOverflow},
+		{convert.TryInto[uint](-1).Err(), convert.ErrOverflow},
+		{convert.TryInto[[]uint8]([]int{1, -2}).Err(), convert.ErrOverflow},
+		{convert.TryInto[string](42).Err(), convert.ErrUnsupported},
+		{convert.TryInto[Money](struct{}{}).Err(), convert.ErrUnsupported},
+	} {
+		if !errors.Is(tt.res, tt.target) {
+			t.Fatalf("expected %v, got %v", tt.target, tt.res)
+		}
+	}
+
+	var convErr *convert.Error
+	if err := convert.TryInto[int8](1000).Err(); !errors.As(err, &convErr) || convErr.Value != 1000 || convErr.To != reflect.TypeFor[int8]() {
+		t.Fatalf("expected a detailed *convert.Error, got %v", err)
+	}
+}
+
+func TestRegisterConverter(t *testing.T) {
+	if got := convert.TryInto[Money](Cents(250)).Unwrap(); got != (Money{Amount: 250, Currency: "USD"}) {
+		t.Fatalf("unexpected conversion: %v", got)
+	}
+	if got := convert.TryInto[[]Money]([]Cents{1, 2}).Unwrap(); len(got) != 2 || got[1].Amount != 2 {
+		t.Fatalf("expected element-wise use of the converter, got %v", got)
+	}
+}
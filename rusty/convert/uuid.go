@@ -0,0 +1,84 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package convert. uuid provides UUID, a 128-bit identifier in the canonical 8-4-4-4-12 hex form, so that
+// ID fields can be bound and validated without a third-party dependency.
+package convert
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// UUID is a 128-bit universally unique identifier. It implements encoding.TextMarshaler and
+// encoding.TextUnmarshaler, so it works in JSON, FromString and every binder. The version is not checked.
+type UUID [16]byte
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrInvalidUUID is wrapped by the errors of ParseUUID.
+var ErrInvalidUUID = errors.New("convert: invalid UUID")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// ParseUUID parses a UUID in the canonical form "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", in either case,
+// optionally wrapped in braces or prefixed with "urn:uuid:".
+func ParseUUID(s string) result.Result[UUID] {
+	var id UUID
+	if err := id.UnmarshalText([]byte(s)); err != nil {
+		return result.Err[UUID](err)
+	}
+	return result.Ok(id)
+}
+
+// -------------------------------------------- UUID Methods --------------------------------------------
+
+// String returns the canonical lowercase form.
+func (id UUID) String() string {
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], id[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], id[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], id[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], id[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], id[10:])
+	return string(buf)
+}
+
+// IsZero reports whether id is the nil UUID.
+func (id UUID) IsZero() bool {
+	return id == UUID{}
+}
+
+// MarshalText encodes id in the canonical form.
+func (id UUID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText parses text as ParseUUID does.
+func (id *UUID) UnmarshalText(text []byte) error {
+	s := strings.TrimPrefix(string(text), "urn:uuid:")
+	if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+		s = s[1 : len(s)-1]
+	}
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return fmt.Errorf("%w: %q", ErrInvalidUUID, text)
+	}
+
+	digits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	var parsed UUID
+	if _, err := hex.Decode(parsed[:], []byte(digits)); err != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidUUID, text)
+	}
+	*id = parsed
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package convert_test. uuid_test verifies UUID parsing and formatting.
+package convert_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/convert"
+)
+
+func TestParseUUID(t *testing.T) {
+	const canonical = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	for _, s := range []string{canonical, "F47AC10B-58CC-4372-A567-0E02B2C3D479", "{" + canonical + "}", "urn:uuid:" + canonical} {
+		if got := convert.ParseUUID(s).Unwrap().String(); got != canonical {
+			t.Fatalf("%q: expected %v, got %v", s, canonical, got)
+		}
+	}
+	for _, s := range []string{"", "f47ac10b58cc4372a5670e02b2c3d479", "f47ac10b-58cc-4372-a567-0e02b2c3d47z"} {
+		if err := convert.ParseUUID(s).Err(); !errors.Is(err, convert.ErrInvalidUUID) {
+			t.Fatalf("%q: expected %v, got %v", s, convert.ErrInvalidUUID, err)
+		}
+	}
+}
+
+func TestUUID_JSON(t *testing.T) {
+	id := convert.ParseUUID("f47ac10b-58cc-4372-a567-0e02b2c3d479").Unwrap()
+	data, _ := json.Marshal(map[string]convert.UUID{"id": id})
+	var decoded map[string]convert.UUID
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded["id"] != id {
+		t.Fatalf("expected a round trip, got %v (%v)", decoded, err)
+	}
+	if !(convert.UUID{}).IsZero() || id.IsZero() {
+		t.Fatal("unexpected IsZero")
+	}
+}
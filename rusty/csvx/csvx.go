@@ -0,0 +1,243 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package csvx. csvx reads CSV files into structs as a fallible iterator: columns are matched to fields
+// through `csv` tags, and a row that cannot be parsed yields an Err for that row while reading continues.
+//
+// Example - Traditional vs csvx:
+//
+//	// Traditional Go
+//	r := csv.NewReader(file)
+//	header, _ := r.Read()
+//	for {
+//	    rec, err := r.Read()
+//	    if err == io.EOF { break }
+//	    qty, err := strconv.Atoi(rec[indexOf(header, "qty")])
+//	    ... // one bad row aborts everything, or needs hand-rolled bookkeeping
+//	}
+//
+//	// With csvx
+//	type Item struct {
+//	    SKU   string                 `csv:"sku"`
+//	    Qty   int                    `csv:"qty"`
+//	    Price option.Option[float64] `csv:"price"`
+//	}
+//	for res := range csvx.Read[Item](file) {
+//	    ...
+//	}
+package csvx
+
+import (
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"strings"
+
+	"github.com/seyedali-dev/goxide/internal/textconv"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Option configures Read.
+type Option func(*config)
+
+// config holds the settings applied by Option.
+type config struct {
+	comma    rune
+	comment  rune
+	noHeader bool
+}
+
+// column binds one CSV column to a struct field.
+type column struct {
+	name  string
+	field []int // index path for reflect.Value.FieldByIndex
+	index int   // position in the record
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+var (
+	// ErrMissingColumn is reported when the header lacks a column that a struct field is tagged with.
+	ErrMissingColumn = errors.New("csvx: missing column")
+	// ErrInvalid is reported for a cell whose value cannot be parsed into its field type.
+	ErrInvalid = errors.New("csvx: invalid value")
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Comma sets the field delimiter (default ',').
+func Comma(r rune) Option {
+	return func(c *config) { c.comma = r }
+}
+
+// Comment makes lines starting with r be skipped.
+func Comment(r rune) Option {
+	return func(c *config) { c.comment = r }
+}
+
+// NoHeader declares that the input has no header row; fields are then bound to columns in declaration order.
+func NoHeader() Option {
+	return func(c *config) { c.noHeader = true }
+}
+
+// Read decodes the rows of r into values of the struct type T, yielding one Result per row.
+//   - `csv:"name"` binds a field to the header column name (matched case-insensitively); untagged
+//     exported fields bind to their field name, and `csv:"-"` skips a field
+//   - Fields may be strings, bools, numbers, time.Duration, encoding.TextUnmarshaler implementations,
+//     comma-separated slices of those, or Option[T] of any of them (None for an empty cell)
+//
+// A malformed row or unparsable cell yields an Err naming its line and column, and reading continues with
+// the next row. A missing header column or a read error yields a single Err and ends the sequence.
+//
+// When to use:
+//   - When importing user-supplied files where a few bad rows must be reported, not fatal
+//   - When streaming large exports without loading them into memory
+//
+// Example:
+//
+//	var imported, failed int
+//	for res := range csvx.Read[Item](file, csvx.Comma(';')) {
+//	    if res.IsErr() {
+//	        failed++
+//	        log.Printf("skipping row: %v", res.Err())
+//	        continue
+//	    }
+//	    store.Save(res.Unwrap())
+//	    imported++
+//	}
+func Read[T any](r io.Reader, opts ...Option) iter.Seq[result.Result[T]] {
+	cfg := config{comma: ','}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(yield func(result.Result[T]) bool) {
+		rowType := reflect.TypeFor[T]()
+		if rowType.Kind() != reflect.Struct {
+			yield(result.Err[T](fmt.Errorf("csvx: %v is not a struct", rowType)))
+			return
+		}
+
+		reader := csv.NewReader(r)
+		reader.Comma, reader.Comment = cfg.comma, cfg.comment
+		reader.FieldsPerRecord = -1 // short rows are reported per row below
+		reader.ReuseRecord = true
+
+		columns := fieldsOf(rowType)
+		if !cfg.noHeader {
+			header, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(result.Err[T](fmt.Errorf("csvx: reading header: %w", err)))
+				return
+			}
+			if err := matchHeader(columns, header); err != nil {
+				yield(result.Err[T](err))
+				return
+			}
+		}
+
+		for {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			var res result.Result[T]
+			switch {
+			case errors.As(err, new(*csv.ParseError)):
+				res = result.Err[T](fmt.Errorf("csvx: %w", err))
+			case err != nil:
+				yield(result.Err[T](fmt.Errorf("csvx: %w", err)))
+				return
+			default:
+				line, _ := reader.FieldPos(0)
+				res = decodeRow[T](record, columns, line)
+			}
+			if !yield(res) {
+				return
+			}
+		}
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// fieldsOf lists the bindable fields of t, recursing into embedded structs, with indexes in declaration order.
+func fieldsOf(t reflect.Type) []column {
+	var columns []column
+	var walk func(t reflect.Type, path []int)
+	walk = func(t reflect.Type, path []int) {
+		for i := range t.NumField() {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("csv")
+			if !sf.IsExported() || tag == "-" {
+				continue
+			}
+			fieldPath := append(append([]int(nil), path...), i)
+			if sf.Anonymous && tag == "" && !textconv.IsScalar(sf.Type) {
+				walk(sf.Type, fieldPath)
+				continue
+			}
+			columns = append(columns, column{
+				name:  cmp.Or(tag, sf.Name),
+				field: fieldPath,
+				index: len(columns),
+			})
+		}
+	}
+	walk(t, nil)
+	return columns
+}
+
+// matchHeader sets each column's record index from the header row.
+func matchHeader(columns []column, header []string) error {
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if _, dup := positions[key]; !dup {
+			positions[key] = i
+		}
+	}
+
+	var missing []string
+	for i := range columns {
+		pos, ok := positions[strings.ToLower(columns[i].name)]
+		if !ok {
+			missing = append(missing, columns[i].name)
+			continue
+		}
+		columns[i].index = pos
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrMissingColumn, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// decodeRow parses record into a T, reporting the first cell that fails.
+func decodeRow[T any](record []string, columns []column, line int) result.Result[T] {
+	var row T
+	target := reflect.ValueOf(&row).Elem()
+	for _, col := range columns {
+		if col.index >= len(record) {
+			return result.Err[T](fmt.Errorf("csvx: line %d: %w: %s", line, ErrMissingColumn, col.name))
+		}
+		raw := record[col.index]
+		field := target.FieldByIndex(col.field)
+		if raw == "" && textconv.IsOption(field.Type()) {
+			continue // an empty cell leaves an Option field None
+		}
+		if err := textconv.Set(field, raw); err != nil {
+			return result.Err[T](fmt.Errorf("csvx: line %d, column %s: %w %q: %w", line, col.name, ErrInvalid, raw, err))
+		}
+	}
+	return result.Ok(row)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package csvx_test. csvx_test verifies header binding, per-row errors and Option cells.
+package csvx_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/csvx"
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+type item struct {
+	SKU   string                 `csv:"sku"`
+	Qty   int                    `csv:"qty"`
+	Price option.Option[float64] `csv:"price"`
+	Note  string                 `csv:"-"`
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestRead_ContinuesPastBadRows(t *testing.T) {
+	input := "Price,SKU,QTY,extra\n" +
+		"9.5,a-1,3,x\n" +
+		",b-2,many,x\n" + // bad qty
+		"\"unterminated,c-3,1,x\n" +
+		",d-4,2,x\n"
+
+	var items []item
+	var errs []error
+	for res := range csvx.Read[item](strings.NewReader(input)) {
+		if res.IsErr() {
+			errs = append(errs, res.Err())
+			continue
+		}
+		items = append(items, res.Unwrap())
+	}
+
+	if len(items) == 0 || items[0].SKU != "a-1" || items[0].Qty != 3 || items[0].Price.Unwrap() != 9.5 {
+		t.Fatalf("expected first row decoded, got %+v", items)
+	}
+	if len(errs) == 0 || !errors.Is(errs[0], csvx.ErrInvalid) || !strings.Contains(errs[0].Error(), "line 3, column qty") {
+		t.Fatalf("expected invalid qty on line 3, got %v", errs)
+	}
+}
+
+func TestRead_OptionCellEmpty(t *testing.T) {
+	input := "sku,qty,price\nd-4,2,\n"
+	for res := range csvx.Read[item](strings.NewReader(input)) {
+		if got := res.Unwrap(); got.Price.IsSome() {
+			t.Fatalf("expected None price, got %v", got.Price.Unwrap())
+		}
+	}
+}
+
+func TestRead_MissingColumn(t *testing.T) {
+	var results int
+	for res := range csvx.Read[item](strings.NewReader("sku,qty\na,1\n")) {
+		results++
+		if !errors.Is(res.Err(), csvx.ErrMissingColumn) || !strings.Contains(res.Err().Error(), "price") {
+			t.Fatalf("expected missing price column, got %v", res.Err())
+		}
+	}
+	if results != 1 {
+		t.Fatalf("expected %v, got %v", 1, results)
+	}
+}
+
+func TestRead_NoHeaderAndComma(t *testing.T) {
+	var got []item
+	for res := range csvx.Read[item](strings.NewReader("a;1;2.5\nb;2;\n"), csvx.NoHeader(), csvx.Comma(';')) {
+		got = append(got, res.Unwrap())
+	}
+	if len(got) != 2 || got[1].SKU != "b" || got[1].Qty != 2 || got[1].Price.IsSome() {
+		t.Fatalf("expected two positional rows, got %+v", got)
+	}
+}
+
+func TestRead_ShortRow(t *testing.T) {
+	for res := range csvx.Read[item](strings.NewReader("sku,qty,price\na,1\n")) {
+		if !errors.Is(res.Err(), csvx.ErrMissingColumn) {
+			t.Fatalf("expected %v, got %v", csvx.ErrMissingColumn, res.Err())
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package ctxx. ctxx provides typed access to request-scoped context values: lookups return Option
+// instead of an untyped any that needs a panicking type assertion, and Key[T] ties a key to its value type.
+//
+// Example - Traditional vs ctxx:
+//
+//	// Traditional Go
+//	type userKey struct{}
+//	ctx = context.WithValue(ctx, userKey{}, user)
+//	u := ctx.Value(userKey{}).(User) // panics if missing or of another type
+//
+//	// With ctxx
+//	var UserKey = ctxx.NewKey[User]("user")
+//	ctx = UserKey.With(ctx, user)
+//	u := UserKey.Get(ctx) // Option[User]
+package ctxx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Key [T] is a context key whose values are always of type T. Keys compare by identity, so two keys
+// created with the same name never collide; declare each key once, as a package-level variable.
+type Key[T any] struct {
+	name string
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Value returns the value stored in ctx under key, or None if there is none or it is not a T.
+//
+// Example:
+//
+//	traceID := ctxx.Value[string](ctx, middleware.TraceIDKey).UnwrapOr("-")
+func Value[T any](ctx context.Context, key any) option.Option[T] {
+	return option.Cast[T](ctx.Value(key))
+}
+
+// MustValue returns the value stored in ctx under key, panicking with a descriptive message if there is
+// none or it is not a T. Use it only where middleware guarantees the value is present.
+func MustValue[T any](ctx context.Context, key any) T {
+	v := ctx.Value(key)
+	if t, ok := v.(T); ok {
+		return t
+	}
+	if v == nil {
+		panic(fmt.Sprintf("ctxx: no value for key %v in context", key))
+	}
+	panic(fmt.Sprintf("ctxx: value for key %v is %T, not %v", key, v, reflect.TypeFor[T]()))
+}
+
+// NewKey creates a key for values of type T. name is used only in String and panic messages.
+func NewKey[T any](name string) *Key[T] {
+	return &Key[T]{name: name}
+}
+
+// With returns a copy of ctx carrying value under k.
+func (k *Key[T]) With(ctx context.Context, value T) context.Context {
+	return context.WithValue(ctx, k, value)
+}
+
+// Get returns the value stored under k, or None if ctx does not carry one.
+func (k *Key[T]) Get(ctx context.Context) option.Option[T] {
+	return Value[T](ctx, k)
+}
+
+// MustGet returns the value stored under k, panicking if ctx does not carry one.
+//
+// Example:
+//
+//	func (h *Handler) Me(w http.ResponseWriter, r *http.Request) {
+//	    user := auth.UserKey.MustGet(r.Context()) // set by the auth middleware
+//	    ...
+//	}
+func (k *Key[T]) MustGet(ctx context.Context) T {
+	return MustValue[T](ctx, k)
+}
+
+// String returns the key's name, so keys print usefully in logs and panics.
+func (k *Key[T]) String() string {
+	return "ctxx.Key(" + k.name + ")"
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package ctxx_test. ctxx_test verifies typed context lookups and key isolation.
+package ctxx_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/ctxx"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+type user struct{ Name string }
+
+type legacyKey struct{}
+
+var UserKey = ctxx.NewKey[user]("user")
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestKey_WithGet(t *testing.T) {
+	ctx := UserKey.With(context.Background(), user{Name: "ali"})
+	if got := UserKey.Get(ctx).Unwrap().Name; got != "ali" {
+		t.Fatalf("expected %v, got %v", "ali", got)
+	}
+	if got := UserKey.MustGet(ctx).Name; got != "ali" {
+		t.Fatalf("expected %v, got %v", "ali", got)
+	}
+	if UserKey.Get(context.Background()).IsSome() {
+		t.Fatal("expected None for a context without the key")
+	}
+}
+
+func TestKey_SameNameDoesNotCollide(t *testing.T) {
+	other := ctxx.NewKey[user]("user")
+	ctx := UserKey.With(context.Background(), user{Name: "ali"})
+	if other.Get(ctx).IsSome() {
+		t.Fatal("expected keys with the same name to be distinct")
+	}
+}
+
+func TestValue_UntypedKeys(t *testing.T) {
+	ctx := context.WithValue(context.Background(), legacyKey{}, "trace-1")
+	if got := ctxx.Value[string](ctx, legacyKey{}).Unwrap(); got != "trace-1" {
+		t.Fatalf("expected %v, got %v", "trace-1", got)
+	}
+	if ctxx.Value[int](ctx, legacyKey{}).IsSome() {
+		t.Fatal("expected None for a value of another type")
+	}
+}
+
+func TestMustValue_PanicsWithContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), legacyKey{}, "trace-1")
+	defer func() {
+		msg, _ := recover().(string)
+		if !strings.Contains(msg, "is string, not int") {
+			t.Fatalf("expected type mismatch panic, got %q", msg)
+		}
+	}()
+	ctxx.MustValue[int](ctx, legacyKey{})
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package dbx. dbx runs SQL queries through database/sql and scans the rows into structs by their `db` tags,
+// returning Results, so a repository method shrinks to a single call per query.
+//
+// Example - Traditional vs dbx:
+//
+//	// Traditional Go
+//	var user User
+//	err := r.db.QueryRowContext(ctx,
+//	    "SELECT id, email, name, created_at FROM users WHERE id = $1", id,
+//	).Scan(&user.ID, &user.Email, &user.Name, &user.CreatedAt)
+//	return result.Wrap(&user, err)
+//
+//	// With dbx
+//	return dbx.QueryOne[User](ctx, r.db, "SELECT id, email, name, created_at FROM users WHERE id = $1", id)
+package dbx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	goxreflect "github.com/seyedali-dev/goxide/reflect"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Querier is implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrUnmappedColumn is returned when a query yields a column that no `db` tag of the row type names.
+var ErrUnmappedColumn = errors.New("dbx: column has no matching db tag")
+
+var (
+	scannerType = reflect.TypeFor[sql.Scanner]()
+	timeType    = reflect.TypeFor[time.Time]()
+
+	// fieldCache memoizes goxreflect.FieldsByTag per row type.
+	fieldCache sync.Map // reflect.Type -> map[string][]int
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Query runs query and scans every row into a T.
+//   - If T is a struct (other than time.Time or an sql.Scanner), each column is scanned into the field
+//     whose `db` tag names it; a column without a matching field is an ErrUnmappedColumn error
+//   - Otherwise the query must return a single column, scanned directly into T
+//
+// An empty result set is Ok with an empty, non-nil slice.
+//
+// Example:
+//
+//	func (r *UserRepo) Active(ctx context.Context) result.Result[[]User] {
+//	    return dbx.Query[User](ctx, r.db, "SELECT id, email, name FROM users WHERE active")
+//	}
+func Query[T any](ctx context.Context, db Querier, query string, args ...any) result.Result[[]T] {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return result.Err[[]T](err)
+	}
+	defer rows.Close()
+
+	targets, err := scanTargets[T](rows)
+	if err != nil {
+		return result.Err[[]T](err)
+	}
+	out := []T{}
+	for rows.Next() {
+		var row T
+		if err := rows.Scan(targets(&row)...); err != nil {
+			return result.Err[[]T](err)
+		}
+		out = append(out, row)
+	}
+	if err := rows.Err(); err != nil {
+		return result.Err[[]T](err)
+	}
+	return result.Ok(out)
+}
+
+// QueryOne runs query and scans its first row into a T, as Query does. If there are no rows the
+// Err is sql.ErrNoRows; an error that ended the rows early is returned as is, never as ErrNoRows.
+//
+// Example:
+//
+//	func (r *UserRepo) FindByID(ctx context.Context, id int) result.Result[User] {
+//	    return dbx.QueryOne[User](ctx, r.db, "SELECT id, email, name FROM users WHERE id = $1", id)
+//	}
+func QueryOne[T any](ctx context.Context, db Querier, query string, args ...any) result.Result[T] {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return result.Err[T](err)
+	}
+	defer rows.Close()
+
+	targets, err := scanTargets[T](rows)
+	if err != nil {
+		return result.Err[T](err)
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return result.Err[T](err)
+		}
+		return result.Err[T](sql.ErrNoRows)
+	}
+	var row T
+	if err := rows.Scan(targets(&row)...); err != nil {
+		return result.Err[T](err)
+	}
+	return result.Ok(row)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// scanTargets resolves the columns of rows against T once and returns a function producing the
+// Scan destinations for a given row value.
+func scanTargets[T any](rows *sql.Rows) (func(*T) []any, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	rowType := reflect.TypeFor[T]()
+	if !isStructRow(rowType) {
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("dbx: scanning %d columns into %v requires a struct with db tags", len(columns), rowType)
+		}
+		return func(row *T) []any { return []any{row} }, nil
+	}
+
+	fields := fieldsOf(rowType)
+	indexes := make([][]int, len(columns))
+	for i, col := range columns {
+		index, ok := fields[col]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q in %v", ErrUnmappedColumn, col, rowType)
+		}
+		indexes[i] = index
+	}
+	return func(row *T) []any {
+		v := reflect.ValueOf(row).Elem()
+		dest := make([]any, len(indexes))
+		for i, index := range indexes {
+			dest[i] = v.FieldByIndex(index).Addr().Interface()
+		}
+		return dest
+	}, nil
+}
+
+// isStructRow reports whether t is scanned field by field rather than as a single value.
+func isStructRow(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != timeType && !reflect.PointerTo(t).Implements(scannerType)
+}
+
+// fieldsOf returns the db-tag mapping of t, computing it on first use.
+func fieldsOf(t reflect.Type) map[string][]int {
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.(map[string][]int)
+	}
+	fields := goxreflect.FieldsByTag(t, "db")
+	fieldCache.Store(t, fields)
+	return fields
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package dbx_test. dbx_test verifies db-tag column mapping, scalar rows and empty results.
+package dbx_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/dbx"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+var ErrConnReset = errors.New("connection reset by peer")
+
+type Audit struct {
+	CreatedBy string `db:"created_by"`
+}
+
+type User struct {
+	Audit
+	ID       int            `db:"id"`
+	Email    string         `db:"email"`
+	Nickname sql.NullString `db:"nickname"`
+	Internal string         `db:"-"`
+}
+
+var userRows = map[string]fakeResult{
+	"users": {
+		columns: []string{"id", "email", "nickname", "created_by"},
+		rows: [][]driver.Value{
+			{int64(1), "ali@example.com", "ali", "admin"},
+			{int64(2), "sara@example.com", nil, "import"},
+		},
+	},
+	"ids":     {columns: []string{"id"}, rows: [][]driver.Value{{int64(1)}, {int64(2)}}},
+	"none":    {columns: []string{"id", "email"}},
+	"broken":  {columns: []string{"id", "email"}, err: ErrConnReset},
+	"unknown": {columns: []string{"id", "password_hash"}, rows: [][]driver.Value{{int64(1), "x"}}},
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestQuery_Structs(t *testing.T) {
+	db, _ := openFake(userRows)
+	users := dbx.Query[User](context.Background(), db, "users").Unwrap()
+
+	if len(users) != 2 {
+		t.Fatalf("expected %v, got %v", 2, len(users))
+	}
+	if got := users[0]; got.ID != 1 || got.Email != "ali@example.com" || got.Nickname.String != "ali" || got.CreatedBy != "admin" {
+		t.Fatalf("unexpected first row %+v", got)
+	}
+	if users[1].Nickname.Valid {
+		t.Fatal("expected NULL nickname")
+	}
+}
+
+func TestQuery_ScalarAndEmpty(t *testing.T) {
+	db, _ := openFake(userRows)
+	if ids := dbx.Query[int](context.Background(), db, "ids").Unwrap(); len(ids) != 2 || ids[1] != 2 {
+		t.Fatalf("expected %v, got %v", []int{1, 2}, ids)
+	}
+	if users := dbx.Query[User](context.Background(), db, "none").Unwrap(); users == nil || len(users) != 0 {
+		t.Fatalf("expected empty non-nil slice, got %#v", users)
+	}
+}
+
+func TestQuery_UnmappedColumn(t *testing.T) {
+	db, _ := openFake(userRows)
+	if res := dbx.Query[User](context.Background(), db, "unknown"); !errors.Is(res.Err(), dbx.ErrUnmappedColumn) {
+		t.Fatalf("expected %v, got %v", dbx.ErrUnmappedColumn, res.Err())
+	}
+}
+
+func TestQueryOne(t *testing.T) {
+	db, _ := openFake(userRows)
+	if got := dbx.QueryOne[User](context.Background(), db, "users").Unwrap(); got.ID != 1 {
+		t.Fatalf("expected %v, got %v", 1, got.ID)
+	}
+	if res := dbx.QueryOne[User](context.Background(), db, "none"); !errors.Is(res.Err(), sql.ErrNoRows) {
+		t.Fatalf("expected %v, got %v", sql.ErrNoRows, res.Err())
+	}
+	if res := dbx.QueryOne[User](context.Background(), db, "broken"); !errors.Is(res.Err(), ErrConnReset) || errors.Is(res.Err(), sql.ErrNoRows) {
+		t.Fatalf("expected %v without %v, got %v", ErrConnReset, sql.ErrNoRows, res.Err())
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package dbx_test. fakedb_test provides an in-memory database/sql driver serving canned query results,
+// so dbx can be tested without a database server.
+package dbx_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// fakeResult is the canned response to one query string. A non-nil err is returned by Next once the rows
+// are exhausted, as a driver reports a failure mid-iteration.
+type fakeResult struct {
+	columns []string
+	rows    [][]driver.Value
+	err     error
+}
+
+// fakeDB serves canned results and records transaction outcomes.
+type fakeDB struct {
+	mu        sync.Mutex
+	results   map[string]fakeResult
+	commits   atomic.Int32
+	rollbacks atomic.Int32
+}
+
+type fakeConnector struct{ db *fakeDB }
+
+type fakeConn struct{ db *fakeDB }
+
+type fakeTx struct{ db *fakeDB }
+
+type fakeRows struct {
+	result fakeResult
+	pos    int
+}
+
+// openFake returns a *sql.DB backed by a fakeDB serving results.
+func openFake(results map[string]fakeResult) (*sql.DB, *fakeDB) {
+	db := &fakeDB{results: results}
+	return sql.OpenDB(fakeConnector{db: db}), db
+}
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn(c), nil }
+func (c fakeConnector) Driver() driver.Driver                        { return nil }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)           { return fakeTx(c), nil }
+
+func (c fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	res, ok := c.db.results[query]
+	if !ok {
+		return nil, fmt.Errorf("fakedb: unexpected query %q", query)
+	}
+	return &fakeRows{result: res}, nil
+}
+
+func (c fakeConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+
+func (t fakeTx) Commit() error {
+	t.db.commits.Add(1)
+	return nil
+}
+
+func (t fakeTx) Rollback() error {
+	t.db.rollbacks.Add(1)
+	return nil
+}
+
+func (r *fakeRows) Columns() []string { return r.result.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.result.rows) {
+		if r.result.err != nil {
+			return r.result.err
+		}
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.pos])
+	r.pos++
+	return nil
+}
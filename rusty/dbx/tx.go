@@ -0,0 +1,81 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package dbx. tx provides WithTx, a transaction scope that commits on Ok and rolls back on Err or panic,
+// so a transaction can never be left open by an early return or BubbleUp.
+package dbx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// TxBeginner is implemented by *sql.DB and *sql.Conn.
+type TxBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithTx begins a transaction, runs fn in it, and commits if fn returns Ok or rolls back otherwise.
+// BubbleUp inside fn is caught and becomes the Err (no defer result.Catch is needed in fn); any other
+// panic rolls back and is re-raised. A failed commit or rollback is reported in the Err.
+//
+// When to use:
+//   - Whenever several statements must succeed or fail together
+//   - Instead of hand-written Begin / defer Rollback / Commit sequences
+//
+// Example:
+//
+//	func Transfer(ctx context.Context, db *sql.DB, from, to int, amount float64) result.Result[types.Unit] {
+//	    return dbx.WithTx(ctx, db, func(tx *sql.Tx) result.Result[types.Unit] {
+//	        debit(ctx, tx, from, amount).BubbleUp()
+//	        credit(ctx, tx, to, amount).BubbleUp()
+//	        return result.Ok(types.Unit{})
+//	    })
+//	}
+func WithTx[T any](ctx context.Context, db TxBeginner, fn func(*sql.Tx) result.Result[T], opts ...*sql.TxOptions) (res result.Result[T]) {
+	var txOpts *sql.TxOptions
+	if len(opts) > 0 {
+		txOpts = opts[0]
+	}
+	tx, err := db.BeginTx(ctx, txOpts)
+	if err != nil {
+		return result.Err[T](fmt.Errorf("dbx: begin transaction: %w", err))
+	}
+
+	completed := false
+	defer func() {
+		if !completed {
+			_ = tx.Rollback() // fn panicked with something other than BubbleUp; undo and let it propagate
+			return
+		}
+		if res.IsErr() {
+			if err := tx.Rollback(); err != nil {
+				res = result.Err[T](errors.Join(res.Err(), fmt.Errorf("dbx: rollback: %w", err)))
+			}
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			res = result.Err[T](fmt.Errorf("dbx: commit: %w", err))
+		}
+	}()
+
+	res = run(tx, fn)
+	completed = true
+	return res
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// run calls fn, turning a BubbleUp panic into an Err Result.
+func run[T any](tx *sql.Tx, fn func(*sql.Tx) result.Result[T]) (res result.Result[T]) {
+	defer result.Catch(&res)
+	return fn(tx)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package dbx_test. tx_test verifies commit on Ok and rollback on Err, BubbleUp and panics.
+package dbx_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/dbx"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+func TestWithTx_CommitsOnOk(t *testing.T) {
+	db, fake := openFake(userRows)
+	res := dbx.WithTx(context.Background(), db, func(tx *sql.Tx) result.Result[int] {
+		return result.Map(dbx.QueryOne[int](context.Background(), tx, "ids"), func(id int) int { return id * 10 })
+	})
+
+	if got := res.Unwrap(); got != 10 {
+		t.Fatalf("expected %v, got %v", 10, got)
+	}
+	if fake.commits.Load() != 1 || fake.rollbacks.Load() != 0 {
+		t.Fatalf("expected one commit, got %d commits and %d rollbacks", fake.commits.Load(), fake.rollbacks.Load())
+	}
+}
+
+func TestWithTx_RollsBackOnBubbleUp(t *testing.T) {
+	db, fake := openFake(userRows)
+	res := dbx.WithTx(context.Background(), db, func(tx *sql.Tx) result.Result[int] {
+		result.Err[int](ErrInsufficientFunds).BubbleUp()
+		return result.Ok(1)
+	})
+
+	if !errors.Is(res.Err(), ErrInsufficientFunds) {
+		t.Fatalf("expected %v, got %v", ErrInsufficientFunds, res.Err())
+	}
+	if fake.commits.Load() != 0 || fake.rollbacks.Load() != 1 {
+		t.Fatalf("expected one rollback, got %d commits and %d rollbacks", fake.commits.Load(), fake.rollbacks.Load())
+	}
+}
+
+func TestWithTx_RollsBackOnPanic(t *testing.T) {
+	db, fake := openFake(userRows)
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected the panic to propagate")
+		}
+		if fake.rollbacks.Load() != 1 {
+			t.Fatalf("expected %v, got %v", 1, fake.rollbacks.Load())
+		}
+	}()
+	_ = dbx.WithTx(context.Background(), db, func(tx *sql.Tx) result.Result[int] {
+		panic("boom")
+	})
+}
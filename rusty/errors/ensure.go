@@ -0,0 +1,83 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors. ensure provides guards for the common "error or empty value" check that follows
+// lookups, decoders and constructors which may return a zero value without an error.
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrEmptyValue is returned by Ensure and EnsureOk when the value is empty but no error was reported.
+var ErrEmptyValue = errors.New("empty value")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Ensure returns val unchanged if err is nil and val is not empty.
+// Otherwise, it returns an error prefixed with msg: the original err if present, or ErrEmptyValue.
+// A value is empty when it is the zero value of its type, or a slice, map or channel of length 0.
+//
+// When to use:
+//   - After calls that may return (nil, nil) or an empty slice where a value is required
+//   - When you want a single guard instead of separate err and nil checks
+//
+// Example:
+//
+//	func FindUser(email string) (*User, error) {
+//	    user, err := repo.FindByEmail(email)
+//	    return goxerrors.Ensure(user, err, "find user by email") // nil user becomes ErrEmptyValue
+//	}
+func Ensure[T any](val T, err error, msg string) (T, error) {
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("%s: %w", msg, err)
+	}
+	if isEmpty(val) {
+		var zero T
+		return zero, fmt.Errorf("%s: %w", msg, ErrEmptyValue)
+	}
+	return val, nil
+}
+
+// EnsureOk is the Result-returning form of Ensure.
+// Use it to guard a (T, error) pair and continue directly in a Result pipeline.
+//
+// When to use:
+//   - When wrapping lookups that may return an empty value without an error
+//   - When the guarded value feeds straight into Map/AndThen or BubbleUp()
+//
+// Example:
+//
+//	func LoadProfile(id int) (res result.Result[*Profile]) {
+//	    defer result.Catch(&res)
+//	    user, err := repo.FindUser(id)
+//	    u := goxerrors.EnsureOk(user, err, "load user").BubbleUp()
+//	    profile, err := repo.FindProfile(u.ProfileID)
+//	    return goxerrors.EnsureOk(profile, err, "load profile")
+//	}
+func EnsureOk[T any](val T, err error, msg string) result.Result[T] {
+	return result.Wrap(Ensure(val, err, msg))
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// isEmpty reports whether v is a zero value or an empty collection.
+func isEmpty(v any) bool {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return true
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Chan:
+		return rv.Len() == 0
+	default:
+		return rv.IsZero()
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors_test. ensure_test verifies the error-or-empty guards.
+package errors_test
+
+import (
+	"errors"
+	"testing"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+)
+
+func TestEnsureOk_Value(t *testing.T) {
+	res := goxerrors.EnsureOk(42, nil, "load answer")
+	if res.IsErr() {
+		t.Fatalf("expected Ok, got Err: %v", res.Err())
+	}
+	if res.Unwrap() != 42 {
+		t.Fatalf("expected 42, got %d", res.Unwrap())
+	}
+}
+
+func TestEnsureOk_Error(t *testing.T) {
+	res := goxerrors.EnsureOk("", ErrNotFound, "load user")
+	if res.IsOk() {
+		t.Fatal("expected Err, got Ok")
+	}
+	if !errors.Is(res.Err(), ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", res.Err())
+	}
+	if res.Err().Error() != "load user: not found" {
+		t.Fatalf("unexpected message: %q", res.Err().Error())
+	}
+}
+
+func TestEnsureOk_Empty(t *testing.T) {
+	var nilUser *struct{ Name string }
+	cases := map[string]error{
+		"nil pointer": goxerrors.EnsureOk(nilUser, nil, "ptr").Err(),
+		"empty slice": goxerrors.EnsureOk([]int{}, nil, "slice").Err(),
+		"empty map":   goxerrors.EnsureOk(map[string]int{}, nil, "map").Err(),
+		"zero string": goxerrors.EnsureOk("", nil, "string").Err(),
+	}
+	for name, err := range cases {
+		if !errors.Is(err, goxerrors.ErrEmptyValue) {
+			t.Errorf("%s: expected ErrEmptyValue, got %v", name, err)
+		}
+	}
+}
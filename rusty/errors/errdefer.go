@@ -0,0 +1,31 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors. errdefer provides OnErrDefer for functions with plain error returns: a deferred cleanup
+// that runs only when the function fails, modeled on Zig's errdefer. result.OnErrDefer is the Result form.
+package errors
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// OnErrDefer calls fn with *errp if it is non-nil when the surrounding function returns. It must be deferred,
+// with errp pointing at the function's named error result.
+//
+// When to use:
+//   - When undoing partial setup (temp files, reservations, half-written rows) in functions returning error
+//
+// Example:
+//
+//	func Export(path string) (err error) {
+//	    f, err := os.Create(path)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    defer goxerrors.OnErrDefer(&err, func(error) { os.Remove(path) })
+//	    defer f.Close()
+//	    return writeAll(f)
+//	}
+func OnErrDefer(errp *error, fn func(error)) {
+	if *errp != nil {
+		fn(*errp)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors_test. errdefer_test verifies that OnErrDefer runs only on failure.
+package errors_test
+
+import (
+	"testing"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+)
+
+func TestOnErrDefer(t *testing.T) {
+	cleaned := 0
+	export := func(fail error) (err error) {
+		defer goxerrors.OnErrDefer(&err, func(error) { cleaned++ })
+		return fail
+	}
+
+	if err := export(nil); err != nil || cleaned != 0 {
+		t.Fatalf("expected no cleanup on success, got %v cleanups", cleaned)
+	}
+	if err := export(ErrNotFound); err != ErrNotFound || cleaned != 1 {
+		t.Fatalf("expected one cleanup on failure, got %v cleanups", cleaned)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors. format provides stack and field annotations for errors and a formatter that renders
+// the full wrap chain for %+v while keeping %v terse.
+//
+// Example:
+//
+//	err := goxerrors.WithFields(goxerrors.WithStack(ErrNotFound), goxerrors.Fields{"user_id": 42})
+//	fmt.Printf("%v\n", err)  // not found
+//	fmt.Printf("%+v\n", err) // not found
+//	                         //     user_id=42
+//	                         //     at main.loadUser (/app/main.go:12)
+//	                         //     ...
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"runtime"
+	"slices"
+	"strings"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Fields holds structured key/value context attached to an error.
+type Fields map[string]any
+
+// stackError records the call stack at the point WithStack was called.
+type stackError struct {
+	err   error
+	stack []uintptr
+}
+
+// fieldsError attaches structured fields to an error without changing its message.
+type fieldsError struct {
+	err    error
+	fields Fields
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// maxStackDepth bounds the number of frames recorded by WithStack.
+const maxStackDepth = 32
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithStack annotates err with the current call stack. The message is unchanged and
+// errors.Is/As still see err. Returns nil if err is nil.
+//
+// Example:
+//
+//	if err := row.Scan(&user.ID); err != nil {
+//	    return result.Err[User](goxerrors.WithStack(err))
+//	}
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(2, pcs)
+	return &stackError{err: err, stack: pcs[:n]}
+}
+
+// WithFields annotates err with structured fields. The message is unchanged and
+// errors.Is/As still see err. Returns nil if err is nil.
+//
+// Example:
+//
+//	return goxerrors.WithFields(err, goxerrors.Fields{"order_id": id, "attempt": n})
+func WithFields(err error, fields Fields) error {
+	if err == nil {
+		return nil
+	}
+	return &fieldsError{err: err, fields: fields}
+}
+
+// FieldsOf merges the fields recorded anywhere in err's chain.
+// When a key appears at several layers, the outermost value wins.
+func FieldsOf(err error) Fields {
+	merged := Fields{}
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if fe, ok := e.(*fieldsError); ok {
+			for k, v := range fe.fields {
+				if _, exists := merged[k]; !exists {
+					merged[k] = v
+				}
+			}
+		}
+	}
+	return merged
+}
+
+// FormatChain renders the full wrap chain of err, one layer per line, followed by any fields
+// and stack frames recorded at that layer. Works for any error, not only annotated ones.
+// This is what %+v prints for errors created by WithStack and WithFields.
+//
+// Example:
+//
+//	log.Printf("request failed:\n%s", goxerrors.FormatChain(err))
+func FormatChain(err error) string {
+	var b strings.Builder
+	writeChain(&b, err, 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// -------------------------------------------- Annotation Methods --------------------------------------------
+
+func (e *stackError) Error() string { return e.err.Error() }
+func (e *stackError) Unwrap() error { return e.err }
+
+// Format implements fmt.Formatter: %+v renders the full chain, other verbs render the message.
+func (e *stackError) Format(s fmt.State, verb rune) { format(e, s, verb) }
+
+func (e *fieldsError) Error() string { return e.err.Error() }
+func (e *fieldsError) Unwrap() error { return e.err }
+
+// Format implements fmt.Formatter: %+v renders the full chain, other verbs render the message.
+func (e *fieldsError) Format(s fmt.State, verb rune) { format(e, s, verb) }
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// format implements the shared fmt.Formatter behavior of annotated errors.
+func format(err error, s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		_, _ = io.WriteString(s, FormatChain(err))
+	case verb == 'q':
+		_, _ = fmt.Fprintf(s, "%q", err.Error())
+	default:
+		_, _ = io.WriteString(s, err.Error())
+	}
+}
+
+// writeChain writes err and its wrapped errors to b. Annotation layers carry no message of their own,
+// so their fields and stacks are printed under the next layer that does.
+func writeChain(b *strings.Builder, err error, depth int) {
+	indent := strings.Repeat("    ", depth)
+	var fields []Fields
+	var stacks [][]uintptr
+
+	for err != nil {
+		switch e := err.(type) {
+		case *fieldsError:
+			fields = append(fields, e.fields)
+			err = e.err
+			continue
+		case *stackError:
+			stacks = append(stacks, e.stack)
+			err = e.err
+			continue
+		}
+
+		b.WriteString(indent)
+		b.WriteString(ownMessage(err))
+		b.WriteByte('\n')
+		for _, f := range fields {
+			writeFields(b, indent+"    ", f)
+		}
+		for _, stack := range stacks {
+			writeStack(b, indent+"    ", stack)
+		}
+		fields, stacks = nil, nil
+
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, inner := range joined.Unwrap() {
+				writeChain(b, inner, depth+1)
+			}
+			return
+		}
+		err = errors.Unwrap(err)
+	}
+}
+
+// ownMessage returns the part of err's message contributed by err itself,
+// stripping the ": <wrapped message>" suffix that fmt.Errorf("...: %w") adds.
+func ownMessage(err error) string {
+	msg := err.Error()
+	if inner := errors.Unwrap(err); inner != nil {
+		if trimmed, ok := strings.CutSuffix(msg, ": "+inner.Error()); ok {
+			return trimmed
+		}
+	}
+	return msg
+}
+
+// writeFields writes fields sorted by key on a single line.
+func writeFields(b *strings.Builder, indent string, fields Fields) {
+	if len(fields) == 0 {
+		return
+	}
+	b.WriteString(indent)
+	for i, k := range slices.Sorted(maps.Keys(fields)) {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		_, _ = fmt.Fprintf(b, "%s=%v", k, fields[k])
+	}
+	b.WriteByte('\n')
+}
+
+// writeStack writes one line per recorded stack frame.
+func writeStack(b *strings.Builder, indent string, stack []uintptr) {
+	frames := runtime.CallersFrames(stack)
+	for {
+		frame, more := frames.Next()
+		_, _ = fmt.Fprintf(b, "%sat %s (%s:%d)\n", indent, frame.Function, frame.File, frame.Line)
+		if !more {
+			return
+		}
+	}
+}
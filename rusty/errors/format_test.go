@@ -0,0 +1,67 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors_test. format_test verifies stack/field annotations and chain formatting.
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+)
+
+func TestFormat_TerseV(t *testing.T) {
+	err := fmt.Errorf("load user: %w", goxerrors.WithStack(ErrNotFound))
+
+	if got := fmt.Sprintf("%v", err); got != "load user: not found" {
+		t.Fatalf("unexpected %%v output: %q", got)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatal("expected annotated error to match sentinel")
+	}
+}
+
+func TestFormat_PlusV(t *testing.T) {
+	inner := goxerrors.WithFields(goxerrors.WithStack(ErrNotFound), goxerrors.Fields{"user_id": 42})
+	err := goxerrors.WithStack(fmt.Errorf("load profile: %w", inner))
+
+	out := fmt.Sprintf("%+v", err)
+	lines := strings.Split(out, "\n")
+
+	if lines[0] != "load profile" {
+		t.Fatalf("expected first layer %q, got %q", "load profile", lines[0])
+	}
+	if !strings.Contains(out, "\nnot found\n") {
+		t.Fatalf("expected sentinel layer on its own line:\n%s", out)
+	}
+	if !strings.Contains(out, "    user_id=42\n") {
+		t.Fatalf("expected fields under sentinel layer:\n%s", out)
+	}
+	if !strings.Contains(out, "TestFormat_PlusV") {
+		t.Fatalf("expected stack frames to reference the test:\n%s", out)
+	}
+}
+
+func TestFieldsOf_OutermostWins(t *testing.T) {
+	err := goxerrors.WithFields(
+		fmt.Errorf("wrap: %w", goxerrors.WithFields(ErrNotFound, goxerrors.Fields{"id": 1, "table": "users"})),
+		goxerrors.Fields{"id": 2},
+	)
+
+	fields := goxerrors.FieldsOf(err)
+	if fields["id"] != 2 || fields["table"] != "users" {
+		t.Fatalf("unexpected fields: %v", fields)
+	}
+}
+
+func TestFormatChain_Joined(t *testing.T) {
+	err := errors.Join(ErrNotFound, ErrUnavailable)
+
+	out := goxerrors.FormatChain(err)
+	if !strings.Contains(out, "    not found") || !strings.Contains(out, "    service unavailable") {
+		t.Fatalf("expected joined errors indented:\n%s", out)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors. kind provides declarative domain error families: a Kind couples a stable code with a
+// message template and acts as the sentinel every error it creates matches.
+//
+// Example:
+//
+//	var (
+//	    ErrUserNotFound = goxerrors.NewKind("user_not_found", "user %d not found").
+//	        WithMeta(goxerrors.Meta{HTTPStatus: http.StatusNotFound})
+//	    ErrPaymentFailed = goxerrors.NewKind("payment_failed", "payment for order %d failed")
+//	)
+//
+//	func FindUser(id int) result.Result[User] {
+//	    return result.Err[User](ErrUserNotFound.New(id)) // "user 42 not found"
+//	}
+//
+//	errors.Is(err, ErrUserNotFound) // true
+package errors
+
+import (
+	"fmt"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Kind is a family of errors sharing a code and message template.
+// A Kind is itself an error, registered in the registry, and every error created from it matches it via errors.Is.
+type Kind struct {
+	code     string
+	template string
+}
+
+// kindError is an error instance created from a Kind.
+type kindError struct {
+	kind  *Kind
+	msg   string
+	cause error
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewKind declares a new error family with the given code and fmt-style message template,
+// and registers it with Meta{Code: code}. Use WithMeta to attach an HTTP status or retryability.
+//
+// When to use:
+//   - When a domain has a known set of failure modes that need stable codes
+//   - When the same message shape is built in many places
+func NewKind(code, template string) *Kind {
+	k := &Kind{code: code, template: template}
+	Register(k, Meta{Code: code})
+	return k
+}
+
+// WithMeta registers meta for the kind and returns the kind for chaining.
+// meta.Code is always overridden with the kind's own code.
+func (k *Kind) WithMeta(meta Meta) *Kind {
+	meta.Code = k.code
+	Register(k, meta)
+	return k
+}
+
+// Code returns the kind's code.
+func (k *Kind) Code() string {
+	return k.code
+}
+
+// Error returns the kind's code, so a bare Kind reads sensibly when used directly as an error.
+func (k *Kind) Error() string {
+	return k.code
+}
+
+// New creates an error of this kind, formatting the template with args.
+//
+// Example:
+//
+//	err := ErrUserNotFound.New(42) // "user 42 not found"
+func (k *Kind) New(args ...any) error {
+	return &kindError{kind: k, msg: fmt.Sprintf(k.template, args...)}
+}
+
+// Wrap creates an error of this kind that wraps cause, formatting the template with args.
+// The resulting error matches both the kind and cause via errors.Is. Returns nil if cause is nil.
+//
+// Example:
+//
+//	if err := gateway.Charge(order); err != nil {
+//	    return result.Err[Receipt](ErrPaymentFailed.Wrap(err, order.ID)) // "payment for order 7 failed: <cause>"
+//	}
+func (k *Kind) Wrap(cause error, args ...any) error {
+	if cause == nil {
+		return nil
+	}
+	return &kindError{kind: k, msg: fmt.Sprintf(k.template, args...), cause: cause}
+}
+
+// -------------------------------------------- Kind Error Methods --------------------------------------------
+
+func (e *kindError) Error() string {
+	if e.cause != nil {
+		return e.msg + ": " + e.cause.Error()
+	}
+	return e.msg
+}
+
+func (e *kindError) Unwrap() error { return e.cause }
+
+// Is reports whether target is the Kind this error was created from.
+func (e *kindError) Is(target error) bool {
+	return target == e.kind
+}
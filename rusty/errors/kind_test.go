@@ -0,0 +1,53 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors_test. kind_test verifies declarative error families.
+package errors_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+)
+
+var (
+	ErrUserNotFound = goxerrors.NewKind("user_not_found", "user %d not found").
+			WithMeta(goxerrors.Meta{HTTPStatus: http.StatusNotFound})
+	ErrPaymentFailed = goxerrors.NewKind("payment_failed", "payment for order %d failed")
+)
+
+func TestKind_New(t *testing.T) {
+	err := ErrUserNotFound.New(42)
+
+	if err.Error() != "user 42 not found" {
+		t.Fatalf("unexpected message: %q", err.Error())
+	}
+	if !errors.Is(err, ErrUserNotFound) {
+		t.Fatal("expected error to match its kind")
+	}
+	if errors.Is(err, ErrPaymentFailed) {
+		t.Fatal("expected error not to match another kind")
+	}
+	if got := goxerrors.CodeOf(err); got != "user_not_found" {
+		t.Fatalf("expected code %q, got %q", "user_not_found", got)
+	}
+	if got := goxerrors.HTTPStatusOf(err); got != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", got)
+	}
+}
+
+func TestKind_Wrap(t *testing.T) {
+	err := ErrPaymentFailed.Wrap(ErrUnavailable, 7)
+
+	if err.Error() != "payment for order 7 failed: service unavailable" {
+		t.Fatalf("unexpected message: %q", err.Error())
+	}
+	if !errors.Is(err, ErrPaymentFailed) || !errors.Is(err, ErrUnavailable) {
+		t.Fatal("expected error to match both kind and cause")
+	}
+	if ErrPaymentFailed.Wrap(nil, 7) != nil {
+		t.Fatal("expected nil when wrapping nil cause")
+	}
+}
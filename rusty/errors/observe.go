@@ -0,0 +1,86 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors. observe provides error-occurrence observers keyed by registered error codes,
+// so error rates can be exported (e.g. to Prometheus) without counters in business code.
+package errors
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Observer is called with the registered code (see CodeOf) and the error itself.
+type Observer func(code string, err error)
+
+var (
+	observers     atomic.Pointer[[]Observer]
+	observersMu   sync.Mutex
+	installResult sync.Once
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// OnError registers an observer that is notified of every error occurrence.
+// Occurrences come from two sources: every Err Result created through the result package,
+// and explicit calls to Notify for code that still returns plain errors.
+// Observers run synchronously and must be fast and safe for concurrent use.
+//
+// When to use:
+//   - When exporting error counters by code to a metrics backend
+//   - When sampling errors into logs or an error tracker
+//
+// Example - Prometheus counter by code:
+//
+//	var errorsTotal = prometheus.NewCounterVec(
+//	    prometheus.CounterOpts{Name: "app_errors_total"},
+//	    []string{"code"},
+//	)
+//
+//	func init() {
+//	    goxerrors.OnError(func(code string, err error) {
+//	        errorsTotal.WithLabelValues(code).Inc()
+//	    })
+//	}
+func OnError(fn Observer) {
+	observersMu.Lock()
+	var list []Observer
+	if current := observers.Load(); current != nil {
+		list = append(list, *current...)
+	}
+	list = append(list, fn)
+	observers.Store(&list)
+	observersMu.Unlock()
+
+	installResult.Do(func() {
+		result.OnErr(Notify)
+	})
+}
+
+// Notify reports an error occurrence to all observers registered with OnError.
+// Err Results are reported automatically; call Notify for errors that never become a Result.
+// Nil errors are ignored.
+//
+// Example:
+//
+//	if err := legacyClient.Send(msg); err != nil {
+//	    goxerrors.Notify(err)
+//	    return err
+//	}
+func Notify(err error) {
+	if err == nil {
+		return
+	}
+	list := observers.Load()
+	if list == nil {
+		return
+	}
+	code := CodeOf(err)
+	for _, fn := range *list {
+		fn(code, err)
+	}
+}
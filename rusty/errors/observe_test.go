@@ -0,0 +1,54 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors_test. observe_test verifies error-occurrence observers.
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+func TestOnError_ResultErrReportedOnce(t *testing.T) {
+	sentinel := errors.New("observed")
+	goxerrors.Register(sentinel, goxerrors.Meta{Code: "observed"})
+
+	var count atomic.Int32
+	goxerrors.OnError(func(code string, err error) {
+		if code == "observed" {
+			count.Add(1)
+		}
+	})
+
+	res := result.Err[int](fmt.Errorf("wrap: %w", sentinel))
+	mapped := result.Map(res, func(x int) string { return fmt.Sprint(x) })
+	_ = result.AndThen(mapped, func(s string) result.Result[bool] { return result.Ok(true) })
+
+	if got := count.Load(); got != 1 {
+		t.Fatalf("expected 1 occurrence, got %d", got)
+	}
+}
+
+func TestNotify_PlainError(t *testing.T) {
+	sentinel := errors.New("plain")
+	goxerrors.Register(sentinel, goxerrors.Meta{Code: "plain"})
+
+	var seen atomic.Value
+	goxerrors.OnError(func(code string, err error) {
+		if code == "plain" {
+			seen.Store(err)
+		}
+	})
+
+	goxerrors.Notify(sentinel)
+	goxerrors.Notify(nil)
+
+	if seen.Load() != sentinel {
+		t.Fatalf("expected observer to receive sentinel, got %v", seen.Load())
+	}
+}
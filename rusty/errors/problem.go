@@ -0,0 +1,104 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors. problem renders errors as RFC 7807 "problem details" (application/problem+json),
+// using the registry to pick the status code and error code.
+package errors
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Problem is an RFC 7807 problem details object.
+// Code is an extension member carrying the registered error code.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code,omitempty"`
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ProblemContentType is the media type defined by RFC 7807 for JSON problem details.
+const ProblemContentType = "application/problem+json"
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// ToProblem converts err into a Problem using the registry.
+// The error message is exposed as Detail only for registered errors with a 4xx status;
+// server errors and unregistered errors get a generic detail so internal messages never leak to clients.
+//
+// Example:
+//
+//	problem := goxerrors.ToProblem(fmt.Errorf("user 42: %w", ErrNotFound))
+//	// Problem{Type: "about:blank", Title: "Not Found", Status: 404, Detail: "user 42: not found", Code: "not_found"}
+func ToProblem(err error) Problem {
+	status := HTTPStatusOf(err)
+	problem := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   CodeOf(err),
+	}
+	if err != nil && Lookup(err).IsSome() && status < http.StatusInternalServerError {
+		problem.Detail = err.Error()
+	}
+	return problem
+}
+
+// WriteProblem writes err to w as application/problem+json with the matching status code.
+//
+// Example:
+//
+//	func HandleGetUser(w http.ResponseWriter, r *http.Request) {
+//	    var user User
+//	    if err := service.GetUser(r.Context(), id).Ok(&user); err != nil {
+//	        goxerrors.WriteProblem(w, r, err)
+//	        return
+//	    }
+//	    json.NewEncoder(w).Encode(user)
+//	}
+func WriteProblem(w http.ResponseWriter, r *http.Request, err error) {
+	problem := ToProblem(err)
+	if r != nil && r.URL != nil {
+		problem.Instance = r.URL.Path
+	}
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(problem.Status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
+
+// ResultHandler adapts a Result-returning function into an http.Handler.
+// Ok values are written as application/json with status 200; Err values are rendered with WriteProblem.
+//
+// When to use:
+//   - When handlers are written in the Result/BubbleUp style
+//   - When you want consistent problem+json error responses across endpoints
+//
+// Example:
+//
+//	mux.Handle("GET /users/{id}", goxerrors.ResultHandler(func(r *http.Request) (res result.Result[User]) {
+//	    defer result.Catch(&res)
+//	    id := parseID(r.PathValue("id")).BubbleUp()
+//	    return service.GetUser(r.Context(), id)
+//	}))
+func ResultHandler[T any](fn func(*http.Request) result.Result[T]) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := fn(r)
+		if res.IsErr() {
+			WriteProblem(w, r, res.Err())
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(res.Unwrap())
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors_test. problem_test verifies RFC 7807 rendering.
+package errors_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+func TestToProblem_Registered(t *testing.T) {
+	problem := goxerrors.ToProblem(fmt.Errorf("user 42: %w", ErrNotFound))
+
+	if problem.Status != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", problem.Status)
+	}
+	if problem.Code != "not_found" {
+		t.Errorf("expected code %q, got %q", "not_found", problem.Code)
+	}
+	if problem.Detail != "user 42: not found" {
+		t.Errorf("unexpected detail: %q", problem.Detail)
+	}
+}
+
+func TestToProblem_HidesInternalDetail(t *testing.T) {
+	problem := goxerrors.ToProblem(errors.New("pq: connection refused"))
+
+	if problem.Status != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", problem.Status)
+	}
+	if problem.Detail != "" {
+		t.Errorf("expected no detail for internal error, got %q", problem.Detail)
+	}
+}
+
+func TestResultHandler(t *testing.T) {
+	handler := goxerrors.ResultHandler(func(r *http.Request) result.Result[string] {
+		if r.URL.Query().Get("id") == "" {
+			return result.Err[string](ErrNotFound)
+		}
+		return result.Ok("found")
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != goxerrors.ProblemContentType {
+		t.Fatalf("expected %q, got %q", goxerrors.ProblemContentType, ct)
+	}
+	var problem goxerrors.Problem
+	if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+		t.Fatalf("decode problem: %v", err)
+	}
+	if problem.Instance != "/users" {
+		t.Errorf("expected instance %q, got %q", "/users", problem.Instance)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users?id=1", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+}
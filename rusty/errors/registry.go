@@ -0,0 +1,159 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors. registry provides a single place to attach metadata (code, HTTP status, retryability)
+// to sentinel errors so every boundary (HTTP, gRPC, retry loops) classifies errors the same way.
+//
+// Instead of maintaining parallel switch statements:
+//
+//	switch {
+//	case errors.Is(err, ErrNotFound):
+//	    status = 404
+//	case errors.Is(err, ErrConflict):
+//	    status = 409
+//	}
+//
+// register the sentinel once and read from the registry everywhere:
+//
+//	var ErrNotFound = errors.New("not found")
+//
+//	func init() {
+//	    goxerrors.Register(ErrNotFound, goxerrors.Meta{Code: "not_found", HTTPStatus: 404})
+//	}
+//
+//	status := goxerrors.HTTPStatusOf(err) // 404 for anything wrapping ErrNotFound
+package errors
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Meta describes how a sentinel error should be presented and treated at system boundaries.
+type Meta struct {
+	Code       string // Stable, machine-readable identifier (e.g. "user_not_found")
+	HTTPStatus int    // HTTP status code used when rendering the error in responses
+	Retryable  bool   // Whether the operation that produced the error may succeed if retried
+}
+
+// entry pairs a registered sentinel with its metadata.
+type entry struct {
+	sentinel error
+	meta     Meta
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// CodeUnknown is the code reported for non-nil errors that do not match any registered sentinel.
+const CodeUnknown = "unknown"
+
+var registry struct {
+	sync.RWMutex
+	entries []entry
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Register associates metadata with a sentinel error.
+// Any error that matches the sentinel via errors.Is (including wrapped errors) reports this metadata.
+// Registering the same sentinel again replaces its metadata. Register panics if sentinel is nil.
+//
+// When to use:
+//   - In package init() next to the sentinel declarations
+//   - When a new domain error needs a specific HTTP status or retry behavior
+//
+// Example:
+//
+//	var ErrRateLimited = errors.New("rate limited")
+//
+//	func init() {
+//	    goxerrors.Register(ErrRateLimited, goxerrors.Meta{
+//	        Code:       "rate_limited",
+//	        HTTPStatus: http.StatusTooManyRequests,
+//	        Retryable:  true,
+//	    })
+//	}
+func Register(sentinel error, meta Meta) {
+	if sentinel == nil {
+		panic("errors: Register called with nil sentinel")
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	for i := range registry.entries {
+		if registry.entries[i].sentinel == sentinel {
+			registry.entries[i].meta = meta
+			return
+		}
+	}
+	registry.entries = append(registry.entries, entry{sentinel: sentinel, meta: meta})
+}
+
+// Lookup returns the metadata of the first registered sentinel that err matches, or None.
+// Sentinels are checked in registration order, so register more specific sentinels first
+// when a single error may wrap several of them.
+//
+// Example:
+//
+//	if meta := goxerrors.Lookup(err); meta.IsSome() {
+//	    log.Printf("error code: %s", meta.Unwrap().Code)
+//	}
+func Lookup(err error) option.Option[Meta] {
+	if err == nil {
+		return option.None[Meta]()
+	}
+
+	registry.RLock()
+	defer registry.RUnlock()
+
+	for _, e := range registry.entries {
+		if errors.Is(err, e.sentinel) {
+			return option.Some(e.meta)
+		}
+	}
+	return option.None[Meta]()
+}
+
+// CodeOf returns the registered code for err.
+// Returns "" for nil errors and CodeUnknown for errors that match no registered sentinel.
+func CodeOf(err error) string {
+	if err == nil {
+		return ""
+	}
+	return option.Map(Lookup(err), func(m Meta) string { return m.Code }).UnwrapOr(CodeUnknown)
+}
+
+// HTTPStatusOf returns the registered HTTP status for err.
+// Returns 200 for nil errors and 500 for errors that match no registered sentinel
+// (or whose metadata leaves HTTPStatus unset).
+func HTTPStatusOf(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	status := option.Map(Lookup(err), func(m Meta) int { return m.HTTPStatus }).UnwrapOr(0)
+	if status == 0 {
+		return http.StatusInternalServerError
+	}
+	return status
+}
+
+// IsRetryable reports whether err matches a sentinel registered as retryable.
+// Unregistered errors are treated as non-retryable.
+//
+// Example:
+//
+//	for attempt := 0; attempt < 3; attempt++ {
+//	    res := callAPI()
+//	    if res.IsOk() || !goxerrors.IsRetryable(res.Err()) {
+//	        return res
+//	    }
+//	}
+func IsRetryable(err error) bool {
+	return option.Map(Lookup(err), func(m Meta) bool { return m.Retryable }).UnwrapOr(false)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package errors_test. registry_test verifies sentinel metadata registration and lookup.
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrUnavailable = errors.New("service unavailable")
+	ErrUnknown     = errors.New("never registered")
+)
+
+func init() {
+	goxerrors.Register(ErrNotFound, goxerrors.Meta{Code: "not_found", HTTPStatus: http.StatusNotFound})
+	goxerrors.Register(ErrUnavailable, goxerrors.Meta{Code: "unavailable", HTTPStatus: http.StatusServiceUnavailable, Retryable: true})
+}
+
+// -------------------------------------------- Registry Tests --------------------------------------------
+
+func TestLookup_WrappedSentinel(t *testing.T) {
+	err := fmt.Errorf("find user 42: %w", ErrNotFound)
+
+	meta := goxerrors.Lookup(err)
+	if meta.IsNone() {
+		t.Fatal("expected metadata for wrapped sentinel")
+	}
+	if meta.Unwrap().Code != "not_found" {
+		t.Fatalf("expected code %q, got %q", "not_found", meta.Unwrap().Code)
+	}
+}
+
+func TestLookup_Unregistered(t *testing.T) {
+	if goxerrors.Lookup(ErrUnknown).IsSome() {
+		t.Fatal("expected None for unregistered error")
+	}
+	if goxerrors.Lookup(nil).IsSome() {
+		t.Fatal("expected None for nil error")
+	}
+}
+
+func TestHelpers_Defaults(t *testing.T) {
+	if got := goxerrors.CodeOf(ErrUnknown); got != goxerrors.CodeUnknown {
+		t.Errorf("expected %q, got %q", goxerrors.CodeUnknown, got)
+	}
+	if got := goxerrors.HTTPStatusOf(ErrUnknown); got != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", got)
+	}
+	if got := goxerrors.HTTPStatusOf(nil); got != http.StatusOK {
+		t.Errorf("expected 200 for nil, got %d", got)
+	}
+	if goxerrors.IsRetryable(ErrUnknown) {
+		t.Error("expected unregistered error to be non-retryable")
+	}
+}
+
+func TestHelpers_Registered(t *testing.T) {
+	err := fmt.Errorf("call billing: %w", ErrUnavailable)
+
+	if got := goxerrors.HTTPStatusOf(err); got != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", got)
+	}
+	if !goxerrors.IsRetryable(err) {
+		t.Error("expected error to be retryable")
+	}
+}
+
+func TestRegister_Replaces(t *testing.T) {
+	sentinel := errors.New("replace me")
+	goxerrors.Register(sentinel, goxerrors.Meta{Code: "first"})
+	goxerrors.Register(sentinel, goxerrors.Meta{Code: "second"})
+
+	if got := goxerrors.CodeOf(sentinel); got != "second" {
+		t.Fatalf("expected %q, got %q", "second", got)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package events. bus provides Bus, an in-process event bus dispatching typed events to Result-returning
+// handlers, so that domain events report failures through the same error model as the rest of the code.
+//
+// Example - Reacting to a domain event:
+//
+//	bus := events.New()
+//	events.Subscribe(bus, func(e OrderPlaced) result.Result[types.Unit] {
+//	    return mailer.SendConfirmation(e.OrderID)
+//	})
+//	events.Subscribe(bus, func(e OrderPlaced) result.Result[types.Unit] {
+//	    return inventory.Reserve(e.Items)
+//	})
+//
+//	events.Publish(bus, OrderPlaced{OrderID: id, Items: items}).BubbleUp()
+package events
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Bus delivers published events to the handlers subscribed to their exact type. It is safe for concurrent use.
+type Bus struct {
+	cfg      config
+	mu       sync.Mutex
+	handlers map[reflect.Type][]*subscription // replaced, never mutated, so Publish can iterate without mu
+	nextID   uint64
+	inflight sync.WaitGroup
+}
+
+// Dispatch selects how Publish runs handlers.
+type Dispatch int
+
+// ErrorPolicy selects how synchronous dispatch handles a failing handler.
+type ErrorPolicy int
+
+// Option configures a Bus.
+type Option func(*config)
+
+// config holds the settings applied by Option.
+type config struct {
+	dispatch Dispatch
+	policy   ErrorPolicy
+	onError  func(error)
+}
+
+// subscription is a handler with the identity used to unsubscribe it.
+type subscription struct {
+	id uint64
+	fn func(any) result.Result[types.Unit]
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+const (
+	// Sync runs handlers one after another in the publishing goroutine; Publish returns their errors.
+	Sync Dispatch = iota
+	// Async runs each handler in its own goroutine; Publish returns at once and errors go to OnError.
+	Async
+)
+
+const (
+	// CollectAll runs every handler and returns all of their errors joined.
+	CollectAll ErrorPolicy = iota
+	// StopOnFirst stops at the first failing handler and returns its error.
+	StopOnFirst
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithDispatch sets how handlers are run (default: Sync).
+func WithDispatch(d Dispatch) Option {
+	return func(c *config) {
+		c.dispatch = d
+	}
+}
+
+// WithErrorPolicy sets how synchronous dispatch treats failing handlers (default: CollectAll).
+func WithErrorPolicy(p ErrorPolicy) Option {
+	return func(c *config) {
+		c.policy = p
+	}
+}
+
+// OnError registers a callback receiving the errors of handlers run asynchronously (default: discarded).
+// It may be called from several goroutines at once.
+func OnError(fn func(error)) Option {
+	return func(c *config) {
+		c.onError = fn
+	}
+}
+
+// New creates a Bus without subscribers.
+func New(opts ...Option) *Bus {
+	cfg := config{onError: func(error) {}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Bus{cfg: cfg, handlers: make(map[reflect.Type][]*subscription)}
+}
+
+// Subscribe registers fn for events of type T and returns a function that unsubscribes it. Handlers receive
+// only events published with exactly that type. A BubbleUp inside fn becomes its Err, and any other panic an
+// Err wrapping result.ErrPanic.
+//
+// Example:
+//
+//	unsubscribe := events.Subscribe(bus, func(e UserDeleted) result.Result[types.Unit] {
+//	    return sessions.Revoke(e.UserID)
+//	})
+//	defer unsubscribe()
+func Subscribe[T any](b *Bus, fn func(T) result.Result[types.Unit]) (unsubscribe func()) {
+	key := reflect.TypeFor[T]()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	sub := &subscription{id: b.nextID, fn: func(event any) result.Result[types.Unit] { return fn(event.(T)) }}
+	b.handlers[key] = append(b.handlers[key][:len(b.handlers[key]):len(b.handlers[key])], sub)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { b.remove(key, sub.id) })
+	}
+}
+
+// Publish delivers event to the handlers subscribed to type T, in subscription order. With Sync dispatch it
+// returns the handlers' errors according to the ErrorPolicy; with Async dispatch it returns Ok at once.
+// Publishing an event nobody subscribed to is Ok.
+func Publish[T any](b *Bus, event T) result.Result[types.Unit] {
+	b.mu.Lock()
+	subs := b.handlers[reflect.TypeFor[T]()]
+	b.mu.Unlock()
+
+	if b.cfg.dispatch == Async {
+		for _, sub := range subs {
+			b.inflight.Go(func() {
+				if res := call(sub, event); res.IsErr() {
+					b.cfg.onError(res.Err())
+				}
+			})
+		}
+		return result.Ok(types.Unit{})
+	}
+
+	var errs []error
+	for _, sub := range subs {
+		if res := call(sub, event); res.IsErr() {
+			if b.cfg.policy == StopOnFirst {
+				return res
+			}
+			errs = append(errs, res.Err())
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return result.Err[types.Unit](err)
+	}
+	return result.Ok(types.Unit{})
+}
+
+// Wait blocks until every handler started by an asynchronous Publish has returned, e.g. before shutdown.
+func (b *Bus) Wait() {
+	b.inflight.Wait()
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// remove drops the subscription with id from the handlers of key.
+func (b *Bus) remove(key reflect.Type, id uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	subs := b.handlers[key]
+	for i, sub := range subs {
+		if sub.id == id {
+			b.handlers[key] = append(subs[:i:i], subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// call runs sub's handler as result.SafeGo does, turning a BubbleUp into its Err and any other panic into
+// an Err wrapping result.ErrPanic.
+func call(sub *subscription, event any) result.Result[types.Unit] {
+	return <-result.SafeGo(func() result.Result[types.Unit] { return sub.fn(event) })
+}
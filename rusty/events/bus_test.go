@@ -0,0 +1,109 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package events_test. bus_test verifies typed delivery, error policies, unsubscribing and async dispatch.
+package events_test
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/events"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+type (
+	UserCreated struct{ Name string }
+	UserDeleted struct{ Name string }
+)
+
+var (
+	ErrMailer  = errors.New("mailer down")
+	ErrIndexer = errors.New("indexer down")
+)
+
+func ok() result.Result[types.Unit] { return result.Ok(types.Unit{}) }
+
+func TestBus_DeliversByType(t *testing.T) {
+	bus := events.New()
+	var got []string
+	events.Subscribe(bus, func(e UserCreated) result.Result[types.Unit] {
+		got = append(got, "first "+e.Name)
+		return ok()
+	})
+	unsubscribe := events.Subscribe(bus, func(e UserCreated) result.Result[types.Unit] {
+		got = append(got, "second "+e.Name)
+		return ok()
+	})
+	events.Subscribe(bus, func(e UserDeleted) result.Result[types.Unit] {
+		got = append(got, "deleted "+e.Name)
+		return ok()
+	})
+
+	events.Publish(bus, UserCreated{Name: "ada"}).Unwrap()
+	unsubscribe()
+	unsubscribe() // idempotent
+	events.Publish(bus, UserCreated{Name: "bob"}).Unwrap()
+	events.Publish(bus, "nobody listens").Unwrap()
+
+	if want := []string{"first ada", "second ada", "first bob"}; !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestBus_ErrorPolicies(t *testing.T) {
+	subscribe := func(bus *events.Bus, calls *int) {
+		events.Subscribe(bus, func(UserCreated) result.Result[types.Unit] {
+			*calls++
+			return result.Err[types.Unit](ErrMailer)
+		})
+		events.Subscribe(bus, func(UserCreated) result.Result[types.Unit] {
+			*calls++
+			result.Err[int](ErrIndexer).BubbleUp()
+			return ok()
+		})
+		events.Subscribe(bus, func(UserCreated) result.Result[types.Unit] { panic("boom") })
+	}
+
+	var calls int
+	collect := events.New()
+	subscribe(collect, &calls)
+	err := events.Publish(collect, UserCreated{}).Err()
+	if !errors.Is(err, ErrMailer) || !errors.Is(err, ErrIndexer) || !errors.Is(err, result.ErrPanic) || calls != 2 {
+		t.Fatalf("expected every error collected, got %v after %v calls", err, calls)
+	}
+
+	calls = 0
+	stop := events.New(events.WithErrorPolicy(events.StopOnFirst))
+	subscribe(stop, &calls)
+	if err := events.Publish(stop, UserCreated{}).Err(); !errors.Is(err, ErrMailer) || errors.Is(err, ErrIndexer) || calls != 1 {
+		t.Fatalf("expected to stop at the first error, got %v after %v calls", err, calls)
+	}
+}
+
+func TestBus_Async(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+	bus := events.New(events.WithDispatch(events.Async), events.OnError(func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	}))
+	events.Subscribe(bus, func(UserDeleted) result.Result[types.Unit] { return result.Err[types.Unit](ErrIndexer) })
+	events.Subscribe(bus, func(UserDeleted) result.Result[types.Unit] { return ok() })
+
+	for range 3 {
+		if res := events.Publish(bus, UserDeleted{}); res.IsErr() {
+			t.Fatalf("expected async Publish to return Ok, got %v", res.Err())
+		}
+	}
+	bus.Wait()
+	if len(errs) != 3 || !errors.Is(errs[0], ErrIndexer) {
+		t.Fatalf("expected 3 reported errors, got %v", errs)
+	}
+}
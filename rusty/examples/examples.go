@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/seyedali-dev/goxide/rusty/dbx"
 	"github.com/seyedali-dev/goxide/rusty/result"
 )
 
@@ -186,25 +187,13 @@ func ValidateUserInput(email, password, username string) (res result.Result[User
 // -------------------------------------------- Example 7: Transaction Handling --------------------------------------------
 
 // ExecuteTransaction demonstrates error handling in database transactions.
-func ExecuteTransaction(db *sql.DB, userID int, amount float64) (res result.Result[string]) {
-	defer result.Catch(&res)
-
-	tx := result.Wrap(db.Begin()).BubbleUp()
-
-	// Rollback on any error
-	defer func() {
-		if res.IsErr() {
-			tx.Rollback()
-		}
-	}()
-
-	// Execute transaction steps
-	updateBalance(tx, userID, amount).BubbleUp()
-	recordTransaction(tx, userID, amount).BubbleUp()
-
-	//result.Wrap(nil, tx.Commit()).BubbleUp()
-	//return result.Ok("transaction completed")
-	panic("TODO: fix my error")
+// dbx.WithTx commits when the steps succeed and rolls back when any of them bubbles up an error.
+func ExecuteTransaction(db *sql.DB, userID int, amount float64) result.Result[string] {
+	return dbx.WithTx(context.Background(), db, func(tx *sql.Tx) result.Result[string] {
+		updateBalance(tx, userID, amount).BubbleUp()
+		recordTransaction(tx, userID, amount).BubbleUp()
+		return result.Ok("transaction completed")
+	})
 }
 
 // -------------------------------------------- Example 8: Context-Aware Operations --------------------------------------------
@@ -0,0 +1,202 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package flagx. flagx layers Option and Result over the standard flag package: flags that are undefined or
+// were not passed come back as None, parse failures come back as Err, and a whole flags struct can be
+// bound through field tags with the same field types config.Load accepts.
+//
+// Example - Traditional vs flagx:
+//
+//	// Traditional Go
+//	addr := flag.String("addr", ":8080", "listen address")
+//	timeout := flag.Duration("timeout", 5*time.Second, "request timeout")
+//	token := flag.String("token", "", "API token") // was it passed, or just empty?
+//	flag.Parse()
+//
+//	// With flagx
+//	type Flags struct {
+//	    Addr    string                `flag:"addr" default:":8080" usage:"listen address"`
+//	    Timeout time.Duration         `flag:"timeout" default:"5s" usage:"request timeout"`
+//	    Token   option.Option[string] `flag:"token" usage:"API token"`
+//	}
+//	flags := flagx.Bind[Flags](flag.CommandLine, os.Args[1:]).BubbleUp()
+package flagx
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/seyedali-dev/goxide/internal/optionset"
+	"github.com/seyedali-dev/goxide/internal/textconv"
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrMissing is reported for each flag tagged required that was not passed.
+var ErrMissing = errors.New("required flag not set")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Parse parses args with fs and returns the remaining non-flag arguments.
+// fs should use flag.ContinueOnError for failures to be returned rather than exiting the process.
+func Parse(fs *flag.FlagSet, args []string) result.Result[[]string] {
+	if err := fs.Parse(args); err != nil {
+		return result.Err[[]string](err)
+	}
+	return result.Ok(fs.Args())
+}
+
+// Get returns the value of the flag name if it was passed on the command line and holds a T, or None if the
+// flag is undefined, was not passed, or holds another type. Call it after fs has been parsed.
+//
+// Example:
+//
+//	fs.String("profile", "", "write a CPU profile to this file")
+//	...
+//	if path := flagx.Get[string](fs, "profile"); path.IsSome() {
+//	    startProfiling(path.Unwrap())
+//	}
+func Get[T any](fs *flag.FlagSet, name string) option.Option[T] {
+	if !IsSet(fs, name) {
+		return option.None[T]()
+	}
+	getter, ok := fs.Lookup(name).Value.(flag.Getter)
+	if !ok {
+		return option.None[T]()
+	}
+	return option.Cast[T](getter.Get())
+}
+
+// IsSet reports whether the flag name was passed on the command line.
+func IsSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		set = set || f.Name == name
+	})
+	return set
+}
+
+// Bind defines a flag on fs for each tagged field of T, parses args and returns the populated T.
+//   - `flag:"name"` defines the flag; `flag:"name,required"` reports ErrMissing when it is not passed
+//   - `default:"value"` and `usage:"text"` set the default and the help text
+//   - untagged struct fields are bound recursively
+//
+// Fields may be strings, bools, numbers, time.Duration, encoding.TextUnmarshaler implementations, slices
+// of those (comma-separated, and repeated flags append) and Option[T] of any of them (None unless passed).
+// Bool and Option[bool] fields are boolean flags that may be passed without a value.
+//
+// When to use:
+//   - In main, to declare a tool's flags as a struct next to its config
+//   - In subcommands, binding each one's flags into its own struct on its own FlagSet
+//
+// Example:
+//
+//	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+//	res := flagx.Bind[ServeFlags](fs, args)
+//	if res.IsErr() {
+//	    fmt.Fprintln(os.Stderr, res.Err())
+//	    os.Exit(2)
+//	}
+func Bind[T any](fs *flag.FlagSet, args []string) result.Result[T] {
+	var flags T
+	target := reflect.ValueOf(&flags).Elem()
+	if target.Kind() != reflect.Struct {
+		return result.Err[T](fmt.Errorf("flagx: %T is not a struct", flags))
+	}
+
+	required, err := define(fs, target)
+	if err != nil {
+		return result.Err[T](err)
+	}
+	if err := fs.Parse(args); err != nil {
+		return result.Err[T](err)
+	}
+
+	var errs []error
+	for _, name := range required {
+		if !IsSet(fs, name) {
+			errs = append(errs, fmt.Errorf("-%s: %w", name, ErrMissing))
+		}
+	}
+	if len(errs) > 0 {
+		return result.Err[T](errors.Join(errs...))
+	}
+	return result.Ok(flags)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// define registers a flag for each tagged field of v, applying defaults, and returns the required flag names.
+func define(fs *flag.FlagSet, v reflect.Value) ([]string, error) {
+	var required []string
+	for i := range v.NumField() {
+		field, sf := v.Field(i), v.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		tag, tagged := sf.Tag.Lookup("flag")
+		if !tagged {
+			if !textconv.IsScalar(field.Type()) {
+				names, err := define(fs, field)
+				if err != nil {
+					return nil, err
+				}
+				required = append(required, names...)
+			}
+			continue
+		}
+
+		name, flags, _ := strings.Cut(tag, ",")
+		if flags == "required" {
+			required = append(required, name)
+		}
+		if def, ok := sf.Tag.Lookup("default"); ok {
+			if err := textconv.Set(field, def); err != nil {
+				return nil, fmt.Errorf("flagx: default for -%s: %w", name, err)
+			}
+		}
+
+		set := setter(field)
+		if isBool(field.Type()) {
+			fs.BoolFunc(name, sf.Tag.Get("usage"), set)
+		} else {
+			fs.Func(name, sf.Tag.Get("usage"), set)
+		}
+	}
+	return required, nil
+}
+
+// setter returns the flag.Func callback that parses a command-line value into field.
+// The first value passed for a slice replaces its default; later ones append.
+func setter(field reflect.Value) func(string) error {
+	if field.Kind() != reflect.Slice {
+		return func(raw string) error { return textconv.Set(field, raw) }
+	}
+	passed := false
+	return func(raw string) error {
+		values := reflect.New(field.Type()).Elem()
+		if err := textconv.Set(values, raw); err != nil {
+			return err
+		}
+		if !passed {
+			field.SetZero()
+			passed = true
+		}
+		field.Set(reflect.AppendSlice(field, values))
+		return nil
+	}
+}
+
+// isBool reports whether t is bool or Option[bool], i.e. a flag that may be passed without a value.
+func isBool(t reflect.Type) bool {
+	if textconv.IsOption(t) {
+		t = optionset.Elem(t)
+	}
+	return t.Kind() == reflect.Bool
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package flagx_test. flagx_test verifies Option lookups and struct binding of flags.
+package flagx_test
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/flagx"
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+type serveFlags struct {
+	Addr    string                `flag:"addr" default:":8080" usage:"listen address"`
+	Timeout time.Duration         `flag:"timeout" default:"5s"`
+	Token   option.Option[string] `flag:"token"`
+	Verbose bool                  `flag:"v"`
+	Debug   option.Option[bool]   `flag:"debug"`
+	Tags    []string              `flag:"tag" default:"base"`
+	DB      struct {
+		URL string `flag:"db-url,required"`
+	}
+}
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestGet(t *testing.T) {
+	fs := newFlagSet()
+	fs.String("profile", "", "")
+	fs.Int("workers", 4, "")
+	rest := flagx.Parse(fs, []string{"-profile", "cpu.out", "run"}).Unwrap()
+
+	if got := flagx.Get[string](fs, "profile").Unwrap(); got != "cpu.out" {
+		t.Fatalf("expected %v, got %v", "cpu.out", got)
+	}
+	if flagx.Get[int](fs, "workers").IsSome() {
+		t.Fatal("expected None for a flag that was not passed")
+	}
+	if flagx.Get[int](fs, "undefined").IsSome() {
+		t.Fatal("expected None for an undefined flag")
+	}
+	if !slices.Equal(rest, []string{"run"}) {
+		t.Fatalf("expected %v, got %v", []string{"run"}, rest)
+	}
+}
+
+func TestBind_Options(t *testing.T) {
+	args := []string{"-debug", "-token", "secret", "-db-url", "postgres://x"}
+	got := flagx.Bind[serveFlags](newFlagSet(), args).Unwrap()
+
+	if got.Token != option.Some("secret") {
+		t.Fatalf("expected %v, got %v", option.Some("secret"), got.Token)
+	}
+	if got.Debug != option.Some(true) {
+		t.Fatalf("expected %v, got %v", option.Some(true), got.Debug)
+	}
+}
+
+func TestParse_Err(t *testing.T) {
+	fs := newFlagSet()
+	fs.Int("workers", 4, "")
+	if res := flagx.Parse(fs, []string{"-workers", "many"}); res.IsOk() {
+		t.Fatal("expected Err for an invalid value")
+	}
+}
+
+func TestBind(t *testing.T) {
+	args := []string{"-v", "-timeout", "2s", "-tag", "a,b", "-tag", "c", "-db-url", "postgres://x"}
+	got := flagx.Bind[serveFlags](newFlagSet(), args).Unwrap()
+
+	if got.Addr != ":8080" || got.Timeout != 2*time.Second || !got.Verbose || got.DB.URL != "postgres://x" {
+		t.Fatalf("unexpected flags %+v", got)
+	}
+	if got.Token.IsSome() {
+		t.Fatal("expected None token")
+	}
+	if want := []string{"a", "b", "c"}; !slices.Equal(got.Tags, want) {
+		t.Fatalf("expected %v, got %v", want, got.Tags)
+	}
+}
+
+func TestBind_Required(t *testing.T) {
+	res := flagx.Bind[serveFlags](newFlagSet(), []string{"-token", "s3cret"})
+	if !errors.Is(res.Err(), flagx.ErrMissing) {
+		t.Fatalf("expected %v, got %v", flagx.ErrMissing, res.Err())
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package funcx. debounce provides Debounce, which runs a function once per burst of calls, with the
+// argument of the latest call, after the calls have stopped for a while.
+package funcx
+
+import (
+	"sync"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/clock"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// debouncer holds the burst currently waiting for its quiet period.
+type debouncer[A, T any] struct {
+	fn    func(A) result.Result[T]
+	wait  time.Duration
+	clock clock.Clock
+
+	mu      sync.Mutex
+	pending *burst[A, T]
+}
+
+// burst is a group of calls that share one run of fn, published when done is closed.
+type burst[A, T any] struct {
+	arg   A
+	timer clock.Timer
+	done  chan struct{}
+	res   result.Result[T]
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Debounce wraps fn so that calls arriving less than wait apart are collapsed into one run of fn, made wait
+// after the last of them with that call's argument. Every call blocks until that run finishes and returns
+// its Result, so superseded callers see the outcome of the call that replaced theirs.
+// A panic in fn becomes an Err wrapping result.ErrPanic.
+//
+// When to use:
+//   - Saving a document or search query after the user stops typing
+//   - Recomputing derived state once after a flurry of change notifications
+//
+// Example:
+//
+//	save := funcx.Debounce(func(doc Document) result.Result[Revision] {
+//	    return store.Save(ctx, doc)
+//	}, 500*time.Millisecond)
+//
+//	rev := save(doc).BubbleUp() // waits until no newer edit arrived for 500ms
+func Debounce[A, T any](fn func(A) result.Result[T], wait time.Duration, opts ...Option) func(A) result.Result[T] {
+	d := &debouncer[A, T]{fn: fn, wait: wait, clock: newConfig(opts).clock}
+	return d.call
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// call joins the pending burst, or starts one, and waits for its Result.
+func (d *debouncer[A, T]) call(arg A) result.Result[T] {
+	d.mu.Lock()
+	b := d.pending
+	if b == nil {
+		b = &burst[A, T]{arg: arg, timer: d.clock.NewTimer(d.wait), done: make(chan struct{})}
+		d.pending = b
+		go d.fire(b)
+	} else {
+		b.arg = arg
+		b.timer.Reset(d.wait)
+	}
+	d.mu.Unlock()
+
+	<-b.done
+	return b.res
+}
+
+// fire runs fn once b's quiet period has passed. Calls arriving after this point start a new burst.
+func (d *debouncer[A, T]) fire(b *burst[A, T]) {
+	<-b.timer.C()
+
+	d.mu.Lock()
+	b.timer.Stop() // a call may have re-armed the timer after it fired
+	d.pending = nil
+	arg := b.arg
+	d.mu.Unlock()
+
+	b.res = <-result.SafeGo(func() result.Result[T] { return d.fn(arg) })
+	close(b.done)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package funcx_test. debounce_test verifies bursts are collapsed into one run with the latest argument.
+package funcx_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/clock/clocktest"
+	"github.com/seyedali-dev/goxide/rusty/funcx"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+func TestDebounce_CollapsesBursts(t *testing.T) {
+	clk := clocktest.NewFakeClock(time.Now())
+	var calls atomic.Int32
+	double := funcx.Debounce(func(x int) result.Result[int] {
+		calls.Add(1)
+		return result.Ok(x * 2)
+	}, time.Second, funcx.WithClock(clk))
+
+	var (
+		wg      sync.WaitGroup
+		results [2]result.Result[int]
+	)
+	wg.Go(func() { results[0] = double(1) })
+	clk.BlockUntil(1)
+	clk.Advance(600 * time.Millisecond)
+	wg.Go(func() { results[1] = double(2) })
+	time.Sleep(20 * time.Millisecond) // let the second call join the burst and re-arm the timer
+
+	clk.Advance(600 * time.Millisecond)
+	if clk.Pending() != 1 || calls.Load() != 0 {
+		t.Fatal("expected the second call to restart the quiet period")
+	}
+	clk.Advance(400 * time.Millisecond)
+	wg.Wait()
+
+	for _, res := range results {
+		if res.Unwrap() != 4 {
+			t.Fatalf("expected %v, got %v", 4, res.Unwrap())
+		}
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected %v call, got %v", 1, calls.Load())
+	}
+
+	next := make(chan result.Result[int])
+	go func() { next <- double(3) }()
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	if res := <-next; res.Unwrap() != 6 || calls.Load() != 2 {
+		t.Fatalf("expected a new burst to run again, got %v after %v calls", res, calls.Load())
+	}
+}
+
+func TestDebounce_Panic(t *testing.T) {
+	explode := funcx.Debounce(func(int) result.Result[int] { panic("boom") }, time.Millisecond)
+	if err := explode(1).Err(); !errors.Is(err, result.ErrPanic) {
+		t.Fatalf("expected %v, got %v", result.ErrPanic, err)
+	}
+}
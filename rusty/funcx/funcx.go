@@ -0,0 +1,51 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package funcx. funcx provides wrappers that control how often a Result-returning function actually runs:
+// Debounce collapses bursts of calls into one, Throttle rejects calls that come too soon after the last.
+//
+// Example - Forwarding webhooks without flooding the receiver:
+//
+//	forward := funcx.Throttle(func(e Event) result.Result[Ack] {
+//	    return client.Post(ctx, e)
+//	}, time.Second)
+//
+//	if res := forward(event); errors.Is(res.Err(), funcx.ErrThrottled) {
+//	    queue.Push(event) // try again later
+//	}
+package funcx
+
+import (
+	"github.com/seyedali-dev/goxide/rusty/clock"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Option configures Debounce and Throttle.
+type Option func(*config)
+
+// config holds the settings applied by Option.
+type config struct {
+	clock clock.Clock
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithClock sets the clock driving the wrapper's timing (default: clock.System),
+// e.g. a clocktest.FakeClock to test debouncing without sleeping.
+func WithClock(clk clock.Clock) Option {
+	return func(c *config) {
+		c.clock = clk
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// newConfig applies opts over the defaults.
+func newConfig(opts []Option) config {
+	cfg := config{clock: clock.System}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package funcx. throttle provides Throttle, which lets a function run at most once per interval and rejects
+// the calls in between with a *ThrottledError.
+package funcx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// ThrottledError is returned by a throttled function called before its interval has passed.
+type ThrottledError struct {
+	RetryAfter time.Duration // Time left until a call would be let through
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrThrottled is wrapped by every *ThrottledError. It is registered as a retryable "rate_limited" error
+// with HTTP status 429.
+var ErrThrottled = errors.New("funcx: throttled")
+
+func init() {
+	goxerrors.Register(ErrThrottled, goxerrors.Meta{
+		Code:       "rate_limited",
+		HTTPStatus: http.StatusTooManyRequests,
+		Retryable:  true,
+	})
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Throttle wraps fn so that it runs at most once per interval. The first call runs fn; calls made less than
+// interval after the start of the last run return Err(*ThrottledError) immediately without running fn.
+// Rejected calls do not extend the interval.
+//
+// When to use:
+//   - Forwarding webhooks or notifications to a receiver with a rate limit
+//   - Guarding expensive refreshes (cache rebuilds, token renewals) triggered by many callers
+//
+// Example:
+//
+//	refresh := funcx.Throttle(func(struct{}) result.Result[Token] {
+//	    return auth.Renew(ctx)
+//	}, 30*time.Second)
+//
+//	res := refresh(struct{}{})
+//	var throttled *funcx.ThrottledError
+//	if errors.As(res.Err(), &throttled) {
+//	    log.Printf("renewed recently, next renewal allowed in %s", throttled.RetryAfter)
+//	}
+func Throttle[A, T any](fn func(A) result.Result[T], interval time.Duration, opts ...Option) func(A) result.Result[T] {
+	clk := newConfig(opts).clock
+	var (
+		mu      sync.Mutex
+		last    time.Time
+		started bool
+	)
+	return func(arg A) result.Result[T] {
+		mu.Lock()
+		if started {
+			if elapsed := clk.Since(last); elapsed < interval {
+				mu.Unlock()
+				return result.Err[T](&ThrottledError{RetryAfter: interval - elapsed})
+			}
+		}
+		last, started = clk.Now(), true
+		mu.Unlock()
+		return fn(arg)
+	}
+}
+
+// -------------------------------------------- ThrottledError Methods --------------------------------------------
+
+// Error reports how long until the next call is allowed.
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("%v: retry after %v", ErrThrottled, e.RetryAfter)
+}
+
+// Unwrap returns ErrThrottled.
+func (e *ThrottledError) Unwrap() error {
+	return ErrThrottled
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package funcx_test. throttle_test verifies calls within the interval are rejected with a ThrottledError.
+package funcx_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/clock/clocktest"
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/funcx"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+func TestThrottle(t *testing.T) {
+	clk := clocktest.NewFakeClock(time.Now())
+	calls := 0
+	send := funcx.Throttle(func(msg string) result.Result[string] {
+		calls++
+		return result.Ok("sent " + msg)
+	}, time.Minute, funcx.WithClock(clk))
+
+	if got := send("a").Unwrap(); got != "sent a" {
+		t.Fatalf("expected %v, got %v", "sent a", got)
+	}
+
+	clk.Advance(20 * time.Second)
+	err := send("b").Err()
+	var throttled *funcx.ThrottledError
+	if !errors.As(err, &throttled) || throttled.RetryAfter != 40*time.Second {
+		t.Fatalf("expected a ThrottledError retrying after 40s, got %v", err)
+	}
+	if !errors.Is(err, funcx.ErrThrottled) || !goxerrors.IsRetryable(err) {
+		t.Fatalf("expected a retryable %v, got %v", funcx.ErrThrottled, err)
+	}
+
+	clk.Advance(40 * time.Second)
+	if got := send("c").Unwrap(); got != "sent c" || calls != 2 {
+		t.Fatalf("expected the call after the interval to run, got %v after %v calls", got, calls)
+	}
+}
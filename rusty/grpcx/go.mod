@@ -0,0 +1,16 @@
+module github.com/seyedali-dev/goxide/rusty/grpcx
+
+go 1.25.0
+
+require (
+	github.com/seyedali-dev/goxide v0.0.0-20261016191056-12d6c9fcf26a
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250929231259-57b25ae835d4
+	google.golang.org/grpc v1.75.1
+)
+
+require (
+	golang.org/x/net v0.56.0 // indirect
+	golang.org/x/sys v0.46.0 // indirect
+	golang.org/x/text v0.38.0 // indirect
+	google.golang.org/protobuf v1.36.10 // indirect
+)
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package grpcx. grpcx makes Result-first services safe on gRPC boundaries: server interceptors recover
+// BubbleUp panics that escaped a handler and translate errors into gRPC statuses using the error registry,
+// attaching the registered code and structured fields as an ErrorInfo detail.
+//
+// Example:
+//
+//	srv := grpc.NewServer(
+//	    grpc.ChainUnaryInterceptor(grpcx.UnaryServerInterceptor()),
+//	    grpc.ChainStreamInterceptor(grpcx.StreamServerInterceptor()),
+//	)
+//
+//	func (s *UserServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+//	    user := s.repo.Find(ctx, req.Id).BubbleUp() // ErrNotFound becomes codes.NotFound
+//	    return toProto(user), nil
+//	}
+package grpcx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrorDomain is the Domain of the ErrorInfo detail attached by StatusOf.
+const ErrorDomain = "goxide"
+
+// httpToCode maps registered HTTP statuses to their conventional gRPC codes.
+var httpToCode = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.AlreadyExists,
+	http.StatusPreconditionFailed:  codes.FailedPrecondition,
+	http.StatusUnprocessableEntity: codes.InvalidArgument,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	http.StatusNotImplemented:      codes.Unimplemented,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusGatewayTimeout:      codes.DeadlineExceeded,
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// StatusOf converts err into a gRPC status.
+//   - Errors that already carry a status (status.FromError) are returned as-is.
+//   - Context cancellation and deadline errors map to Canceled and DeadlineExceeded.
+//   - Registered errors map through their HTTP status (404 -> NotFound, 429 -> ResourceExhausted, ...)
+//     and carry an ErrorInfo detail with the registered code and the error's fields as metadata.
+//   - Unregistered errors map to Unknown.
+//
+// As with goxerrors.ToProblem, the error message is exposed only for registered errors with a 4xx
+// status, so internal messages never leak to clients. Returns nil for a nil error.
+func StatusOf(err error) *status.Status {
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, err.Error())
+	}
+
+	meta := goxerrors.Lookup(err)
+	if meta.IsNone() {
+		return status.New(codes.Unknown, "unknown error")
+	}
+
+	httpStatus := goxerrors.HTTPStatusOf(err)
+	clientError := httpStatus < http.StatusInternalServerError
+	code, ok := httpToCode[httpStatus]
+	if !ok {
+		code = codes.Internal
+		if clientError {
+			code = codes.FailedPrecondition
+		}
+	}
+	message := http.StatusText(httpStatus)
+	if clientError {
+		message = err.Error()
+	}
+
+	st := status.New(code, message)
+	info := &errdetails.ErrorInfo{
+		Reason:   meta.Unwrap().Code,
+		Domain:   ErrorDomain,
+		Metadata: make(map[string]string),
+	}
+	for k, v := range goxerrors.FieldsOf(err) {
+		info.Metadata[k] = fmt.Sprint(v)
+	}
+	if withDetails, detailErr := st.WithDetails(info); detailErr == nil {
+		st = withDetails
+	}
+	return st
+}
+
+// UnaryServerInterceptor recovers BubbleUp panics raised by unary handlers and converts every handler
+// error into a status with StatusOf. Other panics are re-raised untouched.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		res := invoke(func() (any, error) { return handler(ctx, req) })
+		if res.IsErr() {
+			return nil, StatusOf(res.Err()).Err()
+		}
+		return res.Unwrap(), nil
+	}
+}
+
+// StreamServerInterceptor recovers BubbleUp panics raised by streaming handlers and converts every handler
+// error into a status with StatusOf. Other panics are re-raised untouched.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		res := invoke(func() (types.Unit, error) { return types.Unit{}, handler(srv, stream) })
+		return StatusOf(res.Err()).Err()
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// invoke runs fn, turning a BubbleUp panic into an Err Result.
+func invoke[T any](fn func() (T, error)) (res result.Result[T]) {
+	defer result.Catch(&res)
+	return result.Wrap(fn())
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package grpcx_test. grpcx_test verifies status translation and BubbleUp recovery in the interceptors.
+package grpcx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/grpcx"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrStoreDown    = errors.New("store down")
+)
+
+func init() {
+	goxerrors.Register(ErrUserNotFound, goxerrors.Meta{Code: "user_not_found", HTTPStatus: http.StatusNotFound})
+	goxerrors.Register(ErrStoreDown, goxerrors.Meta{Code: "store_down", HTTPStatus: http.StatusServiceUnavailable})
+}
+
+func findUser(id int) result.Result[string] {
+	if id == 42 {
+		return result.Ok("ali")
+	}
+	return result.Err[string](goxerrors.WithFields(ErrUserNotFound, goxerrors.Fields{"user_id": id}))
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestStatusOf_Registered(t *testing.T) {
+	st := grpcx.StatusOf(findUser(7).Err())
+	if st.Code() != codes.NotFound || st.Message() != "user not found" {
+		t.Fatalf("expected NotFound with message, got %v %q", st.Code(), st.Message())
+	}
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	if !ok || info.Reason != "user_not_found" || info.Metadata["user_id"] != "7" {
+		t.Fatalf("expected ErrorInfo with code and fields, got %v", st.Details())
+	}
+}
+
+func TestStatusOf_HidesServerMessages(t *testing.T) {
+	st := grpcx.StatusOf(fmt.Errorf("dial 10.0.0.3: %w", ErrStoreDown))
+	if st.Code() != codes.Unavailable || st.Message() != "Service Unavailable" {
+		t.Fatalf("expected Unavailable with generic message, got %v %q", st.Code(), st.Message())
+	}
+	if st := grpcx.StatusOf(errors.New("secret")); st.Code() != codes.Unknown || st.Message() == "secret" {
+		t.Fatalf("expected Unknown with generic message, got %v %q", st.Code(), st.Message())
+	}
+}
+
+func TestStatusOf_PassThrough(t *testing.T) {
+	original := status.Error(codes.Aborted, "conflict")
+	if st := grpcx.StatusOf(original); st.Code() != codes.Aborted {
+		t.Fatalf("expected %v, got %v", codes.Aborted, st.Code())
+	}
+	if st := grpcx.StatusOf(context.DeadlineExceeded); st.Code() != codes.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", codes.DeadlineExceeded, st.Code())
+	}
+	if grpcx.StatusOf(nil) != nil {
+		t.Fatal("expected nil status for nil error")
+	}
+}
+
+func TestUnaryServerInterceptor_RecoversBubbleUp(t *testing.T) {
+	intercept := grpcx.UnaryServerInterceptor()
+	//goxide:bubbles
+	handler := func(_ context.Context, req any) (any, error) {
+		return findUser(req.(int)).BubbleUp(), nil
+	}
+
+	resp, err := intercept(context.Background(), 42, &grpc.UnaryServerInfo{}, handler)
+	if err != nil || resp != "ali" {
+		t.Fatalf("expected %v, got %v (%v)", "ali", resp, err)
+	}
+
+	_, err = intercept(context.Background(), 7, &grpc.UnaryServerInfo{}, handler)
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected %v, got %v", codes.NotFound, status.Code(err))
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	intercept := grpcx.StreamServerInterceptor()
+	//goxide:bubbles
+	err := intercept(nil, nil, &grpc.StreamServerInfo{}, func(any, grpc.ServerStream) error {
+		findUser(1).BubbleUp()
+		return nil
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected %v, got %v", codes.NotFound, status.Code(err))
+	}
+	if err := intercept(nil, nil, &grpc.StreamServerInfo{}, func(any, grpc.ServerStream) error { return nil }); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package httpx. bind provides Bind, which fills a request struct from the path, query string, headers and
+// JSON body in one call, reporting every malformed input together as a single 400-class error.
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+
+	goxreflect "github.com/seyedali-dev/goxide/reflect"
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrBind matches every error returned by Bind. It is registered as a 400 with code "invalid_request".
+var ErrBind = errors.New("invalid request")
+
+func init() {
+	goxerrors.Register(ErrBind, goxerrors.Meta{Code: "invalid_request", HTTPStatus: http.StatusBadRequest})
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Bind builds a T from r using struct tags, applied in this order so that later sources win:
+//   - the JSON body (`json` tags), when the Content-Type is application/json and the body is not empty
+//   - `query:"name"` fields from the query string (repeated parameters fill slices)
+//   - `header:"Name"` fields from request headers
+//   - `path:"name"` fields from path wildcards (r.PathValue)
+//
+// Values are converted as reflect.FromMap does. The Err matches ErrBind and joins one error per malformed
+// input (a *reflect.FieldError naming the parameter, or the body decoding error).
+//
+// Example:
+//
+//	type ListOrders struct {
+//	    UserID  int      `path:"id"`
+//	    Limit   int      `query:"limit"`
+//	    Status  []string `query:"status"`
+//	    TraceID string   `header:"X-Trace-ID"`
+//	}
+//
+//	mux.Handle("GET /users/{id}/orders", goxerrors.ResultHandler(func(r *http.Request) (res result.Result[[]Order]) {
+//	    defer result.Catch(&res)
+//	    req := httpx.Bind[ListOrders](r).BubbleUp() // 400 problem on bad input
+//	    return orders.List(r.Context(), req)
+//	}))
+func Bind[T any](r *http.Request) result.Result[T] {
+	var v T
+	if reflect.TypeFor[T]().Kind() != reflect.Struct {
+		return result.Err[T](fmt.Errorf("httpx: %T is not a struct", v))
+	}
+
+	var errs []error
+	if err := decodeBody(r, &v); err != nil {
+		errs = append(errs, fmt.Errorf("body: %w", err))
+	}
+
+	query := make(map[string]any)
+	for name, values := range r.URL.Query() {
+		query[name] = values
+	}
+	headers := make(map[string]any)
+	for name := range goxreflect.FieldsByTag(reflect.TypeFor[T](), "header") {
+		if values := r.Header.Values(name); len(values) > 0 {
+			headers[name] = values
+		}
+	}
+	path := make(map[string]any)
+	for name := range goxreflect.FieldsByTag(reflect.TypeFor[T](), "path") {
+		if value := r.PathValue(name); value != "" {
+			path[name] = value
+		}
+	}
+
+	for _, source := range []struct {
+		tag    string
+		values map[string]any
+	}{{"query", query}, {"header", headers}, {"path", path}} {
+		if err := goxreflect.MapInto(&v, source.values, source.tag); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return result.Err[T](fmt.Errorf("%w: %w", ErrBind, errors.Join(errs...)))
+	}
+	return result.Ok(v)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// decodeBody decodes a JSON request body into target. Requests without a JSON body are left untouched.
+func decodeBody(r *http.Request, target any) error {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+		return nil
+	}
+	err := json.NewDecoder(r.Body).Decode(target)
+	if errors.Is(err, io.EOF) {
+		return nil // empty body
+	}
+	return err
+}
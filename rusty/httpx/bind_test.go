@@ -0,0 +1,73 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package httpx_test. bind_test verifies binding from every request source and error aggregation.
+package httpx_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/reflect"
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/httpx"
+)
+
+type createOrder struct {
+	UserID  int      `path:"id"`
+	DryRun  bool     `query:"dry_run"`
+	Tags    []string `query:"tag"`
+	TraceID string   `header:"X-Trace-ID"`
+	SKU     string   `json:"sku"`
+	Qty     int      `json:"qty"`
+}
+
+func newRequest(target, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+	r.Header.Set("X-Trace-ID", "t-1")
+	r.SetPathValue("id", "42")
+	return r
+}
+
+func TestBind_AllSources(t *testing.T) {
+	r := newRequest("/users/42/orders?dry_run=true&tag=a&tag=b", `{"sku":"pen","qty":3}`)
+	got := httpx.Bind[createOrder](r).Unwrap()
+
+	if got.UserID != 42 || !got.DryRun || got.TraceID != "t-1" || got.SKU != "pen" || got.Qty != 3 {
+		t.Fatalf("unexpected binding %+v", got)
+	}
+	if !slices.Equal(got.Tags, []string{"a", "b"}) {
+		t.Fatalf("expected %v, got %v", []string{"a", "b"}, got.Tags)
+	}
+}
+
+func TestBind_AggregatesErrors(t *testing.T) {
+	r := newRequest("/users/42/orders?dry_run=maybe", `{"qty":"three"}`)
+	r.SetPathValue("id", "abc")
+	res := httpx.Bind[createOrder](r)
+
+	if !errors.Is(res.Err(), httpx.ErrBind) || goxerrors.HTTPStatusOf(res.Err()) != http.StatusBadRequest {
+		t.Fatalf("expected 400 ErrBind, got %v", res.Err())
+	}
+	var fieldErr *reflect.FieldError
+	if !errors.As(res.Err(), &fieldErr) {
+		t.Fatalf("expected *reflect.FieldError, got %v", res.Err())
+	}
+	for _, part := range []string{"body:", "dry_run:", "id:"} {
+		if !strings.Contains(res.Err().Error(), part) {
+			t.Fatalf("expected %q in %v", part, res.Err())
+		}
+	}
+}
+
+func TestBind_NoBody(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/orders?tag=x", nil)
+	if got := httpx.Bind[createOrder](r).Unwrap(); !slices.Equal(got.Tags, []string{"x"}) {
+		t.Fatalf("expected %v, got %v", []string{"x"}, got.Tags)
+	}
+}
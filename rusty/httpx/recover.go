@@ -0,0 +1,190 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package httpx. recover provides Recover, middleware that turns panics escaping HTTP handlers into problem+json
+// responses and structured log records: a BubbleUp that was never caught answers with its error's registered
+// status, and any other panic answers 500 without leaking a stack trace to the client.
+package httpx
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"runtime/debug"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Option configures Recover.
+type Option func(*config)
+
+// config holds the settings applied by Option.
+type config struct {
+	logger *slog.Logger
+}
+
+// trackingWriter records whether the response has been started, so Recover never writes a second header.
+type trackingWriter struct {
+	http.ResponseWriter
+	started bool
+}
+
+// flushWriter is a trackingWriter over a writer that implements http.Flusher.
+type flushWriter struct{ *trackingWriter }
+
+// hijackWriter is a trackingWriter over a writer that implements http.Hijacker.
+type hijackWriter struct{ *trackingWriter }
+
+// flushHijackWriter is a trackingWriter over a writer that implements both http.Flusher and http.Hijacker.
+type flushHijackWriter struct{ *trackingWriter }
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrPanic is the error reported for a handler panic that is not a BubbleUp. It is registered as a 500.
+var ErrPanic = errors.New("handler panicked")
+
+func init() {
+	goxerrors.Register(ErrPanic, goxerrors.Meta{Code: "internal_error", HTTPStatus: http.StatusInternalServerError})
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithLogger sets the logger for recovered panics (default: slog.Default()).
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *config) { c.logger = logger }
+}
+
+// Recover wraps handlers so that panics become error responses instead of dropped connections:
+//   - A BubbleUp with no matching defer result.Catch is rendered with goxerrors.WriteProblem, so it gets
+//     the status and code registered for its error, and is logged as a warning about the missing Catch
+//   - Any other panic is logged at error level with its stack and answered with a 500 problem
+//   - http.ErrAbortHandler is re-raised, as net/http expects
+//
+// Nothing is written if the handler had already started the response.
+//
+// Example:
+//
+//	mux := http.NewServeMux()
+//	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+//	    user := service.GetUser(r.Context(), r.PathValue("id")).BubbleUp() // 404 if ErrNotFound
+//	    json.NewEncoder(w).Encode(user)
+//	})
+//	http.ListenAndServe(":8080", httpx.Recover()(mux))
+func Recover(opts ...Option) func(http.Handler) http.Handler {
+	cfg := config{logger: slog.Default()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tw := &trackingWriter{ResponseWriter: w}
+			defer func() {
+				p := recover()
+				if p == nil {
+					return
+				}
+				if p == http.ErrAbortHandler {
+					panic(p)
+				}
+				err := fmt.Errorf("%w: %v", ErrPanic, p)
+				cfg.logger.ErrorContext(r.Context(), "httpx: recovered panic",
+					"method", r.Method, "path", r.URL.Path, "panic", fmt.Sprint(p), "stack", string(debug.Stack()))
+				tw.writeProblem(r, err)
+			}()
+
+			if res := serve(next, tw.expose(), r); res.IsErr() {
+				cfg.logger.WarnContext(r.Context(), "httpx: BubbleUp escaped handler without result.Catch",
+					"method", r.Method, "path", r.URL.Path, "code", goxerrors.CodeOf(res.Err()), "error", res.Err())
+				tw.writeProblem(r, res.Err())
+			}
+		})
+	}
+}
+
+// -------------------------------------------- trackingWriter Methods --------------------------------------------
+
+// WriteHeader marks the response as started.
+func (w *trackingWriter) WriteHeader(status int) {
+	w.started = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write marks the response as started.
+func (w *trackingWriter) Write(b []byte) (int, error) {
+	w.started = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController.
+func (w *trackingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// expose returns w wrapped so that it implements http.Flusher and http.Hijacker exactly when the underlying
+// writer does, letting streaming and websocket handlers keep working behind Recover.
+func (w *trackingWriter) expose() http.ResponseWriter {
+	_, flusher := w.ResponseWriter.(http.Flusher)
+	_, hijacker := w.ResponseWriter.(http.Hijacker)
+	switch {
+	case flusher && hijacker:
+		return flushHijackWriter{w}
+	case flusher:
+		return flushWriter{w}
+	case hijacker:
+		return hijackWriter{w}
+	}
+	return w
+}
+
+// flush sends buffered data to the client, which starts the response.
+func (w *trackingWriter) flush() {
+	w.started = true
+	w.ResponseWriter.(http.Flusher).Flush()
+}
+
+// hijack takes over the connection; once it succeeds Recover can no longer write a response.
+func (w *trackingWriter) hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := w.ResponseWriter.(http.Hijacker).Hijack()
+	if err == nil {
+		w.started = true
+	}
+	return conn, rw, err
+}
+
+// writeProblem renders err unless the response has already started.
+func (w *trackingWriter) writeProblem(r *http.Request, err error) {
+	if !w.started {
+		goxerrors.WriteProblem(w, r, err)
+	}
+}
+
+// -------------------------------------------- flushWriter, hijackWriter and flushHijackWriter Methods --------------------------------------------
+
+// Flush implements http.Flusher.
+func (w flushWriter) Flush() { w.flush() }
+
+// Hijack implements http.Hijacker.
+func (w hijackWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) { return w.hijack() }
+
+// Flush implements http.Flusher.
+func (w flushHijackWriter) Flush() { w.flush() }
+
+// Hijack implements http.Hijacker.
+func (w flushHijackWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) { return w.hijack() }
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// serve runs next, turning a BubbleUp panic into an Err Result. Other panics propagate.
+func serve(next http.Handler, w http.ResponseWriter, r *http.Request) (res result.Result[types.Unit]) {
+	defer result.Catch(&res)
+	next.ServeHTTP(w, r)
+	return result.Ok(types.Unit{})
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package httpx_test. recover_test verifies BubbleUp and panic recovery into problem responses.
+package httpx_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/httpx"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+var ErrOrderNotFound = errors.New("order not found")
+
+func init() {
+	goxerrors.Register(ErrOrderNotFound, goxerrors.Meta{Code: "order_not_found", HTTPStatus: http.StatusNotFound})
+}
+
+func serve(t *testing.T, h http.HandlerFunc) (*httptest.ResponseRecorder, string) {
+	t.Helper()
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	rec := httptest.NewRecorder()
+	httpx.Recover(httpx.WithLogger(logger))(h).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/orders/7", nil))
+	return rec, logs.String()
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestRecover_BubbleUp(t *testing.T) {
+	//goxide:bubbles
+	rec, logs := serve(t, func(w http.ResponseWriter, r *http.Request) {
+		result.Err[int](ErrOrderNotFound).BubbleUp()
+	})
+
+	if rec.Code != http.StatusNotFound || !strings.Contains(rec.Body.String(), `"code":"order_not_found"`) {
+		t.Fatalf("expected 404 problem, got %d %s", rec.Code, rec.Body)
+	}
+	if !strings.Contains(logs, "without result.Catch") {
+		t.Fatalf("expected a warning about the missing Catch, got %q", logs)
+	}
+}
+
+func TestRecover_ArbitraryPanic(t *testing.T) {
+	rec, logs := serve(t, func(w http.ResponseWriter, r *http.Request) {
+		var m map[string]int
+		m["boom"]++
+	})
+
+	if rec.Code != http.StatusInternalServerError || strings.Contains(rec.Body.String(), "nil map") {
+		t.Fatalf("expected opaque 500 problem, got %d %s", rec.Code, rec.Body)
+	}
+	if !strings.Contains(logs, "level=ERROR") || !strings.Contains(logs, "stack=") {
+		t.Fatalf("expected an error log with stack, got %q", logs)
+	}
+}
+
+func TestRecover_ResponseAlreadyStarted(t *testing.T) {
+	rec, _ := serve(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		panic("late failure")
+	})
+	if rec.Code != http.StatusAccepted || rec.Body.Len() != 0 {
+		t.Fatalf("expected untouched 202 response, got %d %s", rec.Code, rec.Body)
+	}
+}
+
+func TestRecover_PassThrough(t *testing.T) {
+	rec, logs := serve(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	if rec.Code != http.StatusOK || rec.Body.String() != "ok" || logs != "" {
+		t.Fatalf("expected untouched response, got %d %s (logs %q)", rec.Code, rec.Body, logs)
+	}
+}
+
+func TestRecover_Flush(t *testing.T) {
+	rec, _ := serve(t, func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := w.(http.Hijacker); ok {
+			t.Error("expected no Hijacker over a ResponseRecorder")
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			t.Fatal("expected the wrapped writer to implement http.Flusher")
+		}
+		w.Write([]byte("data: 1\n\n"))
+		flusher.Flush()
+		panic("stream broke")
+	})
+	if !rec.Flushed || rec.Code != http.StatusOK || rec.Body.String() != "data: 1\n\n" {
+		t.Fatalf("expected the flushed event and no problem, got %d %q (flushed %v)", rec.Code, rec.Body, rec.Flushed)
+	}
+}
+
+func TestRecover_Hijack(t *testing.T) {
+	srv := httptest.NewServer(httpx.Recover()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hijacker, ok := w.(http.Hijacker)
+		if !ok {
+			t.Error("expected the wrapped writer to implement http.Hijacker")
+			return
+		}
+		conn, buf, err := hijacker.Hijack()
+		if err != nil {
+			t.Errorf("expected nil, got %v", err)
+			return
+		}
+		defer conn.Close()
+		buf.WriteString("HTTP/1.1 204 No Content\r\nConnection: close\r\n\r\n")
+		buf.Flush()
+	})))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected %v, got %v", http.StatusNoContent, resp.StatusCode)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package iox. iox wraps the io and os file helpers so they return Results, and pairs opening a file with
+// a scope that always closes it, so file-handling code needs no result.Wrap or manual defer Close.
+//
+// Example - Traditional vs iox:
+//
+//	// Traditional Go
+//	f, err := os.Open(path)
+//	if err != nil {
+//	    return result.Err[Report](err)
+//	}
+//	defer f.Close()
+//	data, err := io.ReadAll(f)
+//	if err != nil {
+//	    return result.Err[Report](err)
+//	}
+//
+//	// With iox
+//	data := iox.ReadFile(path).BubbleUp()
+package iox
+
+import (
+	"io"
+	"os"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// ReadAll reads r until EOF, as io.ReadAll does.
+func ReadAll(r io.Reader) result.Result[[]byte] {
+	return result.Wrap(io.ReadAll(r))
+}
+
+// ReadFile reads the whole named file, as os.ReadFile does.
+func ReadFile(path string) result.Result[[]byte] {
+	return result.Wrap(os.ReadFile(path))
+}
+
+// WriteFile writes data to the named file, creating it with perm if needed, as os.WriteFile does.
+func WriteFile(path string, data []byte, perm os.FileMode) result.Result[types.Unit] {
+	return result.Wrap(types.Unit{}, os.WriteFile(path, data, perm))
+}
+
+// Copy copies from src to dst until EOF, returning the number of bytes copied.
+func Copy(dst io.Writer, src io.Reader) result.Result[int64] {
+	return result.Wrap(io.Copy(dst, src))
+}
+
+// CopyN copies exactly n bytes from src to dst. Copying fewer bytes is an Err wrapping io.EOF,
+// as with io.CopyN.
+func CopyN(dst io.Writer, src io.Reader, n int64) result.Result[int64] {
+	return result.Wrap(io.CopyN(dst, src, n))
+}
+
+// Open opens the named file for reading.
+func Open(path string) result.Result[*os.File] {
+	return result.Wrap(os.Open(path))
+}
+
+// Create creates or truncates the named file for writing.
+func Create(path string) result.Result[*os.File] {
+	return result.Wrap(os.Create(path))
+}
+
+// WithFile opens the named file, runs fn with it and closes it on every path, including BubbleUp panics
+// inside fn. A Close error is reported like any other (see result.With).
+//
+// Example:
+//
+//	func CountLines(path string) result.Result[int] {
+//	    return iox.WithFile(path, func(f *os.File) result.Result[int] {
+//	        n := 0
+//	        for s := bufio.NewScanner(f); s.Scan(); n++ {
+//	        }
+//	        return result.Ok(n)
+//	    })
+//	}
+func WithFile[T any](path string, fn func(*os.File) result.Result[T]) result.Result[T] {
+	return result.With(Open(path), fn)
+}
+
+// WithCreate creates or truncates the named file, runs fn with it and closes it on every path. Because a
+// failed Close can lose buffered writes, its error turns an Ok into Err.
+//
+// Example:
+//
+//	res := iox.WithCreate("report.csv", func(f *os.File) result.Result[types.Unit] {
+//	    return writeReport(f, rows)
+//	})
+func WithCreate[T any](path string, fn func(*os.File) result.Result[T]) result.Result[T] {
+	return result.With(Create(path), fn)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package iox_test. iox_test verifies the Result-returning wrappers and scoped file handling.
+package iox_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/iox"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+func TestWriteReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.txt")
+	iox.WriteFile(path, []byte("hello"), 0o600).Unwrap()
+	if got := string(iox.ReadFile(path).Unwrap()); got != "hello" {
+		t.Fatalf("expected %v, got %v", "hello", got)
+	}
+	if res := iox.ReadFile(filepath.Join(t.TempDir(), "missing")); !errors.Is(res.Err(), fs.ErrNotExist) {
+		t.Fatalf("expected %v, got %v", fs.ErrNotExist, res.Err())
+	}
+}
+
+func TestCopyN(t *testing.T) {
+	var dst bytes.Buffer
+	if n := iox.CopyN(&dst, strings.NewReader("abcdef"), 4).Unwrap(); n != 4 || dst.String() != "abcd" {
+		t.Fatalf("expected 4 bytes %q, got %d %q", "abcd", n, dst.String())
+	}
+	if res := iox.CopyN(&dst, strings.NewReader("ab"), 4); !errors.Is(res.Err(), io.EOF) {
+		t.Fatalf("expected %v, got %v", io.EOF, res.Err())
+	}
+}
+
+func TestWithFile_ClosesOnBubbleUp(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.txt")
+	var opened *os.File
+	res := iox.WithCreate(path, func(f *os.File) (res result.Result[types.Unit]) {
+		defer result.Catch(&res)
+		opened = f
+		iox.ReadFile(filepath.Join(t.TempDir(), "missing")).BubbleUp()
+		return result.Ok(types.Unit{})
+	})
+	if !errors.Is(res.Err(), fs.ErrNotExist) {
+		t.Fatalf("expected %v, got %v", fs.ErrNotExist, res.Err())
+	}
+	if _, err := opened.Write([]byte("x")); !errors.Is(err, os.ErrClosed) {
+		t.Fatalf("expected file to be closed, got %v", err)
+	}
+
+	got := iox.WithFile(path, func(f *os.File) result.Result[[]byte] { return iox.ReadAll(f) }).Unwrap()
+	if len(got) != 0 {
+		t.Fatalf("expected empty file, got %q", got)
+	}
+}
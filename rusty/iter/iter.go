@@ -0,0 +1,97 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package iter. iter provides fallible iteration over iter.Seq[Result[T]], short-circuiting on the
+// first Err the way Rust's try_fold/collect::<Result<Vec<_>, _>> do.
+//
+// Streaming parsers and row scanners naturally produce one Result per item. These helpers let the
+// first failure stop the stream and surface as a single Result, without manual loops.
+//
+// Example - Scanning rows:
+//
+//	func Rows(rows *sql.Rows) iter.Seq[result.Result[User]] {
+//	    return func(yield func(result.Result[User]) bool) {
+//	        defer rows.Close()
+//	        for rows.Next() {
+//	            var u User
+//	            if !yield(result.Wrap(u, rows.Scan(&u.ID, &u.Name))) {
+//	                return
+//	            }
+//	        }
+//	    }
+//	}
+//
+//	users := rustyiter.Collect(Rows(rows)) // Result[[]User], Err on first scan failure
+package iter
+
+import (
+	"iter"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// TryMap applies fn to every Ok value of seq.
+// The returned sequence yields the first Err it meets (from seq or from fn) and then stops.
+//
+// Example:
+//
+//	orders := rustyiter.TryMap(lines, func(line string) result.Result[Order] {
+//	    return parseOrder(line)
+//	})
+func TryMap[T, U any](seq iter.Seq[result.Result[T]], fn func(T) result.Result[U]) iter.Seq[result.Result[U]] {
+	return func(yield func(result.Result[U]) bool) {
+		for item := range seq {
+			mapped := result.AndThen(item, fn)
+			if !yield(mapped) || mapped.IsErr() {
+				return
+			}
+		}
+	}
+}
+
+// TryFold folds the Ok values of seq into an accumulator, starting from init.
+// Returns the first Err met (from seq or from fn), or Ok with the final accumulator.
+//
+// Example:
+//
+//	total := rustyiter.TryFold(amounts, 0.0, func(sum float64, amount float64) result.Result[float64] {
+//	    if amount < 0 {
+//	        return result.Err[float64](ErrNegativeAmount)
+//	    }
+//	    return result.Ok(sum + amount)
+//	})
+func TryFold[T, A any](seq iter.Seq[result.Result[T]], init A, fn func(A, T) result.Result[A]) result.Result[A] {
+	acc := init
+	for item := range seq {
+		if item.IsErr() {
+			return result.Err[A](item.Err())
+		}
+		next := fn(acc, item.Unwrap())
+		if next.IsErr() {
+			return next
+		}
+		acc = next.Unwrap()
+	}
+	return result.Ok(acc)
+}
+
+// Collect gathers the Ok values of seq into a slice, stopping at the first Err.
+//
+// Example:
+//
+//	users := rustyiter.Collect(Rows(rows))
+//	if users.IsErr() {
+//	    return result.Err[Report](users.Err())
+//	}
+func Collect[T any](seq iter.Seq[result.Result[T]]) result.Result[[]T] {
+	values := make([]T, 0)
+	for item := range seq {
+		if item.IsErr() {
+			return result.Err[[]T](item.Err())
+		}
+		values = append(values, item.Unwrap())
+	}
+	return result.Ok(values)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package iter_test. iter_test verifies short-circuiting fallible iteration.
+package iter_test
+
+import (
+	"errors"
+	"iter"
+	"strconv"
+	"testing"
+
+	rustyiter "github.com/seyedali-dev/goxide/rusty/iter"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Test Helpers --------------------------------------------
+
+var ErrNegative = errors.New("negative value")
+
+// parseAll yields one Result per input and counts how many inputs were consumed.
+func parseAll(inputs []string, consumed *int) iter.Seq[result.Result[int]] {
+	return func(yield func(result.Result[int]) bool) {
+		for _, in := range inputs {
+			*consumed++
+			if !yield(result.Wrap(strconv.Atoi(in))) {
+				return
+			}
+		}
+	}
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestCollect_AllOk(t *testing.T) {
+	var consumed int
+	res := rustyiter.Collect(parseAll([]string{"1", "2", "3"}, &consumed))
+
+	if res.IsErr() {
+		t.Fatalf("expected Ok, got Err: %v", res.Err())
+	}
+	if got := res.Unwrap(); len(got) != 3 || got[2] != 3 {
+		t.Fatalf("unexpected values: %v", got)
+	}
+}
+
+func TestCollect_ShortCircuits(t *testing.T) {
+	var consumed int
+	res := rustyiter.Collect(parseAll([]string{"1", "x", "3"}, &consumed))
+
+	if res.IsOk() {
+		t.Fatal("expected Err, got Ok")
+	}
+	if consumed != 2 {
+		t.Fatalf("expected iteration to stop after 2 items, consumed %d", consumed)
+	}
+}
+
+func TestTryMap_StopsOnMapperError(t *testing.T) {
+	var consumed int
+	positive := func(x int) result.Result[int] {
+		if x < 0 {
+			return result.Err[int](ErrNegative)
+		}
+		return result.Ok(x * 10)
+	}
+
+	res := rustyiter.Collect(rustyiter.TryMap(parseAll([]string{"1", "-2", "3"}, &consumed), positive))
+
+	if !errors.Is(res.Err(), ErrNegative) {
+		t.Fatalf("expected ErrNegative, got %v", res.Err())
+	}
+	if consumed != 2 {
+		t.Fatalf("expected iteration to stop after 2 items, consumed %d", consumed)
+	}
+}
+
+func TestTryFold(t *testing.T) {
+	var consumed int
+	sum := func(acc, x int) result.Result[int] { return result.Ok(acc + x) }
+
+	res := rustyiter.TryFold(parseAll([]string{"1", "2", "3"}, &consumed), 10, sum)
+	if res.Unwrap() != 16 {
+		t.Fatalf("expected 16, got %d", res.Unwrap())
+	}
+
+	res = rustyiter.TryFold(parseAll([]string{"1", "bad"}, &consumed), 0, sum)
+	if res.IsOk() {
+		t.Fatal("expected Err from invalid input")
+	}
+}
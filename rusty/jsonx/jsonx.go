@@ -0,0 +1,102 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package jsonx. jsonx wraps encoding/json so that encoding and decoding return Results, and adds a
+// streaming NDJSON decoder that reports bad lines individually instead of abandoning the stream.
+//
+// Example - Traditional vs jsonx:
+//
+//	// Traditional Go
+//	var req CreateUser
+//	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+//	    return result.Err[User](err)
+//	}
+//
+//	// With jsonx
+//	req := jsonx.Decode[CreateUser](r.Body).BubbleUp()
+package jsonx
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Decode reads one JSON value from r into a T.
+//
+// Example:
+//
+//	func (h *Handler) Create(r *http.Request) result.Result[User] {
+//	    req := jsonx.Decode[CreateUser](r.Body).BubbleUp()
+//	    return h.users.Create(r.Context(), req)
+//	}
+func Decode[T any](r io.Reader) result.Result[T] {
+	var v T
+	if err := json.NewDecoder(r).Decode(&v); err != nil {
+		return result.Err[T](err)
+	}
+	return result.Ok(v)
+}
+
+// Unmarshal parses data into a T.
+func Unmarshal[T any](data []byte) result.Result[T] {
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return result.Err[T](err)
+	}
+	return result.Ok(v)
+}
+
+// Marshal encodes v as JSON.
+func Marshal(v any) result.Result[[]byte] {
+	return result.Wrap(json.Marshal(v))
+}
+
+// DecodeSeq decodes newline-delimited JSON (NDJSON) from r, yielding one Result per non-blank line.
+// A line that fails to decode yields an Err annotated with its line number and decoding continues with
+// the next line; a read error yields a final Err and ends the sequence.
+//
+// When to use:
+//   - When importing or replaying NDJSON exports and logs
+//   - When a few malformed records must not abort the whole file
+//
+// Example:
+//
+//	for res := range jsonx.DecodeSeq[Event](file) {
+//	    if res.IsErr() {
+//	        log.Printf("skipping: %v", res.Err())
+//	        continue
+//	    }
+//	    apply(res.Unwrap())
+//	}
+func DecodeSeq[T any](r io.Reader) iter.Seq[result.Result[T]] {
+	return func(yield func(result.Result[T]) bool) {
+		reader := bufio.NewReader(r)
+		for lineNo := 1; ; lineNo++ {
+			line, err := reader.ReadBytes('\n')
+			if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 {
+				res := Unmarshal[T](trimmed).MapError(func(err error) error {
+					return fmt.Errorf("jsonx: line %d: %w", lineNo, err)
+				})
+				if !yield(res) {
+					return
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(result.Err[T](fmt.Errorf("jsonx: line %d: %w", lineNo, err)))
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package jsonx_test. jsonx_test verifies Result-returning decoding and per-line NDJSON errors.
+package jsonx_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/seyedali-dev/goxide/rusty/jsonx"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+type event struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind"`
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestDecode(t *testing.T) {
+	got := jsonx.Decode[event](strings.NewReader(`{"id":1,"kind":"created"}`)).Unwrap()
+	if want := (event{ID: 1, Kind: "created"}); got != want {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if res := jsonx.Decode[event](strings.NewReader(`{"id":`)); res.IsOk() {
+		t.Fatal("expected Err for truncated input")
+	}
+}
+
+func TestMarshalUnmarshal_RoundTrip(t *testing.T) {
+	data := jsonx.Marshal(event{ID: 2, Kind: "deleted"}).Unwrap()
+	if got := string(data); got != `{"id":2,"kind":"deleted"}` {
+		t.Fatalf("expected %v, got %v", `{"id":2,"kind":"deleted"}`, got)
+	}
+	if got := jsonx.Unmarshal[event](data).Unwrap(); got.ID != 2 {
+		t.Fatalf("expected %v, got %v", 2, got.ID)
+	}
+	if res := jsonx.Marshal(make(chan int)); res.IsOk() {
+		t.Fatal("expected Err for unsupported type")
+	}
+}
+
+func TestDecodeSeq_ContinuesPastBadLines(t *testing.T) {
+	input := "{\"id\":1}\n\nnot json\n{\"id\":3}" // blank line skipped, no trailing newline
+	var ids []int
+	var errs []error
+	for res := range jsonx.DecodeSeq[event](strings.NewReader(input)) {
+		if res.IsErr() {
+			errs = append(errs, res.Err())
+			continue
+		}
+		ids = append(ids, res.Unwrap().ID)
+	}
+
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 3 {
+		t.Fatalf("expected %v, got %v", []int{1, 3}, ids)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "line 3") {
+		t.Fatalf("expected one error on line 3, got %v", errs)
+	}
+}
+
+func TestDecodeSeq_ReadError(t *testing.T) {
+	ErrBroken := errors.New("broken pipe")
+	var last error
+	for res := range jsonx.DecodeSeq[event](iotest.ErrReader(ErrBroken)) {
+		last = res.Err()
+	}
+	if !errors.Is(last, ErrBroken) {
+		t.Fatalf("expected %v, got %v", ErrBroken, last)
+	}
+}
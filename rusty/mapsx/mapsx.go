@@ -0,0 +1,95 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package mapsx. mapsx provides free functions over plain maps that return Option instead of (value, ok)
+// pairs, together with the everyday helpers missing from the maps package, complementing slicesx.
+//
+// Example - Traditional vs mapsx:
+//
+//	// Traditional Go
+//	limit, ok := limits[plan]
+//	if !ok {
+//	    limit = defaultLimit
+//	}
+//
+//	// With mapsx
+//	limit := mapsx.Get(limits, plan).UnwrapOr(defaultLimit)
+package mapsx
+
+import (
+	"iter"
+	"maps"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Get returns the value stored under k, or None if m has no such key.
+func Get[K comparable, V any](m map[K]V, k K) option.Option[V] {
+	if v, ok := m[k]; ok {
+		return option.Some(v)
+	}
+	return option.None[V]()
+}
+
+// GetOrInsertWith returns the value stored under k, first storing fn() under k if m has no such key.
+// fn is only called when the key is missing. m must not be nil.
+//
+// Example - Building an index:
+//
+//	for _, o := range orders {
+//	    list := mapsx.GetOrInsertWith(byCustomer, o.CustomerID, func() *[]Order { return new([]Order) })
+//	    *list = append(*list, o)
+//	}
+func GetOrInsertWith[K comparable, V any](m map[K]V, k K, fn func() V) V {
+	if v, ok := m[k]; ok {
+		return v
+	}
+	v := fn()
+	m[k] = v
+	return v
+}
+
+// Keys returns an iterator over the keys of m in unspecified order, as maps.Keys does.
+func Keys[K comparable, V any](m map[K]V) iter.Seq[K] {
+	return maps.Keys(m)
+}
+
+// Values returns an iterator over the values of m in unspecified order, as maps.Values does.
+func Values[K comparable, V any](m map[K]V) iter.Seq[V] {
+	return maps.Values(m)
+}
+
+// Invert returns a map from the values of m to their keys. If several keys share a value, which of them
+// ends up in the result is unspecified.
+//
+// Example:
+//
+//	codeOf := mapsx.Invert(nameOf) // map[string]int from map[int]string
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	out := make(map[V]K, len(m))
+	for k, v := range m {
+		out[v] = k
+	}
+	return out
+}
+
+// MergeWith returns a new map holding the entries of every map in ms. When a key appears in more than one
+// map, conflict combines the value merged so far (a) with the later one (b), in the order of ms.
+//
+// Example - Summing counters from several shards:
+//
+//	totals := mapsx.MergeWith(func(a, b int) int { return a + b }, shardA, shardB, shardC)
+func MergeWith[K comparable, V any](conflict func(a, b V) V, ms ...map[K]V) map[K]V {
+	out := make(map[K]V)
+	for _, m := range ms {
+		for k, v := range m {
+			if prev, ok := out[k]; ok {
+				v = conflict(prev, v)
+			}
+			out[k] = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package mapsx_test. mapsx_test verifies the Option-returning lookups and map helpers.
+package mapsx_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/mapsx"
+)
+
+func TestGet(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+	if mapsx.Get(m, "a").Unwrap() != 1 || mapsx.Get(m, "zero").IsNone() {
+		t.Fatal("expected Some for present keys, including zero values")
+	}
+	if mapsx.Get(m, "b").IsSome() || mapsx.Get(map[string]int(nil), "a").IsSome() {
+		t.Fatal("expected None for missing keys")
+	}
+}
+
+func TestGetOrInsertWith(t *testing.T) {
+	m := map[string][]int{}
+	calls := 0
+	fn := func() []int {
+		calls++
+		return []int{1}
+	}
+	mapsx.GetOrInsertWith(m, "a", fn)
+	if got := mapsx.GetOrInsertWith(m, "a", fn); !slices.Equal(got, []int{1}) || calls != 1 {
+		t.Fatalf("expected a single insertion, got %v after %v calls", got, calls)
+	}
+}
+
+func TestKeysValuesInvert(t *testing.T) {
+	m := map[int]string{1: "one", 2: "two"}
+	if got := slices.Sorted(mapsx.Keys(m)); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("unexpected keys: %v", got)
+	}
+	if got := slices.Sorted(mapsx.Values(m)); !slices.Equal(got, []string{"one", "two"}) {
+		t.Fatalf("unexpected values: %v", got)
+	}
+	if got := mapsx.Invert(m); !maps.Equal(got, map[string]int{"one": 1, "two": 2}) {
+		t.Fatalf("unexpected inverse: %v", got)
+	}
+}
+
+func TestMergeWith(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+	got := mapsx.MergeWith(sum, map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3, "c": 4}, map[string]int{"b": 10})
+	if want := map[string]int{"a": 1, "b": 15, "c": 4}; !maps.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got := mapsx.MergeWith[string](sum); got == nil || len(got) != 0 {
+		t.Fatalf("expected an empty map, got %v", got)
+	}
+}
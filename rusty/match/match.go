@@ -0,0 +1,144 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package match. match provides expression-style pattern matching with guards and type cases,
+// modeled on Rust's match: cases are tried in order and the first one that applies produces the result.
+//
+// Example - Traditional vs match:
+//
+//	// Traditional Go
+//	var area float64
+//	switch s := shape.(type) {
+//	case Circle:
+//	    area = math.Pi * s.R * s.R
+//	case Rect:
+//	    if s.W == s.H {
+//	        area = s.W * s.W
+//	    } else {
+//	        area = s.W * s.H
+//	    }
+//	default:
+//	    area = 0
+//	}
+//
+//	// With match
+//	area := match.Value[float64](shape).
+//	    Case(match.WhenType(func(c Circle) float64 { return math.Pi * c.R * c.R })).
+//	    Case(match.WhenTypeIf(Rect.IsSquare, func(r Rect) float64 { return r.W * r.W })).
+//	    Case(match.WhenType(func(r Rect) float64 { return r.W * r.H })).
+//	    Else(func(Shape) float64 { return 0 })
+package match
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Matcher [R, V] matches a value of type V against cases producing R.
+// Once a case matches, later cases are skipped.
+type Matcher[R, V any] struct {
+	value V
+	out   option.Option[R]
+}
+
+// Case [R] is a reusable, type-directed case: it returns Some(result) if it applies to the value.
+type Case[R any] func(value any) option.Option[R]
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Value starts matching v. R is the result type and must be given explicitly; V is inferred.
+//
+// Example:
+//
+//	label := match.Value[string](code).
+//	    When(match.Eq(200), func(int) string { return "ok" }).
+//	    When(match.OneOf(301, 302), func(int) string { return "redirect" }).
+//	    When(func(c int) bool { return c >= 500 }, func(int) string { return "server error" }).
+//	    Else(func(c int) string { return strconv.Itoa(c) })
+func Value[R, V any](v V) *Matcher[R, V] {
+	return &Matcher[R, V]{value: v}
+}
+
+// WhenType returns a Case that applies when the value's dynamic type is T.
+func WhenType[T, R any](fn func(T) R) Case[R] {
+	return WhenTypeIf(func(T) bool { return true }, fn)
+}
+
+// WhenTypeIf returns a Case that applies when the value's dynamic type is T and guard holds.
+//
+// Example:
+//
+//	match.WhenTypeIf(func(e *HTTPError) bool { return e.Status >= 500 }, func(e *HTTPError) Action {
+//	    return Retry
+//	})
+func WhenTypeIf[T, R any](guard func(T) bool, fn func(T) R) Case[R] {
+	return func(value any) option.Option[R] {
+		if t, ok := value.(T); ok && guard(t) {
+			return option.Some(fn(t))
+		}
+		return option.None[R]()
+	}
+}
+
+// Eq returns a guard matching values equal to target.
+func Eq[V comparable](target V) func(V) bool {
+	return func(v V) bool {
+		return v == target
+	}
+}
+
+// OneOf returns a guard matching values equal to any of targets.
+func OneOf[V comparable](targets ...V) func(V) bool {
+	return func(v V) bool {
+		return slices.Contains(targets, v)
+	}
+}
+
+// -------------------------------------------- Matcher Methods --------------------------------------------
+
+// When applies fn if no earlier case matched and pred holds for the value.
+// Method expressions make Option and Result values easy to match:
+//
+//	match.Value[string](opt).
+//	    When(option.Option[User].IsSome, func(o option.Option[User]) string { return o.Unwrap().Name }).
+//	    Else(func(option.Option[User]) string { return "anonymous" })
+func (m *Matcher[R, V]) When(pred func(V) bool, fn func(V) R) *Matcher[R, V] {
+	if m.out.IsNone() && pred(m.value) {
+		m.out = option.Some(fn(m.value))
+	}
+	return m
+}
+
+// Case applies c if no earlier case matched.
+func (m *Matcher[R, V]) Case(c Case[R]) *Matcher[R, V] {
+	if m.out.IsNone() {
+		m.out = c(m.value)
+	}
+	return m
+}
+
+// Else returns the matched result, or fn(value) if no case matched.
+func (m *Matcher[R, V]) Else(fn func(V) R) R {
+	if m.out.IsSome() {
+		return m.out.Unwrap()
+	}
+	return fn(m.value)
+}
+
+// Result returns the matched result, or None if no case matched.
+func (m *Matcher[R, V]) Result() option.Option[R] {
+	return m.out
+}
+
+// Must returns the matched result and panics if no case matched, for matches that are meant
+// to be exhaustive (a panic here points at an unhandled variant).
+func (m *Matcher[R, V]) Must() R {
+	if m.out.IsNone() {
+		panic(fmt.Sprintf("match: no case matched %v (%T)", m.value, m.value))
+	}
+	return m.out.Unwrap()
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package match_test. match_test verifies guards, type cases and fallthrough behavior.
+package match_test
+
+import (
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/match"
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+type Shape interface{ isShape() }
+
+type Circle struct{ R float64 }
+
+type Rect struct{ W, H float64 }
+
+func (Circle) isShape() {}
+func (Rect) isShape()   {}
+
+func (r Rect) IsSquare() bool { return r.W == r.H }
+
+func describe(s Shape) string {
+	return match.Value[string](s).
+		Case(match.WhenType(func(Circle) string { return "circle" })).
+		Case(match.WhenTypeIf(Rect.IsSquare, func(Rect) string { return "square" })).
+		Case(match.WhenType(func(Rect) string { return "rect" })).
+		Else(func(Shape) string { return "unknown" })
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestMatch_TypeCasesWithGuards(t *testing.T) {
+	cases := map[string]Shape{"circle": Circle{1}, "square": Rect{2, 2}, "rect": Rect{2, 3}, "unknown": nil}
+	for want, shape := range cases {
+		if got := describe(shape); got != want {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestMatch_FirstMatchWins(t *testing.T) {
+	got := match.Value[string](302).
+		When(match.Eq(200), func(int) string { return "ok" }).
+		When(match.OneOf(301, 302), func(int) string { return "redirect" }).
+		When(func(c int) bool { return c >= 300 }, func(int) string { return "other" }).
+		Must()
+	if got != "redirect" {
+		t.Fatalf("expected %v, got %v", "redirect", got)
+	}
+}
+
+func TestMatch_OptionAndNoMatch(t *testing.T) {
+	got := match.Value[int](option.Some(4)).
+		When(option.Option[int].IsSome, func(o option.Option[int]) int { return o.Unwrap() * 2 }).
+		Result()
+	if got.Unwrap() != 8 {
+		t.Fatalf("expected %v, got %v", 8, got.Unwrap())
+	}
+
+	if match.Value[int]("x").When(match.Eq("y"), func(string) int { return 1 }).Result().IsSome() {
+		t.Fatal("expected no match")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Must to panic without a match")
+		}
+	}()
+	match.Value[int](0).Must()
+}
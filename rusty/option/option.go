@@ -12,7 +12,10 @@
 //   - Parsing operations that may fail (instead of returning zero values)
 package option
 
-import "github.com/seyedali-dev/goxide/rusty/types"
+import (
+	"github.com/seyedali-dev/goxide/internal/optionset"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
 
 // -------------------------------------------- Types --------------------------------------------
 
@@ -24,9 +27,17 @@ import "github.com/seyedali-dev/goxide/rusty/types"
 //   - Type-safe: The compiler forces you to handle both Some and None cases
 //   - Explicit: Function signatures clearly show when a value might be absent
 //   - Chainable: Methods like Map and FlatMap enable functional composition
+//
+// For comparable T, Options compare with ==: two Somes are equal when their values are, and all Nones are
+// equal. Options can therefore be used as map keys and in dedup/set code.
 type Option[T any] struct {
 	isSome bool
-	value  *T
+	value  T // the zero T when None, so that == ignores it
+}
+
+// someSetter is implemented by every *Option[T]; it lets optionset store a value of a run-time type.
+type someSetter interface {
+	setSome(value any)
 }
 
 // -------------------------------------------- Public Functions --------------------------------------------
@@ -50,7 +61,7 @@ type Option[T any] struct {
 func Some[T any](value T) Option[T] {
 	return Option[T]{
 		isSome: true,
-		value:  &value,
+		value:  value,
 	}
 }
 
@@ -151,7 +162,7 @@ func (optn Option[T]) IsNone() bool {
 //	}
 func (optn Option[T]) Expect(panicMsg string) T {
 	if optn.IsSome() {
-		return *optn.value
+		return optn.value
 	}
 	panic(panicMsg)
 }
@@ -265,6 +276,23 @@ func (optn Option[T]) Some(out *T) bool {
 	return false
 }
 
+// Inspect calls fn with the contained value if present and returns the Option unchanged.
+// Mirrors Rust's Option::inspect.
+//
+// When to use:
+//   - When logging, tracing or counting hits inside an Option pipeline
+//   - When a side effect should not change the value being passed along
+//
+// Example - Counting cache hits:
+//
+//	user := cache.Get(id).Inspect(func(User) { cacheHits.Inc() })
+func (optn Option[T]) Inspect(fn func(T)) Option[T] {
+	if optn.IsSome() {
+		fn(optn.value)
+	}
+	return optn
+}
+
 // If applies someFn if Option contains a value, otherwise applies noneFn.
 // This is a functional-style conditional that avoids manual if-else branching.
 //
@@ -426,3 +454,14 @@ func Cast[T any](value any) Option[T] {
 	}
 	return None[T]()
 }
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+func init() {
+	optionset.Install(func(ptr, value any) { ptr.(someSetter).setSome(value) })
+}
+
+// setSome implements someSetter.
+func (optn *Option[T]) setSome(value any) {
+	*optn = Some(value.(T))
+}
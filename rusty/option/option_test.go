@@ -0,0 +1,42 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package option_test. option_test verifies Option equality, its use as a map key, and Inspect.
+package option_test
+
+import (
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+func TestOption_Comparable(t *testing.T) {
+	if option.Some(1) != option.Some(1) {
+		t.Fatal("expected equal Some values to compare equal")
+	}
+	if option.Some(1) == option.Some(2) || option.Some(0) == option.None[int]() {
+		t.Fatal("expected different Options to compare unequal")
+	}
+
+	var zero option.Option[int]
+	if zero != option.None[int]() {
+		t.Fatal("expected the zero Option to equal None")
+	}
+
+	seen := map[option.Option[string]]int{}
+	for _, o := range []option.Option[string]{option.Some("a"), option.None[string](), option.Some("a"), option.None[string]()} {
+		seen[o]++
+	}
+	if len(seen) != 2 || seen[option.Some("a")] != 2 {
+		t.Fatalf("expected %v, got %v", 2, len(seen))
+	}
+}
+
+func TestOption_Inspect(t *testing.T) {
+	var seen []int
+	got := option.Some(4).Inspect(func(v int) { seen = append(seen, v) })
+	option.None[int]().Inspect(func(v int) { seen = append(seen, v) })
+	if got != option.Some(4) || len(seen) != 1 || seen[0] != 4 {
+		t.Fatalf("expected %v, got %v", []int{4}, seen)
+	}
+}
@@ -0,0 +1,20 @@
+module github.com/seyedali-dev/goxide/rusty/otelx
+
+go 1.25.0
+
+require (
+	github.com/seyedali-dev/goxide v0.0.0-20261016191056-12d6c9fcf26a
+	go.opentelemetry.io/otel v1.37.0
+	go.opentelemetry.io/otel/metric v1.37.0
+	go.opentelemetry.io/otel/sdk v1.37.0
+	go.opentelemetry.io/otel/sdk/metric v1.37.0
+	go.opentelemetry.io/otel/trace v1.37.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	golang.org/x/sys v0.46.0 // indirect
+)
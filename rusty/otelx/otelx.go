@@ -0,0 +1,190 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package otelx. otelx instruments Result-based code with OpenTelemetry: chain steps and whole operations
+// become spans whose status follows the Result, retry attempts become span events through retry.OnAttempt,
+// and every Err created anywhere is counted by type and registered code through goxerrors.OnError.
+//
+// Example - Wiring it up:
+//
+//	func main() {
+//	    otelx.InstrumentErrors(otel.Meter("checkout")).BubbleUp()
+//	    ...
+//	}
+//
+//	func (s *Service) Checkout(ctx context.Context, cartID string) result.Result[Receipt] {
+//	    return otelx.Span(ctx, "checkout", func(ctx context.Context) result.Result[Receipt] {
+//	        return result.AndThen(
+//	            s.loadCart(ctx, cartID),
+//	            otelx.Step(ctx, "charge", func(ctx context.Context, c Cart) result.Result[Receipt] {
+//	                return retry.Do(ctx, func(ctx context.Context) result.Result[Receipt] {
+//	                    return s.gateway.Charge(ctx, c)
+//	                }, otelx.RetryAttempts(ctx))
+//	            }),
+//	        )
+//	    })
+//	}
+package otelx
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/resilience/retry"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Option configures Span and Step.
+type Option func(*config)
+
+// config holds the settings applied by Option.
+type config struct {
+	tracer trace.Tracer
+	attrs  []attribute.KeyValue
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ScopeName is the instrumentation scope under which spans and metrics are reported.
+const ScopeName = "github.com/seyedali-dev/goxide/rusty/otelx"
+
+// Attribute keys set on spans, events and the error counter.
+const (
+	AttrErrorType = attribute.Key("error.type")
+	AttrErrorCode = attribute.Key("goxide.error.code")
+	AttrAttempt   = attribute.Key("goxide.retry.attempt")
+	AttrDelay     = attribute.Key("goxide.retry.delay_ms")
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithTracer sets the tracer used for spans (default: the global provider's tracer for ScopeName).
+func WithTracer(tracer trace.Tracer) Option {
+	return func(c *config) { c.tracer = tracer }
+}
+
+// WithAttributes adds attributes to the span.
+func WithAttributes(attrs ...attribute.KeyValue) Option {
+	return func(c *config) { c.attrs = append(c.attrs, attrs...) }
+}
+
+// Span runs fn inside a new span named name and ends it with fn's outcome: an Err is recorded with
+// RecordError and sets the span status to Error. A BubbleUp panic inside fn is caught, recorded as the
+// Err it carries and re-raised after the span has ended; any other panic is recorded and re-raised as is.
+//
+// Example:
+//
+//	return otelx.Span(ctx, "users.find", func(ctx context.Context) result.Result[User] {
+//	    return repo.Find(ctx, id)
+//	}, otelx.WithAttributes(attribute.Int("user.id", id)))
+func Span[T any](ctx context.Context, name string, fn func(context.Context) result.Result[T], opts ...Option) (res result.Result[T]) {
+	cfg := config{tracer: otel.Tracer(ScopeName)}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	ctx, span := cfg.tracer.Start(ctx, name, trace.WithAttributes(cfg.attrs...))
+	returned := false
+	defer func() {
+		if r := recover(); r != nil { // not a BubbleUp: result.Catch re-raised it
+			if err, ok := r.(error); ok {
+				RecordError(span, err)
+			} else {
+				span.SetStatus(codes.Error, fmt.Sprint(r))
+			}
+			span.End()
+			panic(r)
+		}
+		if res.IsErr() {
+			RecordError(span, res.Err())
+		}
+		// End before re-raising, so the SDK does not record the panic value as a second exception.
+		span.End()
+		if !returned {
+			res.BubbleUp()
+		}
+	}()
+	defer result.Catch(&res)
+
+	res = fn(ctx)
+	returned = true
+	return res
+}
+
+// Step adapts fn into a chain step (for AndThen or chain.Chain) that runs inside its own span,
+// as a child of the span in ctx.
+//
+// Example:
+//
+//	receipt := result.AndThen(order, otelx.Step(ctx, "payment.charge", s.charge))
+func Step[T, U any](ctx context.Context, name string, fn func(context.Context, T) result.Result[U], opts ...Option) func(T) result.Result[U] {
+	return func(in T) result.Result[U] {
+		return Span(ctx, name, func(ctx context.Context) result.Result[U] {
+			return fn(ctx, in)
+		}, opts...)
+	}
+}
+
+// RetryAttempts returns a retry.Option that adds a "retry.attempt" event to the span in ctx after every
+// attempt, with the attempt number, the delay before the next one and the error type and code.
+func RetryAttempts(ctx context.Context) retry.Option {
+	span := trace.SpanFromContext(ctx)
+	return retry.OnAttempt(func(a retry.Attempt) {
+		attrs := []attribute.KeyValue{AttrAttempt.Int(a.Number), AttrDelay.Int64(a.Delay.Milliseconds())}
+		if a.Err != nil {
+			attrs = append(attrs, errorAttributes(a.Err)...)
+		}
+		span.AddEvent("retry.attempt", trace.WithAttributes(attrs...))
+	})
+}
+
+// RecordError records err on span as an exception event carrying its type, registered code and
+// structured fields, and sets the span status to Error. Nil errors are ignored.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	attrs := errorAttributes(err)
+	for k, v := range goxerrors.FieldsOf(err) {
+		attrs = append(attrs, attribute.String("goxide.error.field."+k, fmt.Sprint(v)))
+	}
+	span.RecordError(err, trace.WithAttributes(attrs...))
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// InstrumentErrors counts every Err Result (and every goxerrors.Notify call) in a "goxide.errors" counter
+// on meter, labelled with the error type and registered code. Call it once at start-up.
+func InstrumentErrors(meter metric.Meter) result.Result[types.Unit] {
+	counter, err := meter.Int64Counter("goxide.errors",
+		metric.WithDescription("Errors created through goxide Results, by type and registered code."))
+	if err != nil {
+		return result.Err[types.Unit](err)
+	}
+	goxerrors.OnError(func(code string, err error) {
+		counter.Add(context.Background(), 1, metric.WithAttributes(
+			AttrErrorType.String(fmt.Sprintf("%T", err)),
+			AttrErrorCode.String(code),
+		))
+	})
+	return result.Ok(types.Unit{})
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// errorAttributes describes err by its concrete type and registered code.
+func errorAttributes(err error) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		AttrErrorType.String(fmt.Sprintf("%T", err)),
+		AttrErrorCode.String(goxerrors.CodeOf(err)),
+	}
+}
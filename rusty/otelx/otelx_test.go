@@ -0,0 +1,161 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package otelx_test. otelx_test verifies spans, retry events and the error counter against in-memory SDKs.
+package otelx_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/otelx"
+	"github.com/seyedali-dev/goxide/rusty/resilience/retry"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+var ErrCardDeclined = errors.New("card declined")
+
+func init() {
+	goxerrors.Register(ErrCardDeclined, goxerrors.Meta{Code: "card_declined", HTTPStatus: 402, Retryable: true})
+}
+
+func newTracer() (*tracetest.SpanRecorder, otelx.Option) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	return recorder, otelx.WithTracer(provider.Tracer("test"))
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestSpan_RecordsErrAndStep(t *testing.T) {
+	recorder, withTracer := newTracer()
+	res := otelx.Span(context.Background(), "checkout", func(ctx context.Context) result.Result[int] {
+		return result.AndThen(result.Ok(10), otelx.Step(ctx, "charge", func(context.Context, int) result.Result[int] {
+			return result.Err[int](ErrCardDeclined)
+		}, withTracer))
+	}, withTracer)
+
+	if !errors.Is(res.Err(), ErrCardDeclined) {
+		t.Fatalf("expected %v, got %v", ErrCardDeclined, res.Err())
+	}
+	spans := recorder.Ended()
+	if len(spans) != 2 || spans[0].Name() != "charge" || spans[1].Name() != "checkout" {
+		t.Fatalf("expected charge and checkout spans, got %v", spans)
+	}
+	if spans[0].Parent().SpanID() != spans[1].SpanContext().SpanID() {
+		t.Fatal("expected the step span to be a child of the operation span")
+	}
+	if spans[0].Status().Code != codes.Error || len(spans[0].Events()) != 1 {
+		t.Fatalf("expected error status and exception event, got %v %v", spans[0].Status(), spans[0].Events())
+	}
+}
+
+func TestSpan_BubbleUp(t *testing.T) {
+	recorder, withTracer := newTracer()
+	res := func() (res result.Result[int]) {
+		defer result.Catch(&res)
+		// fn runs synchronously, so the Catch above recovers its BubbleUp
+		//goxide:bubbles
+		return otelx.Span(context.Background(), "op", func(context.Context) result.Result[int] {
+			return result.Ok(result.Err[int](ErrCardDeclined).BubbleUp())
+		}, withTracer)
+	}()
+
+	if !errors.Is(res.Err(), ErrCardDeclined) {
+		t.Fatalf("expected %v, got %v", ErrCardDeclined, res.Err())
+	}
+	spans := recorder.Ended()
+	if len(spans) != 1 || spans[0].Status().Code != codes.Error {
+		t.Fatalf("expected one failed span, got %v", spans)
+	}
+	events := spans[0].Events()
+	if len(events) != 1 {
+		t.Fatalf("expected one exception event, got %v", events)
+	}
+	attrs := attribute.NewSet(events[0].Attributes...)
+	for key, want := range map[attribute.Key]string{
+		otelx.AttrErrorType: "*errors.errorString",
+		otelx.AttrErrorCode: "card_declined",
+		"exception.type":    "*errors.errorString",
+	} {
+		if got, _ := attrs.Value(key); got.AsString() != want {
+			t.Fatalf("%s: expected %v, got %v", key, want, got.AsString())
+		}
+	}
+}
+
+func TestSpan_OtherPanic(t *testing.T) {
+	recorder, withTracer := newTracer()
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Fatalf("expected %v, got %v", "boom", r)
+			}
+		}()
+		_ = otelx.Span(context.Background(), "op", func(context.Context) result.Result[int] {
+			panic("boom")
+		}, withTracer)
+	}()
+
+	if spans := recorder.Ended(); len(spans) != 1 || spans[0].Status().Description != "boom" {
+		t.Fatalf("expected one span failed with boom, got %v", spans)
+	}
+}
+
+func TestRetryAttempts(t *testing.T) {
+	recorder, withTracer := newTracer()
+	res := otelx.Span(context.Background(), "charge", func(ctx context.Context) result.Result[int] {
+		return retry.Do(ctx, func(context.Context) result.Result[int] {
+			return result.Err[int](ErrCardDeclined)
+		}, retry.WithPolicy(retry.Constant(time.Millisecond, 3)), otelx.RetryAttempts(ctx))
+	}, withTracer)
+	if !errors.Is(res.Err(), ErrCardDeclined) {
+		t.Fatalf("expected %v, got %v", ErrCardDeclined, res.Err())
+	}
+
+	attempts := 0
+	for _, event := range recorder.Ended()[0].Events() {
+		if event.Name == "retry.attempt" {
+			attempts++
+		}
+	}
+	if attempts != 3 {
+		t.Fatalf("expected %v, got %v", 3, attempts)
+	}
+}
+
+func TestInstrumentErrors(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+	otelx.InstrumentErrors(meter).Unwrap()
+
+	_ = result.Err[int](ErrCardDeclined)
+	_ = result.Err[int](ErrCardDeclined)
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	var total int64
+	for _, dp := range sum.DataPoints {
+		if code, _ := dp.Attributes.Value(otelx.AttrErrorCode); code.AsString() == "card_declined" {
+			total += dp.Value
+		}
+	}
+	if total < 2 {
+		t.Fatalf("expected at least %v, got %v", 2, total)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package parse. parse wraps strconv and time parsing so that results slot directly into Result chains,
+// without result.Wrap noise or bit-size bookkeeping for sized integer types.
+//
+// Example - Traditional vs parse:
+//
+//	// Traditional Go
+//	n, err := strconv.ParseInt(r.URL.Query().Get("limit"), 10, 32)
+//	if err != nil {
+//	    return result.Err[Page](err)
+//	}
+//	limit := int32(n)
+//
+//	// With parse
+//	limit := parse.Int[int32](r.URL.Query().Get("limit")).BubbleUp()
+package parse
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Integer is the set of types accepted by Int.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Floating is the set of types accepted by Float.
+type Floating interface {
+	~float32 | ~float64
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Int parses a base-10 integer into T, failing if s does not fit in T.
+// The error is a *strconv.NumError, so errors.Is(err, strconv.ErrRange) and strconv.ErrSyntax work.
+//
+// Example:
+//
+//	port := parse.Int[uint16](os.Getenv("PORT")).UnwrapOr(8080)
+func Int[T Integer](s string) result.Result[T] {
+	bits := reflect.TypeFor[T]().Bits()
+	if signed := ^T(0) < 0; signed {
+		n, err := strconv.ParseInt(s, 10, bits)
+		return result.Wrap(T(n), err)
+	}
+	n, err := strconv.ParseUint(s, 10, bits)
+	return result.Wrap(T(n), err)
+}
+
+// Float parses a floating-point number into T.
+func Float[T Floating](s string) result.Result[T] {
+	f, err := strconv.ParseFloat(s, reflect.TypeFor[T]().Bits())
+	return result.Wrap(T(f), err)
+}
+
+// Bool parses a boolean as strconv.ParseBool does ("1", "t", "true", "0", "f", "false", ...).
+func Bool(s string) result.Result[bool] {
+	return result.Wrap(strconv.ParseBool(s))
+}
+
+// Duration parses a duration such as "300ms" or "1h30m".
+func Duration(s string) result.Result[time.Duration] {
+	return result.Wrap(time.ParseDuration(s))
+}
+
+// Time parses s using layout, as time.Parse does.
+//
+// Example:
+//
+//	since := parse.Time(time.DateOnly, r.URL.Query().Get("since")).BubbleUp()
+func Time(layout, s string) result.Result[time.Time] {
+	return result.Wrap(time.Parse(layout, s))
+}
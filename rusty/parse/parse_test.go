@@ -0,0 +1,46 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package parse_test. parse_test verifies sized integer parsing and the strconv/time wrappers.
+package parse_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/parse"
+)
+
+type Port uint16
+
+func TestInt_SizedTypes(t *testing.T) {
+	if got := parse.Int[int8]("-128").Unwrap(); got != -128 {
+		t.Fatalf("expected %v, got %v", -128, got)
+	}
+	if got := parse.Int[Port]("8080").Unwrap(); got != 8080 {
+		t.Fatalf("expected %v, got %v", 8080, got)
+	}
+	if res := parse.Int[uint8]("256"); !errors.Is(res.Err(), strconv.ErrRange) {
+		t.Fatalf("expected %v, got %v", strconv.ErrRange, res.Err())
+	}
+	if res := parse.Int[uint]("-1"); !errors.Is(res.Err(), strconv.ErrSyntax) {
+		t.Fatalf("expected %v, got %v", strconv.ErrSyntax, res.Err())
+	}
+}
+
+func TestWrappers(t *testing.T) {
+	if got := parse.Float[float32]("1.5").Unwrap(); got != 1.5 {
+		t.Fatalf("expected %v, got %v", 1.5, got)
+	}
+	if !parse.Bool("true").Unwrap() || parse.Bool("yes").IsOk() {
+		t.Fatal("unexpected Bool results")
+	}
+	if got := parse.Duration("1m30s").Unwrap(); got != 90*time.Second {
+		t.Fatalf("expected %v, got %v", 90*time.Second, got)
+	}
+	if got := parse.Time(time.DateOnly, "2025-03-01").Unwrap(); got.Month() != time.March {
+		t.Fatalf("expected %v, got %v", time.March, got.Month())
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package pipeline. pipeline provides a DAG executor whose nodes are Result-returning functions with declared
+// dependencies: independent nodes run concurrently, a failed node skips everything that depends on it, and
+// each node's typed Result is read back from the Report through the handle returned when it was added.
+//
+// Example - Assembling a page from independent lookups:
+//
+//	p := pipeline.New()
+//	user := pipeline.Add(p, "user", func(ctx context.Context, _ *pipeline.Report) result.Result[User] {
+//	    return repo.FindUser(ctx, id)
+//	})
+//	orders := pipeline.Add(p, "orders", func(ctx context.Context, _ *pipeline.Report) result.Result[[]Order] {
+//	    return repo.FindOrders(ctx, id) // runs concurrently with "user"
+//	})
+//	page := pipeline.Add(p, "page", func(_ context.Context, r *pipeline.Report) result.Result[Page] {
+//	    return result.Ok(render(user.BubbleUp(r), orders.BubbleUp(r)))
+//	}, user, orders)
+//
+//	report := p.Run(ctx)
+//	return page.Result(report) // Err(ErrSkipped ...) if either lookup failed
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Pipeline is a set of nodes forming a directed acyclic graph. Since a node can only depend on nodes added
+// before it, every Pipeline is acyclic by construction. A Pipeline may be Run any number of times, but not
+// concurrently with Add.
+type Pipeline struct {
+	cfg   config
+	nodes []*node
+	names map[string]bool
+}
+
+// Node [T] is the handle of a node producing a T, used to declare it as a dependency and to read its Result.
+type Node[T any] struct {
+	n *node
+}
+
+// Dep is a node that another node depends on. It is implemented by Node[T] for every T.
+type Dep interface {
+	node() *node
+}
+
+// Report holds the outcome of every node of one Run. Read typed Results with Node.Result.
+type Report struct {
+	nodes   []*node
+	results []any   // result.Result[T] of each node
+	errs    []error // nil for Ok nodes
+	skipped []bool  // whether the node was skipped because a dependency failed
+	done    []chan struct{}
+}
+
+// Option configures a Pipeline.
+type Option func(*config)
+
+// config holds the settings applied by Option.
+type config struct {
+	concurrency int
+}
+
+// node is the type-erased form of a Node.
+type node struct {
+	id    int
+	name  string
+	owner *Pipeline
+	deps  []*node
+	run   func(context.Context, *Report) (any, error)
+	fail  func(error) any
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrSkipped is wrapped by the Err of a node that did not run because one of its dependencies failed.
+// The Err also wraps the error that originally failed.
+var ErrSkipped = errors.New("pipeline: skipped")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithConcurrency limits how many node functions run at once (default: unlimited).
+func WithConcurrency(n int) Option {
+	return func(c *config) {
+		c.concurrency = n
+	}
+}
+
+// New creates an empty Pipeline.
+func New(opts ...Option) *Pipeline {
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Pipeline{cfg: cfg, names: make(map[string]bool)}
+}
+
+// Add adds a node named name that runs fn once every node in deps has finished Ok. fn receives the Report
+// of the current Run, from which it reads its dependencies' values with Node.BubbleUp. A BubbleUp in fn
+// becomes the node's Err and any other panic an Err wrapping result.ErrPanic.
+// Add panics if name is already used or a dependency belongs to another Pipeline.
+//
+// Example:
+//
+//	total := pipeline.Add(p, "total", func(_ context.Context, r *pipeline.Report) result.Result[Money] {
+//	    return pricing.Total(cart.BubbleUp(r), discounts.BubbleUp(r))
+//	}, cart, discounts)
+func Add[T any](p *Pipeline, name string, fn func(context.Context, *Report) result.Result[T], deps ...Dep) Node[T] {
+	if p.names[name] {
+		panic(fmt.Sprintf("pipeline: duplicate node %q", name))
+	}
+	n := &node{id: len(p.nodes), name: name, owner: p}
+	for _, dep := range deps {
+		d := dep.node()
+		if d.owner != p {
+			panic(fmt.Sprintf("pipeline: node %q depends on %q from another pipeline", name, d.name))
+		}
+		n.deps = append(n.deps, d)
+	}
+	n.run = func(ctx context.Context, r *Report) (any, error) {
+		res := <-result.SafeGo(func() result.Result[T] { return fn(ctx, r) })
+		return res, res.Err()
+	}
+	n.fail = func(err error) any { return result.Err[T](err) }
+
+	p.nodes = append(p.nodes, n)
+	p.names[name] = true
+	return Node[T]{n: n}
+}
+
+// Run executes every node, each as soon as its dependencies have finished, and returns the Report once all
+// nodes are done. Nodes that have not started when ctx is done get Err(ctx.Err()).
+func (p *Pipeline) Run(ctx context.Context) *Report {
+	r := &Report{
+		nodes:   p.nodes,
+		results: make([]any, len(p.nodes)),
+		errs:    make([]error, len(p.nodes)),
+		skipped: make([]bool, len(p.nodes)),
+		done:    make([]chan struct{}, len(p.nodes)),
+	}
+	for i := range r.done {
+		r.done[i] = make(chan struct{})
+	}
+
+	var sem chan struct{}
+	if p.cfg.concurrency > 0 {
+		sem = make(chan struct{}, p.cfg.concurrency)
+	}
+	var wg sync.WaitGroup
+	for _, n := range p.nodes {
+		wg.Go(func() {
+			defer close(r.done[n.id])
+			r.exec(ctx, n, sem)
+		})
+	}
+	wg.Wait()
+	return r
+}
+
+// -------------------------------------------- Node Methods --------------------------------------------
+
+// Name returns the name the node was added with.
+func (n Node[T]) Name() string {
+	return n.n.name
+}
+
+// Result returns the node's Result in r. Inside a node function it may only be called for declared
+// dependencies, which are guaranteed to have finished.
+func (n Node[T]) Result(r *Report) result.Result[T] {
+	return r.results[n.n.id].(result.Result[T])
+}
+
+// BubbleUp returns the node's Ok value in r, or propagates its error to the enclosing result.Catch like
+// Result.BubbleUp. Inside a node function, declared dependencies are always Ok, so BubbleUp is the usual way
+// to read them.
+func (n Node[T]) BubbleUp(r *Report) T {
+	return n.Result(r).BubbleUp()
+}
+
+// node implements Dep.
+func (n Node[T]) node() *node {
+	return n.n
+}
+
+// -------------------------------------------- Report Methods --------------------------------------------
+
+// Err returns the errors of the nodes that failed, joined in the order the nodes were added, or nil if
+// every node is Ok. Skipped nodes are left out, since their errors repeat a failure already included.
+func (r *Report) Err() error {
+	var errs []error
+	for i, err := range r.errs {
+		if err != nil && !r.skipped[i] {
+			errs = append(errs, fmt.Errorf("%s: %w", r.nodes[i].name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Errs returns an iterator over the names and errors of the nodes that failed or were skipped, in the
+// order the nodes were added.
+//
+// Example:
+//
+//	for name, err := range report.Errs() {
+//	    log.Printf("node %s: %v", name, err)
+//	}
+func (r *Report) Errs() iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for i, err := range r.errs {
+			if err != nil && !yield(r.nodes[i].name, err) {
+				return
+			}
+		}
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// exec waits for n's dependencies and records n's outcome: skipped, cancelled or the Result of its function.
+func (r *Report) exec(ctx context.Context, n *node, sem chan struct{}) {
+	for _, d := range n.deps {
+		<-r.done[d.id]
+	}
+	for _, d := range n.deps {
+		if err := r.errs[d.id]; err != nil {
+			r.record(n, fmt.Errorf("%w: dependency %q failed: %w", ErrSkipped, d.name, r.cause(d)))
+			r.skipped[n.id] = true
+			return
+		}
+	}
+
+	if sem != nil {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+		case <-ctx.Done():
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		r.record(n, err)
+		return
+	}
+	r.results[n.id], r.errs[n.id] = n.run(ctx, r)
+}
+
+// record fails n with err without running it.
+func (r *Report) record(n *node, err error) {
+	r.results[n.id], r.errs[n.id] = n.fail(err), err
+}
+
+// cause returns the error that originally failed d, looking through the dependencies it was skipped for.
+func (r *Report) cause(d *node) error {
+	for r.skipped[d.id] {
+		for _, dd := range d.deps {
+			if r.errs[dd.id] != nil {
+				d = dd
+				break
+			}
+		}
+	}
+	return r.errs[d.id]
+}
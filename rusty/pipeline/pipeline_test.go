@@ -0,0 +1,128 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package pipeline_test. pipeline_test verifies dependency ordering, concurrency, skipping and cancellation.
+package pipeline_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/pipeline"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+var ErrLookup = errors.New("lookup failed")
+
+func TestPipeline_RunsDependenciesFirst(t *testing.T) {
+	p := pipeline.New()
+	var (
+		mu            sync.Mutex
+		running, peak int
+	)
+	slow := func(v int) func(context.Context, *pipeline.Report) result.Result[int] {
+		return func(context.Context, *pipeline.Report) result.Result[int] {
+			mu.Lock()
+			running++
+			peak = max(peak, running)
+			mu.Unlock()
+			time.Sleep(20 * time.Millisecond)
+			mu.Lock()
+			running--
+			mu.Unlock()
+			return result.Ok(v)
+		}
+	}
+	a := pipeline.Add(p, "a", slow(2))
+	b := pipeline.Add(p, "b", slow(3))
+	sum := pipeline.Add(p, "sum", func(_ context.Context, r *pipeline.Report) result.Result[string] {
+		return result.Ok(strconv.Itoa(a.BubbleUp(r) + b.BubbleUp(r)))
+	}, a, b)
+
+	report := p.Run(context.Background())
+	if err := report.Err(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := sum.Result(report).Unwrap(); got != "5" {
+		t.Fatalf("expected %v, got %v", "5", got)
+	}
+	if peak != 2 {
+		t.Fatalf("expected independent nodes to run concurrently, got peak %v", peak)
+	}
+}
+
+func TestPipeline_SkipsDependentsOnErr(t *testing.T) {
+	p := pipeline.New()
+	var ran atomic.Bool
+	user := pipeline.Add(p, "user", func(context.Context, *pipeline.Report) result.Result[string] {
+		return result.Err[string](ErrLookup)
+	})
+	greeting := pipeline.Add(p, "greeting", func(_ context.Context, r *pipeline.Report) result.Result[string] {
+		ran.Store(true)
+		return result.Ok("hi " + user.BubbleUp(r))
+	}, user)
+	page := pipeline.Add(p, "page", func(_ context.Context, r *pipeline.Report) result.Result[string] {
+		ran.Store(true)
+		return result.Ok(greeting.BubbleUp(r))
+	}, greeting)
+	other := pipeline.Add(p, "other", func(context.Context, *pipeline.Report) result.Result[int] {
+		return result.Ok(1)
+	})
+
+	report := p.Run(context.Background())
+	if ran.Load() {
+		t.Fatal("expected dependents of a failed node not to run")
+	}
+	err := page.Result(report).Err()
+	if !errors.Is(err, pipeline.ErrSkipped) || !errors.Is(err, ErrLookup) {
+		t.Fatalf("expected a skip caused by %v, got %v", ErrLookup, err)
+	}
+	if other.Result(report).Unwrap() != 1 {
+		t.Fatal("expected an independent node to run")
+	}
+	if got := report.Err(); !errors.Is(got, ErrLookup) || errors.Is(got, pipeline.ErrSkipped) {
+		t.Fatalf("expected only the root failure in the report error, got %v", got)
+	}
+
+	var failed []string
+	for name := range report.Errs() {
+		failed = append(failed, name)
+	}
+	if len(failed) != 3 || failed[0] != "user" || failed[2] != "page" {
+		t.Fatalf("unexpected failed nodes: %v", failed)
+	}
+}
+
+func TestPipeline_PanicsAndCancellation(t *testing.T) {
+	p := pipeline.New(pipeline.WithConcurrency(1))
+	boom := pipeline.Add(p, "boom", func(context.Context, *pipeline.Report) result.Result[int] {
+		panic("boom")
+	})
+	report := p.Run(context.Background())
+	if err := boom.Result(report).Err(); !errors.Is(err, result.ErrPanic) {
+		t.Fatalf("expected %v, got %v", result.ErrPanic, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := p.Run(ctx).Err(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestPipeline_AddPanicsOnDuplicateName(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic")
+		}
+	}()
+	p := pipeline.New()
+	noop := func(context.Context, *pipeline.Report) result.Result[int] { return result.Ok(0) }
+	pipeline.Add(p, "x", noop)
+	pipeline.Add(p, "x", noop)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package retry. policy provides the backoff policies that decide whether, and how long after,
+// a failed attempt is retried.
+package retry
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Policy decides the delay before the next attempt.
+// attempt is the number of the attempt that just failed (starting at 1) and elapsed is the time since
+// the first attempt started. Returning None stops retrying.
+type Policy interface {
+	Next(attempt int, elapsed time.Duration) option.Option[time.Duration]
+}
+
+// PolicyFunc adapts a function to the Policy interface.
+type PolicyFunc func(attempt int, elapsed time.Duration) option.Option[time.Duration]
+
+// Next calls fn.
+func (fn PolicyFunc) Next(attempt int, elapsed time.Duration) option.Option[time.Duration] {
+	return fn(attempt, elapsed)
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Constant retries up to maxAttempts attempts in total, waiting delay between attempts.
+//
+// Example:
+//
+//	retry.Constant(200*time.Millisecond, 5) // 5 attempts, 200ms apart
+func Constant(delay time.Duration, maxAttempts int) Policy {
+	return PolicyFunc(func(attempt int, _ time.Duration) option.Option[time.Duration] {
+		if attempt >= maxAttempts {
+			return option.None[time.Duration]()
+		}
+		return option.Some(delay)
+	})
+}
+
+// Exponential retries up to maxAttempts attempts in total, doubling the delay after each attempt
+// starting from initial and capping it at maxDelay.
+//
+// Example:
+//
+//	retry.Exponential(100*time.Millisecond, 2*time.Second, 6) // 100ms, 200ms, 400ms, 800ms, 1.6s
+func Exponential(initial, maxDelay time.Duration, maxAttempts int) Policy {
+	return PolicyFunc(func(attempt int, _ time.Duration) option.Option[time.Duration] {
+		if attempt >= maxAttempts {
+			return option.None[time.Duration]()
+		}
+		delay := initial
+		for i := 1; i < attempt && delay < maxDelay; i++ {
+			delay *= 2
+		}
+		return option.Some(min(delay, maxDelay))
+	})
+}
+
+// WithJitter randomizes the delays of p by up to ±fraction (0 < fraction <= 1) to avoid
+// synchronized retries from many clients.
+//
+// Example:
+//
+//	retry.WithJitter(retry.Exponential(100*time.Millisecond, 5*time.Second, 5), 0.2)
+func WithJitter(p Policy, fraction float64) Policy {
+	return PolicyFunc(func(attempt int, elapsed time.Duration) option.Option[time.Duration] {
+		return option.Map(p.Next(attempt, elapsed), func(d time.Duration) time.Duration {
+			spread := float64(d) * fraction
+			return time.Duration(float64(d) - spread + rand.Float64()*2*spread)
+		})
+	})
+}
+
+// WithMaxElapsed stops p once the total time spent, including the next delay, would exceed limit.
+//
+// Example:
+//
+//	retry.WithMaxElapsed(retry.Constant(time.Second, 100), 10*time.Second) // give up after ~10s
+func WithMaxElapsed(p Policy, limit time.Duration) Policy {
+	return PolicyFunc(func(attempt int, elapsed time.Duration) option.Option[time.Duration] {
+		return option.FlatMap(p.Next(attempt, elapsed), func(d time.Duration) option.Option[time.Duration] {
+			if elapsed+d > limit {
+				return option.None[time.Duration]()
+			}
+			return option.Some(d)
+		})
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package retry. retry runs Result-returning operations under a backoff policy, retrying only errors
+// classified as retryable (by default via the errors registry) and reporting every attempt to hooks.
+//
+// Example - Traditional vs retry.Do:
+//
+//	// Traditional Go
+//	var data []byte
+//	var err error
+//	for i := 0; i < 3; i++ {
+//	    data, err = fetch(ctx, url)
+//	    if err == nil {
+//	        break
+//	    }
+//	    time.Sleep(time.Duration(1<<i) * 100 * time.Millisecond)
+//	}
+//
+//	// With retry.Do
+//	data := retry.Do(ctx, func(ctx context.Context) result.Result[[]byte] {
+//	    return fetchData(ctx, url)
+//	}, retry.WithPolicy(retry.Exponential(100*time.Millisecond, time.Second, 3)))
+package retry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/clock"
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Attempt describes a finished attempt, passed to OnAttempt hooks.
+type Attempt struct {
+	Number  int           // 1-based attempt number
+	Err     error         // nil if the attempt succeeded
+	Delay   time.Duration // delay before the next attempt; 0 if no further attempt will be made
+	Elapsed time.Duration // time since the first attempt started
+}
+
+// Option configures Do.
+type Option func(*config)
+
+// config holds the settings of a single Do call.
+type config struct {
+	policy  Policy
+	retryIf func(error) bool
+	hooks   []func(Attempt)
+	clock   clock.Clock
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// DefaultPolicy is used when no policy is given: 3 attempts with exponential backoff from 100ms,
+// capped at 2s, with 20% jitter.
+var DefaultPolicy = WithJitter(Exponential(100*time.Millisecond, 2*time.Second, 3), 0.2)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// WithPolicy sets the backoff policy (default: DefaultPolicy).
+func WithPolicy(p Policy) Option {
+	return func(c *config) {
+		c.policy = p
+	}
+}
+
+// RetryIf sets the predicate deciding whether an error is retried (default: errors.IsRetryable).
+//
+// Example:
+//
+//	retry.RetryIf(func(err error) bool {
+//	    return errors.Is(err, ErrTimeout) || goxerrors.IsRetryable(err)
+//	})
+func RetryIf(pred func(error) bool) Option {
+	return func(c *config) {
+		c.retryIf = pred
+	}
+}
+
+// OnAttempt registers a hook called after every attempt, e.g. for logging or metrics.
+//
+// Example:
+//
+//	retry.OnAttempt(func(a retry.Attempt) {
+//	    if a.Err != nil {
+//	        log.Printf("attempt %d failed: %v (retrying in %s)", a.Number, a.Err, a.Delay)
+//	    }
+//	})
+func OnAttempt(fn func(Attempt)) Option {
+	return func(c *config) {
+		c.hooks = append(c.hooks, fn)
+	}
+}
+
+// WithClock sets the clock measuring elapsed time and backoff delays (default: clock.System),
+// e.g. a clocktest.FakeClock to test backoff without sleeping.
+func WithClock(clk clock.Clock) Option {
+	return func(c *config) {
+		c.clock = clk
+	}
+}
+
+// Do calls fn until it returns Ok, returns a non-retryable Err, or the policy gives up.
+// Returns the last Result from fn. If ctx is done while waiting between attempts, returns an Err
+// matching both ctx.Err() and the last attempt's error.
+//
+// When to use:
+//   - Around network calls and other operations with transient failures
+//   - When retryability is already expressed through the errors registry
+//
+// Example:
+//
+//	func ChargeCard(ctx context.Context, req ChargeRequest) result.Result[Receipt] {
+//	    return retry.Do(ctx, func(ctx context.Context) result.Result[Receipt] {
+//	        return gateway.Charge(ctx, req)
+//	    })
+//	}
+func Do[T any](ctx context.Context, fn func(context.Context) result.Result[T], opts ...Option) result.Result[T] {
+	cfg := config{policy: DefaultPolicy, retryIf: goxerrors.IsRetryable, clock: clock.System}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	start := cfg.clock.Now()
+	for attempt := 1; ; attempt++ {
+		res := fn(ctx)
+		elapsed := cfg.clock.Since(start)
+
+		if res.IsOk() || !cfg.retryIf(res.Err()) {
+			cfg.notify(Attempt{Number: attempt, Err: res.Err(), Elapsed: elapsed})
+			return res
+		}
+
+		next := cfg.policy.Next(attempt, elapsed)
+		delay := next.UnwrapOr(0)
+		cfg.notify(Attempt{Number: attempt, Err: res.Err(), Delay: delay, Elapsed: elapsed})
+		if next.IsNone() {
+			return res
+		}
+
+		if err := sleep(ctx, cfg.clock, delay); err != nil {
+			return result.Err[T](fmt.Errorf("retry aborted: %w (last error: %w)", err, res.Err()))
+		}
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// notify calls every registered attempt hook.
+func (c *config) notify(a Attempt) {
+	for _, hook := range c.hooks {
+		hook(a)
+	}
+}
+
+// sleep waits for d on clk or until ctx is done, returning ctx.Err() in the latter case.
+func sleep(ctx context.Context, clk clock.Clock, d time.Duration) error {
+	timer := clk.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
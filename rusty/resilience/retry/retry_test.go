@@ -0,0 +1,110 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package retry_test. retry_test verifies policies, predicates and attempt hooks.
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/resilience/retry"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+var (
+	ErrTransient = errors.New("transient")
+	ErrFatal     = errors.New("fatal")
+)
+
+func init() {
+	goxerrors.Register(ErrTransient, goxerrors.Meta{Code: "transient", Retryable: true})
+}
+
+// failTimes returns a function that fails n times with err before succeeding, counting calls.
+func failTimes(n int, err error, calls *int) func(context.Context) result.Result[string] {
+	return func(context.Context) result.Result[string] {
+		*calls++
+		if *calls <= n {
+			return result.Err[string](err)
+		}
+		return result.Ok("done")
+	}
+}
+
+func TestDo_RetriesRetryableErrors(t *testing.T) {
+	var calls int
+	var attempts []retry.Attempt
+
+	res := retry.Do(context.Background(), failTimes(2, ErrTransient, &calls),
+		retry.WithPolicy(retry.Constant(time.Millisecond, 5)),
+		retry.OnAttempt(func(a retry.Attempt) { attempts = append(attempts, a) }),
+	)
+
+	if res.IsErr() || calls != 3 {
+		t.Fatalf("expected success on third call, got %v after %d calls", res.Err(), calls)
+	}
+	if len(attempts) != 3 || attempts[0].Err == nil || attempts[2].Err != nil {
+		t.Fatalf("unexpected attempt metadata: %+v", attempts)
+	}
+}
+
+func TestDo_StopsOnNonRetryable(t *testing.T) {
+	var calls int
+	res := retry.Do(context.Background(), failTimes(5, ErrFatal, &calls),
+		retry.WithPolicy(retry.Constant(time.Millisecond, 5)))
+
+	if !errors.Is(res.Err(), ErrFatal) || calls != 1 {
+		t.Fatalf("expected single failed call, got %v after %d calls", res.Err(), calls)
+	}
+}
+
+func TestDo_PolicyExhausted(t *testing.T) {
+	var calls int
+	res := retry.Do(context.Background(), failTimes(10, ErrTransient, &calls),
+		retry.WithPolicy(retry.Constant(time.Millisecond, 3)),
+		retry.RetryIf(func(error) bool { return true }))
+
+	if !errors.Is(res.Err(), ErrTransient) || calls != 3 {
+		t.Fatalf("expected 3 calls and last error, got %v after %d calls", res.Err(), calls)
+	}
+}
+
+func TestDo_ContextCancelledWhileWaiting(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	var calls int
+	res := retry.Do(ctx, failTimes(10, ErrTransient, &calls),
+		retry.WithPolicy(retry.Constant(time.Hour, 3)))
+
+	if !errors.Is(res.Err(), context.DeadlineExceeded) || !errors.Is(res.Err(), ErrTransient) {
+		t.Fatalf("expected error matching deadline and last error, got %v", res.Err())
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	exp := retry.Exponential(10*time.Millisecond, 50*time.Millisecond, 5)
+	want := []time.Duration{10, 20, 40, 50}
+	for i, w := range want {
+		if got := exp.Next(i+1, 0).Unwrap(); got != w*time.Millisecond {
+			t.Errorf("attempt %d: expected %v, got %v", i+1, w*time.Millisecond, got)
+		}
+	}
+	if exp.Next(5, 0).IsSome() {
+		t.Error("expected exponential policy to stop at max attempts")
+	}
+
+	capped := retry.WithMaxElapsed(retry.Constant(time.Second, 100), 3*time.Second)
+	if capped.Next(1, 2500*time.Millisecond).IsSome() {
+		t.Error("expected max-elapsed policy to stop")
+	}
+
+	jittered := retry.WithJitter(retry.Constant(100*time.Millisecond, 2), 0.5)
+	if d := jittered.Next(1, 0).Unwrap(); d < 50*time.Millisecond || d > 150*time.Millisecond {
+		t.Errorf("jittered delay out of range: %v", d)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package result. errdefer provides OnErrDefer, a deferred cleanup that runs only when the function ends
+// in Err, modeled on Zig's errdefer, so partially completed setup is undone exactly when it must be.
+//
+// Example - Traditional vs OnErrDefer:
+//
+//	// Traditional Go
+//	func CreateWorkspace(name string) (ws Workspace, err error) {
+//	    dir, err := os.MkdirTemp("", name)
+//	    if err != nil {
+//	        return Workspace{}, err
+//	    }
+//	    defer func() {
+//	        if err != nil {
+//	            os.RemoveAll(dir)
+//	        }
+//	    }()
+//	    ...
+//	}
+//
+//	// With OnErrDefer
+//	func CreateWorkspace(name string) (res Result[Workspace]) {
+//	    defer result.Catch(&res)
+//	    dir := result.Wrap(os.MkdirTemp("", name)).BubbleUp()
+//	    defer result.OnErrDefer(&res, func(error) { os.RemoveAll(dir) })
+//	    ...
+//	}
+package result
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// OnErrDefer calls fn with the error if *res is Err when the surrounding function returns, and does nothing
+// on Ok. It must be deferred. Like CatchWith, it recovers BubbleUp panics into *res first, so it sees
+// errors from BubbleUp wherever it is deferred relative to Catch; other panics propagate without running fn.
+// Deferred OnErrDefer calls run in reverse order, undoing setup steps last-to-first.
+//
+// When to use:
+//   - When a function creates temp files, reserves IDs or opens resources that must be released on failure
+//     but handed to the caller on success
+//
+// Example - Releasing a reserved ID on failure:
+//
+//	func Register(u User) (res Result[UserID]) {
+//	    defer result.Catch(&res)
+//	    id := ids.Reserve().BubbleUp()
+//	    defer result.OnErrDefer(&res, func(error) { ids.Release(id) })
+//	    repo.Insert(id, u).BubbleUp()
+//	    return result.Ok(id)
+//	}
+func OnErrDefer[T any](res *Result[T], fn func(error)) {
+	absorb(res, recover())
+	if res.IsErr() {
+		fn(res.Err())
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package result. hooks provides observation points for Results so metrics, tracing and logging
+// integrations can see every error without being threaded through business code.
+package result
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// errHooks holds the observers notified whenever an Err Result is created.
+// It is read on every Err call, so it is swapped atomically and never mutated in place.
+var errHooks atomic.Pointer[[]func(error)]
+
+// hooksMu serializes writers of errHooks.
+var hooksMu sync.Mutex
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// OnErr registers fn to be called with the error of every Err Result created via Err, Wrap and friends.
+// Hooks run synchronously on the goroutine that created the Result, so they must be fast and must not
+// create Err Results themselves.
+//
+// When to use:
+//   - When exporting error counters (e.g. Prometheus) from a single place
+//   - When wiring tracing or logging integrations
+//
+// Most applications should prefer higher-level integrations (such as errors.OnError) built on this hook.
+//
+// Example:
+//
+//	func init() {
+//	    result.OnErr(func(err error) {
+//	        errorCounter.Inc()
+//	    })
+//	}
+func OnErr(fn func(error)) {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+
+	var hooks []func(error)
+	if current := errHooks.Load(); current != nil {
+		hooks = append(hooks, *current...)
+	}
+	hooks = append(hooks, fn)
+	errHooks.Store(&hooks)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// notifyErr calls every registered hook with err. It is a no-op when no hooks are registered.
+func notifyErr(err error) {
+	hooks := errHooks.Load()
+	if hooks == nil {
+		return
+	}
+	if err == nil {
+		err = ErrEmptyResult
+	}
+	for _, hook := range *hooks {
+		hook(err)
+	}
+}
+
+// propagate builds an Err Result for an error that already surfaced in another Result,
+// so hooks observe each error once instead of at every Map/AndThen hop.
+func propagate[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package result. json provides encoding/json support so Results can be embedded in API responses and
+// decoded on the other side, encoded as {"ok": value} or {"error": "message"}.
+package result
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// jsonFieldNames are the object keys used for the Ok value and the error message.
+type jsonFieldNames struct {
+	ok, err string
+}
+
+// jsonFields holds the current field names; it is read on every encode and decode, so it is swapped atomically.
+var jsonFields atomic.Pointer[jsonFieldNames]
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrInvalidJSON is returned when decoding a Result from a JSON value that is not an object holding
+// exactly one of the Ok and error fields.
+var ErrInvalidJSON = errors.New("result: invalid JSON")
+
+const (
+	// DefaultJSONOkField is the key holding the value of an Ok Result.
+	DefaultJSONOkField = "ok"
+	// DefaultJSONErrField is the key holding the message of an Err Result.
+	DefaultJSONErrField = "error"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// SetJSONFields changes the keys used to encode and decode Results, process-wide.
+// Call it once during start-up, before any Result is marshaled; empty names restore the defaults.
+//
+// Example - Matching an existing API envelope:
+//
+//	func init() {
+//	    result.SetJSONFields("data", "message")
+//	}
+//	// Ok(user) -> {"data": {...}}, Err(err) -> {"message": "user not found"}
+func SetJSONFields(okField, errField string) {
+	if okField == "" {
+		okField = DefaultJSONOkField
+	}
+	if errField == "" {
+		errField = DefaultJSONErrField
+	}
+	jsonFields.Store(&jsonFieldNames{ok: okField, err: errField})
+}
+
+// MarshalJSON encodes an Ok Result as {"ok": value} and an Err Result as {"error": message}.
+// Only the error message is encoded; error types and wrapped chains do not cross the boundary.
+//
+// Example:
+//
+//	type Response struct {
+//	    User result.Result[User] `json:"user"`
+//	}
+//	json.Marshal(Response{User: repo.FindUser(id)})
+//	// {"user":{"ok":{"id":1,"name":"ali"}}} or {"user":{"error":"user not found"}}
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	fields := currentJSONFields()
+	if r.IsErr() {
+		return json.Marshal(map[string]string{fields.err: r.Err().Error()})
+	}
+	value, err := json.Marshal(r.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+	key, _ := json.Marshal(fields.ok)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	buf.Write(key)
+	buf.WriteByte(':')
+	buf.Write(value)
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes the form written by MarshalJSON. A decoded Err holds a plain error with the
+// original message, so match it by text rather than with errors.Is. Returns ErrInvalidJSON if data is
+// not an object with exactly one of the two fields; unknown fields are ignored. JSON null is a no-op that
+// leaves r unchanged, as encoding/json expects, so nullable Result fields decode.
+//
+// Decoding an Err does not notify OnErr hooks: the error happened on the other side of the boundary.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil || object == nil {
+		return fmt.Errorf("%w: expected an object", ErrInvalidJSON)
+	}
+
+	fields := currentJSONFields()
+	raw, isOk := object[fields.ok]
+	msg, isErr := object[fields.err]
+	switch {
+	case isOk && isErr:
+		return fmt.Errorf("%w: both %q and %q are present", ErrInvalidJSON, fields.ok, fields.err)
+	case isOk:
+		var value T
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		*r = Ok(value)
+		return nil
+	case isErr:
+		var text string
+		if err := json.Unmarshal(msg, &text); err != nil {
+			return fmt.Errorf("%w: %q must be a string", ErrInvalidJSON, fields.err)
+		}
+		*r = propagate[T](errors.New(text))
+		return nil
+	default:
+		return fmt.Errorf("%w: neither %q nor %q is present", ErrInvalidJSON, fields.ok, fields.err)
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// currentJSONFields returns the configured field names, or the defaults if SetJSONFields was never called.
+func currentJSONFields() *jsonFieldNames {
+	if fields := jsonFields.Load(); fields != nil {
+		return fields
+	}
+	return &jsonFieldNames{ok: DefaultJSONOkField, err: DefaultJSONErrField}
+}
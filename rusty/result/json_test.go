@@ -0,0 +1,80 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package result_test. json_test verifies Result's JSON round trip and configurable field names.
+package result_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+type userResponse struct {
+	User result.Result[map[string]int] `json:"user"`
+}
+
+func TestResult_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(userResponse{User: result.Ok(map[string]int{"id": 1})})
+	if err != nil || string(data) != `{"user":{"ok":{"id":1}}}` {
+		t.Fatalf("expected %v, got %s (%v)", `{"user":{"ok":{"id":1}}}`, data, err)
+	}
+
+	data, _ = json.Marshal(result.Err[int](ErrNotFound))
+	if string(data) != `{"error":"resource not found"}` {
+		t.Fatalf("expected %v, got %s", `{"error":"resource not found"}`, data)
+	}
+}
+
+func TestResult_UnmarshalJSON(t *testing.T) {
+	var resp userResponse
+	if err := json.Unmarshal([]byte(`{"user":{"ok":{"id":7}}}`), &resp); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	if got := resp.User.Unwrap()["id"]; got != 7 {
+		t.Fatalf("expected %v, got %v", 7, got)
+	}
+
+	var res result.Result[int]
+	if err := json.Unmarshal([]byte(`{"error":"boom"}`), &res); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	if res.IsOk() || res.Err().Error() != "boom" {
+		t.Fatalf("expected Err(boom), got %v", res)
+	}
+
+	for _, bad := range []string{`{}`, `{"ok":1,"error":"x"}`, `{"error":3}`, `[1]`} {
+		if err := json.Unmarshal([]byte(bad), &res); !errors.Is(err, result.ErrInvalidJSON) {
+			t.Fatalf("%s: expected %v, got %v", bad, result.ErrInvalidJSON, err)
+		}
+	}
+}
+
+func TestResult_UnmarshalJSONNull(t *testing.T) {
+	var resp userResponse
+	if err := json.Unmarshal([]byte(`{"user":null}`), &resp); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	res := result.Ok(1)
+	if err := json.Unmarshal([]byte(`null`), &res); err != nil || res.Unwrap() != 1 {
+		t.Fatalf("expected null to leave Ok(1) unchanged, got %v (%v)", res, err)
+	}
+}
+
+func TestSetJSONFields(t *testing.T) {
+	result.SetJSONFields("data", "message")
+	defer result.SetJSONFields("", "")
+
+	data, _ := json.Marshal(result.Ok("hi"))
+	if string(data) != `{"data":"hi"}` {
+		t.Fatalf("expected %v, got %s", `{"data":"hi"}`, data)
+	}
+
+	var res result.Result[string]
+	if err := json.Unmarshal([]byte(`{"message":"nope"}`), &res); err != nil || res.Err().Error() != "nope" {
+		t.Fatalf("expected Err(nope), got %v (%v)", res, err)
+	}
+}
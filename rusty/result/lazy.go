@@ -0,0 +1,72 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package result. lazy provides LazyResult[T], a fallible computation that runs only when its outcome
+// is first needed, and then at most once.
+//
+// Example - Skipping an expensive lookup on the fast path:
+//
+//	quota := result.Defer(func() Result[Quota] { return billing.FetchQuota(ctx, tenant) })
+//	if req.Size < freeTierLimit {
+//	    return accept(req) // FetchQuota never runs
+//	}
+//	if quota.Unwrap().Remaining < req.Size { ... }
+package result
+
+import "sync"
+
+// -------------------------------------------- Types --------------------------------------------
+
+// LazyResult [T] is a Result computed on first access. Copies share the same computation, and concurrent
+// first accesses block until it completes. A LazyResult must be created with Defer.
+type LazyResult[T any] struct {
+	get func() Result[T]
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Defer returns a LazyResult that runs fn on its first use and caches the outcome, Ok or Err.
+// If fn panics (including via BubbleUp), every access re-raises the panic in the caller.
+//
+// When to use:
+//   - When a fallible computation may never be needed depending on later branches
+//   - When several branches need the same expensive Result and should share one evaluation
+func Defer[T any](fn func() Result[T]) LazyResult[T] {
+	return LazyResult[T]{get: sync.OnceValue(fn)}
+}
+
+// Get runs the computation if it has not run yet and returns its Result.
+func (l LazyResult[T]) Get() Result[T] {
+	return l.get()
+}
+
+// IsOk runs the computation if needed and reports whether it succeeded.
+func (l LazyResult[T]) IsOk() bool {
+	return l.get().IsOk()
+}
+
+// IsErr runs the computation if needed and reports whether it failed.
+func (l LazyResult[T]) IsErr() bool {
+	return l.get().IsErr()
+}
+
+// Err runs the computation if needed and returns its error, or nil if it succeeded.
+func (l LazyResult[T]) Err() error {
+	return l.get().Err()
+}
+
+// Unwrap runs the computation if needed and returns its value, panicking if it failed.
+func (l LazyResult[T]) Unwrap() T {
+	return l.get().Unwrap()
+}
+
+// UnwrapOr runs the computation if needed and returns its value, or defaultValue if it failed.
+func (l LazyResult[T]) UnwrapOr(defaultValue T) T {
+	return l.get().UnwrapOr(defaultValue)
+}
+
+// BubbleUp runs the computation if needed and returns its value, or propagates its error to the
+// enclosing Catch like Result.BubbleUp.
+func (l LazyResult[T]) BubbleUp() T {
+	return l.get().BubbleUp()
+}
@@ -45,6 +45,7 @@ package result
 import (
 	"errors"
 	"fmt"
+	"iter"
 
 	"github.com/seyedali-dev/goxide/rusty/option"
 	"github.com/seyedali-dev/goxide/rusty/types"
@@ -129,11 +130,45 @@ func Ok[T any](value T) Result[T] {
 //	    return Ok(user)
 //	}
 func Err[T any](err error) Result[T] {
+	notifyErr(err)
 	return Result[T]{
 		err: err,
 	}
 }
 
+// OkIf returns Ok(value) when cond holds and Err(err) otherwise, turning a guard clause into a single
+// expression that fits inside a pipeline.
+//
+// When to use:
+//   - When validating a value inside AndThen without an if/else block
+//   - When a precondition decides between a value and a known sentinel error
+//
+// Example - Positive amount guard:
+//
+//	func ValidateAmount(amount int) Result[int] {
+//	    return result.OkIf(amount > 0, amount, ErrNotPositive)
+//	}
+//
+//	total := result.AndThen(ParseAmount(input), ValidateAmount)
+func OkIf[T any](cond bool, value T, err error) Result[T] {
+	if cond {
+		return Ok(value)
+	}
+	return Err[T](err)
+}
+
+// ErrIf is the mirror of OkIf: it returns Err(err) when cond holds and Ok(value) otherwise.
+// Use it when the condition describes the failure rather than the success.
+//
+// Example - Rejecting a blocked account:
+//
+//	func EnsureActive(user User) Result[User] {
+//	    return result.ErrIf(user.Blocked, user, ErrUserBlocked)
+//	}
+func ErrIf[T any](cond bool, value T, err error) Result[T] {
+	return OkIf(!cond, value, err)
+}
+
 // IsOk reports whether the Result contains a successful value.
 // Use this for explicit checking before accessing the value.
 //
@@ -225,6 +260,25 @@ func (r Result[T]) Err() error {
 	return nil
 }
 
+// ErrChain returns an iterator over the error of an Err Result and every error it wraps, outermost first.
+// Joined errors (Unwrap() []error) are walked depth-first in order, as errors.Is does. The sequence is empty
+// for Ok Results.
+//
+// When to use:
+//   - When logging every layer of a wrapped error
+//   - When classifying failures by the first layer of a given type
+//
+// Example - Logging each layer:
+//
+//	for layer := range res.ErrChain() {
+//	    log.Printf("  caused by %T: %v", layer, layer)
+//	}
+func (r Result[T]) ErrChain() iter.Seq[error] {
+	return func(yield func(error) bool) {
+		walkErr(r.Err(), yield)
+	}
+}
+
 // BubbleUp returns the value if Ok, or panics with a tryError if Err.
 // This enables Rust-like ? operator behavior when combined with Catch().
 // The panic will be recovered by Catch() and converted back to a Result.
@@ -290,14 +344,7 @@ func (r Result[T]) BubbleUp() T {
 //	    return repo.FindUser(id)
 //	}
 func Catch[T any](res *Result[T]) {
-	if r := recover(); r != nil {
-		err, ok := r.(tryError)
-		if !ok {
-			// Re-panic if not a tryError
-			panic(r)
-		}
-		*res = Err[T](err.error)
-	}
+	absorb(res, recover())
 }
 
 // CatchWith recovers from specific errors and applies a handler function.
@@ -346,29 +393,9 @@ func Catch[T any](res *Result[T]) {
 //	    return LoadConfigFile()
 //	}
 func CatchWith[T any](res *Result[T], handler func(error) T, when ...error) {
-	defer func() {
-		if res.IsOk() {
-			return
-		}
-
-		err := res.Err()
-		// No specific errors means handle all errors
-		if len(when) == 0 {
-			*res = Ok(handler(err))
-			return
-		}
-
-		// Check if error matches any of the specified errors
-		for _, target := range when {
-			if errors.Is(err, target) {
-				*res = Ok(handler(err))
-				return
-			}
-		}
-	}()
-	defer Catch(res)
-	if r := recover(); r != nil {
-		panic(r)
+	absorb(res, recover())
+	if res.IsErr() && matchesAny(res.Err(), when) {
+		*res = Ok(handler(res.Err()))
 	}
 }
 
@@ -407,9 +434,9 @@ func CatchWith[T any](res *Result[T], handler func(error) T, when ...error) {
 //	    return config.GetFlag(name)
 //	}
 func Fallback[T any](res *Result[T], fallback T, when ...error) {
-	defer CatchWith(res, func(_ error) T { return fallback }, when...)
-	if r := recover(); r != nil {
-		panic(r)
+	absorb(res, recover())
+	if res.IsErr() && matchesAny(res.Err(), when) {
+		*res = Ok(fallback)
 	}
 }
 
@@ -458,6 +485,28 @@ func CatchErr[T any](out *T, err *error) {
 	}
 }
 
+// CatchInto runs fn and returns its value as Ok, converting a BubbleUp inside fn into Err.
+// It replaces the named return value and deferred Catch for code that is not its own function,
+// such as a loop body or a goroutine. Panics that are not from BubbleUp propagate.
+//
+// When to use:
+//   - Inside loops where each iteration should short-circuit independently
+//   - Inside goroutines and inline closures
+//
+// Example - Per-item processing in a loop:
+//
+//	for _, id := range ids {
+//	    res := result.CatchInto(func() Receipt {
+//	        order := FindOrder(id).BubbleUp()
+//	        return Charge(order).BubbleUp()
+//	    })
+//	    report(id, res)
+//	}
+func CatchInto[T any](fn func() T) (res Result[T]) {
+	defer Catch(&res)
+	return Ok(fn())
+}
+
 // Expect returns the value if Ok, or panics with the provided message if Err.
 // Use ONLY in tests or when the error represents an unrecoverable programming error.
 //
@@ -789,7 +838,26 @@ func (r Result[T]) ExpectErr(panicMsg string) error {
 //	    })
 //	}
 func (r Result[T]) MapError(fn func(e error) error) Result[T] {
-	return If(r, Ok[T], types.Compose(fn, Err[T]))
+	return If(r, Ok[T], types.Compose(fn, propagate[T]))
+}
+
+// WrapErr adds context to the error of an Err Result, as fmt.Errorf(format+": %w", args..., err) would.
+// Ok Results pass through unchanged, so the arguments are formatted only on failure.
+//
+// When to use:
+//   - When adding call-site context to an error without a MapError closure
+//
+// Example - Annotating a repository error:
+//
+//	func LoadUser(id int) Result[User] {
+//	    return repo.FindUser(id).WrapErr("load user %d", id)
+//	}
+//	// Err: "load user 42: sql: no rows in result set"
+func (r Result[T]) WrapErr(format string, args ...any) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return propagate[T](fmt.Errorf(format+": %w", append(args[:len(args):len(args)], r.Err())...))
 }
 
 // Ok copies the value into out if successful, returning nil.
@@ -903,7 +971,7 @@ func If[Out, T any](r Result[T], okFn func(T) Out, errFn func(error) Out) Out {
 //	    })
 //	}
 func Map[T, U any](r Result[T], fn func(T) U) Result[U] {
-	return If(r, types.Compose(fn, Ok[U]), Err[U])
+	return If(r, types.Compose(fn, Ok[U]), propagate[U])
 }
 
 // FlatMap chains a Result-returning function, flattening nested Results.
@@ -936,7 +1004,7 @@ func Map[T, U any](r Result[T], fn func(T) U) Result[U] {
 //	    })
 //	}
 func FlatMap[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
-	return If(Map(r, fn), types.Id[Result[U]], Err[U])
+	return If(Map(r, fn), types.Id[Result[U]], propagate[U])
 }
 
 // AndThen chains a Result-returning function if Ok, short-circuiting on Err.
@@ -974,7 +1042,25 @@ func FlatMap[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
 //	        })
 //	}
 func AndThen[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
-	return If(r, fn, Err[U])
+	return If(r, fn, propagate[U])
+}
+
+// And returns b if a is Ok, otherwise a's error. Both Results are already evaluated; use AndThen when the
+// second operation should only run after the first succeeded.
+//
+// When to use:
+//   - When an earlier step must have succeeded for a later, already computed Result to count
+//   - Instead of an AndThen closure that ignores its argument
+//
+// Example - The audit write must succeed before the response is returned:
+//
+//	audited := audit.Record(ctx, event)
+//	return result.And(audited, BuildResponse(order))
+func And[T, U any](a Result[T], b Result[U]) Result[U] {
+	if a.IsErr() {
+		return propagate[U](a.Err())
+	}
+	return b
 }
 
 // Map2 combines two Results by applying fn if both are Ok, otherwise returns the first error.
@@ -1006,10 +1092,10 @@ func AndThen[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
 //	}
 func Map2[T, U, V any](r Result[T], s Result[U], fn func(T, U) V) Result[V] {
 	if r.IsErr() {
-		return Err[V](r.Err())
+		return propagate[V](r.Err())
 	}
 	if s.IsErr() {
-		return Err[V](s.Err())
+		return propagate[V](s.Err())
 	}
 	return Ok(fn(r.Value().Unwrap(), s.Value().Unwrap()))
 }
@@ -1051,13 +1137,249 @@ func Map2[T, U, V any](r Result[T], s Result[U], fn func(T, U) V) Result[V] {
 //	}
 func Map3[T, U, V, W any](r Result[T], s Result[U], t Result[V], fn func(T, U, V) W) Result[W] {
 	if r.IsErr() {
-		return Err[W](r.Err())
+		return propagate[W](r.Err())
 	}
 	if s.IsErr() {
-		return Err[W](s.Err())
+		return propagate[W](s.Err())
 	}
 	if t.IsErr() {
-		return Err[W](t.Err())
+		return propagate[W](t.Err())
 	}
 	return Ok(fn(r.Value().Unwrap(), s.Value().Unwrap(), t.Value().Unwrap()))
 }
+
+// Zip combines two Results into a Result of their Pair, or returns the first error.
+// Use it instead of Map2 when both values should simply be carried forward together.
+//
+// When to use:
+//   - When two independent lookups must both succeed before the next step
+//   - When a combining closure for Map2 would only build a tuple
+//
+// Example - Carrying a user and their settings forward:
+//
+//	res := result.Zip(repo.FindUser(id), repo.FindSettings(id))
+//	return result.AndThen(res, func(p types.Pair[User, Settings]) Result[Page] {
+//	    user, settings := p.Unpack()
+//	    return RenderDashboard(user, settings)
+//	})
+func Zip[A, B any](a Result[A], b Result[B]) Result[types.Pair[A, B]] {
+	return Map2(a, b, types.NewPair[A, B])
+}
+
+// All3 combines three independent Results into a Result of their Triple, or returns the first error
+// in argument order. All4 and All5 do the same for four and five Results. Every arity checks all of its
+// Results before building the tuple and propagates the error without notifying OnErr hooks a second time.
+//
+// When to use:
+//   - When several independent lookups must all succeed
+//   - When a combining closure for Map3 would only build a tuple
+//
+// Example - Loading a checkout page:
+//
+//	page := result.All3(repo.FindUser(id), repo.FindCart(id), repo.FindAddress(id))
+//	user, cart, address := page.BubbleUp().Unpack()
+func All3[A, B, C any](a Result[A], b Result[B], c Result[C]) Result[types.Triple[A, B, C]] {
+	if err := firstErr(a.Err(), b.Err(), c.Err()); err != nil {
+		return propagate[types.Triple[A, B, C]](err)
+	}
+	return Ok(types.Triple[A, B, C]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap()})
+}
+
+// All4 combines four independent Results into a Result of their Quad, or returns the first error.
+func All4[A, B, C, D any](a Result[A], b Result[B], c Result[C], d Result[D]) Result[types.Quad[A, B, C, D]] {
+	if err := firstErr(a.Err(), b.Err(), c.Err(), d.Err()); err != nil {
+		return propagate[types.Quad[A, B, C, D]](err)
+	}
+	return Ok(types.Quad[A, B, C, D]{
+		First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(),
+	})
+}
+
+// All5 combines five independent Results into a Result of their Quint, or returns the first error.
+func All5[A, B, C, D, E any](a Result[A], b Result[B], c Result[C], d Result[D], e Result[E]) Result[types.Quint[A, B, C, D, E]] {
+	if err := firstErr(a.Err(), b.Err(), c.Err(), d.Err(), e.Err()); err != nil {
+		return propagate[types.Quint[A, B, C, D, E]](err)
+	}
+	return Ok(types.Quint[A, B, C, D, E]{
+		First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap(),
+	})
+}
+
+// Collect converts a slice of Results into a Result of the Ok values in order, returning the first error.
+// An empty or nil slice yields Ok of an empty slice.
+//
+// When to use:
+//   - When a batch only counts if every element succeeded, e.g. mapping DB rows
+//   - Use CollectAll instead when the caller should see every failure, not just the first
+//
+// Example - Mapping rows:
+//
+//	users := make([]Result[User], len(rows))
+//	for i, row := range rows {
+//	    users[i] = ParseUser(row)
+//	}
+//	return result.Collect(users)
+func Collect[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(results))
+	for _, r := range results {
+		if r.IsErr() {
+			return propagate[[]T](r.Err())
+		}
+		values = append(values, r.Unwrap())
+	}
+	return Ok(values)
+}
+
+// CollectAll is like Collect but inspects every element, returning all errors joined with errors.Join
+// (in slice order) if any failed. errors.Is and errors.As match each of the joined errors.
+//
+// Example - Reporting every invalid line at once:
+//
+//	res := result.CollectAll(parsed)
+//	if res.IsErr() {
+//	    return fmt.Errorf("import failed:\n%w", res.Err())
+//	}
+func CollectAll[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(results))
+	var errs []error
+	for _, r := range results {
+		if r.IsErr() {
+			errs = append(errs, r.Err())
+			continue
+		}
+		values = append(values, r.Unwrap())
+	}
+	if len(errs) > 0 {
+		return propagate[[]T](errors.Join(errs...))
+	}
+	return Ok(values)
+}
+
+// Partition splits results into the Ok values and the errors, each in their original order.
+// Unlike Collect and CollectAll it never fails, so a batch can keep its successes and still report
+// every failure with its full error chain.
+//
+// When to use:
+//   - When a batch job should commit what succeeded and log or retry what did not
+//
+// Example - Importing what parses, reporting the rest:
+//
+//	records, failures := result.Partition(parsed)
+//	repo.InsertAll(records)
+//	for _, err := range failures {
+//	    log.Warn("skipped record", "err", err)
+//	}
+func Partition[T any](results []Result[T]) ([]T, []error) {
+	var values []T
+	var errs []error
+	for _, r := range results {
+		if r.IsErr() {
+			errs = append(errs, r.Err())
+			continue
+		}
+		values = append(values, r.Unwrap())
+	}
+	return values, errs
+}
+
+// Iterate returns a sequence that yields seed, then step(seed), and so on, until step returns Err, along
+// with a function reporting the error that ended the most recent pass over the sequence. That function
+// returns nil until the sequence has been ranged over, and also after the consumer stopped early. A step
+// that never fails produces an infinite sequence; stop ranging over it to end early.
+//
+// When to use:
+//   - When following pagination cursors until the API reports an error or the end
+//   - When repeating an operation on its own output until it fails
+//
+// Example - Walking pages by cursor:
+//
+//	pages, err := result.Iterate(firstPage, func(p Page) Result[Page] {
+//	    if p.Next == "" {
+//	        return result.Err[Page](ErrLastPage)
+//	    }
+//	    return api.FetchPage(p.Next)
+//	})
+//	for page := range pages {
+//	    process(page)
+//	}
+//	if !errors.Is(err(), ErrLastPage) {
+//	    return err()
+//	}
+func Iterate[T any](seed T, step func(T) Result[T]) (iter.Seq[T], func() error) {
+	var last error
+	seq := func(yield func(T) bool) {
+		last = nil
+		for current := seed; yield(current); {
+			next := step(current)
+			if next.IsErr() {
+				last = next.Err()
+				return
+			}
+			current = next.Unwrap()
+		}
+	}
+	return seq, func() error { return last }
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// absorb stores the error of a recovered BubbleUp panic r in res. A nil r leaves res untouched, and
+// any other panic is re-raised. recover must be called by the deferred function itself, so callers
+// pass its value in.
+func absorb[T any](res *Result[T], r any) {
+	if r == nil {
+		return
+	}
+	err, ok := r.(tryError)
+	if !ok {
+		// Re-panic if not a tryError
+		panic(r)
+	}
+	*res = propagate[T](err.error)
+}
+
+// matchesAny reports whether err matches any of targets by errors.Is. No targets matches every error.
+func matchesAny(err error, targets []error) bool {
+	if len(targets) == 0 {
+		return true
+	}
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// walkErr yields err and the errors it wraps in pre-order, reporting whether the walk should continue.
+func walkErr(err error, yield func(error) bool) bool {
+	for err != nil {
+		if !yield(err) {
+			return false
+		}
+		switch e := err.(type) {
+		case interface{ Unwrap() error }:
+			err = e.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				if !walkErr(inner, yield) {
+					return false
+				}
+			}
+			return true
+		default:
+			return true
+		}
+	}
+	return true
+}
+
+// firstErr returns the first non-nil error in errs.
+func firstErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -13,7 +13,7 @@ import (
 	"testing"
 
 	_ "github.com/lib/pq"
-	"github.com/seyedali-dev/goxide/internal/tests"
+	"github.com/seyedali-dev/goxide/goxidetest"
 	"github.com/seyedali-dev/goxide/rusty/chain"
 	"github.com/seyedali-dev/goxide/rusty/result"
 )
@@ -27,11 +27,12 @@ var (
 
 func TestMain(m *testing.M) {
 	ctx := context.Background()
-	tc, err := tests.SetupTestContainer(ctx)
-	if err != nil {
-		fmt.Printf("❌ Failed to setup test container: %v\n", err)
+	res := goxidetest.StartPostgres(ctx)
+	if res.IsErr() {
+		fmt.Printf("❌ Failed to setup test container: %v\n", res.Err())
 		os.Exit(1)
 	}
+	tc := res.Unwrap()
 	defer tc.Cleanup(ctx)
 
 	testDB = tc.DB
@@ -12,10 +12,12 @@
 package result_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/seyedali-dev/goxide/rusty/result"
 )
@@ -550,6 +552,354 @@ func TestEdgeCase_CatchWithReThrow(t *testing.T) {
 	}
 }
 
+// -------------------------------------------- Test Cases: With --------------------------------------------
+
+// trackedResource records whether it was closed and optionally fails on Close.
+type trackedResource struct {
+	closed   bool
+	closeErr error
+}
+
+func (r *trackedResource) Close() error {
+	r.closed = true
+	return r.closeErr
+}
+
+func TestWith_ClosesOnEveryPath(t *testing.T) {
+	ok := &trackedResource{}
+	res := result.With(result.Ok(ok), func(*trackedResource) result.Result[int] { return result.Ok(1) })
+	if res.Unwrap() != 1 || !ok.closed {
+		t.Fatal("expected Ok value and closed resource")
+	}
+
+	bubbled := &trackedResource{}
+	compute := func() (res result.Result[int]) {
+		defer result.Catch(&res)
+		// fn runs synchronously, so the Catch above recovers its BubbleUp
+		//goxide:bubbles
+		return result.With(result.Ok(bubbled), func(*trackedResource) result.Result[int] {
+			return result.Ok(result.Err[int](ErrDatabaseDown).BubbleUp())
+		})
+	}
+	if !errors.Is(compute().Err(), ErrDatabaseDown) || !bubbled.closed {
+		t.Fatal("expected BubbleUp error and closed resource")
+	}
+}
+
+func TestWith_CloseErrorAndFailedAcquire(t *testing.T) {
+	failing := &trackedResource{closeErr: ErrTimeout}
+	res := result.With(result.Ok(failing), func(*trackedResource) result.Result[int] {
+		return result.Err[int](ErrDatabaseDown)
+	})
+	if !errors.Is(res.Err(), ErrDatabaseDown) || !errors.Is(res.Err(), ErrTimeout) {
+		t.Fatalf("expected joined errors, got %v", res.Err())
+	}
+
+	called := false
+	res = result.With(result.Err[*trackedResource](ErrDatabaseDown), func(*trackedResource) result.Result[int] {
+		called = true
+		return result.Ok(1)
+	})
+	if called || !errors.Is(res.Err(), ErrDatabaseDown) {
+		t.Fatal("expected fn to be skipped on failed acquisition")
+	}
+}
+
+func TestOkIf(t *testing.T) {
+	positive := func(n int) result.Result[int] { return result.OkIf(n > 0, n, ErrInvalidInput) }
+	if got := positive(3); got.Unwrap() != 3 {
+		t.Fatalf("expected %v, got %v", 3, got.Unwrap())
+	}
+	if got := positive(-1); !errors.Is(got.Err(), ErrInvalidInput) {
+		t.Fatalf("expected %v, got %v", ErrInvalidInput, got.Err())
+	}
+
+	total := result.AndThen(result.Ok(5), positive)
+	if total.Unwrap() != 5 {
+		t.Fatalf("expected %v, got %v", 5, total.Unwrap())
+	}
+}
+
+func TestErrIf(t *testing.T) {
+	if got := result.ErrIf(true, "x", ErrNotFound); !errors.Is(got.Err(), ErrNotFound) {
+		t.Fatalf("expected %v, got %v", ErrNotFound, got.Err())
+	}
+	if got := result.ErrIf(false, "x", ErrNotFound); got.Unwrap() != "x" {
+		t.Fatalf("expected %v, got %v", "x", got.Unwrap())
+	}
+}
+
+func TestErrChain(t *testing.T) {
+	err := fmt.Errorf("load: %w", errors.Join(ErrCacheMiss, fmt.Errorf("db: %w", ErrDatabaseDown)))
+	var got []error
+	for layer := range result.Err[int](err).ErrChain() {
+		got = append(got, layer)
+	}
+	if len(got) != 5 || got[0] != err || got[2] != ErrCacheMiss || got[4] != ErrDatabaseDown {
+		t.Fatalf("expected outermost-first depth-first chain, got %v", got)
+	}
+
+	for layer := range result.Err[int](err).ErrChain() {
+		if layer == ErrCacheMiss {
+			break
+		}
+	}
+	for range result.Ok(1).ErrChain() {
+		t.Fatal("expected no errors for Ok")
+	}
+}
+
+func TestAnd(t *testing.T) {
+	if got := result.And(result.Ok(1), result.Ok("two")).Unwrap(); got != "two" {
+		t.Fatalf("expected %v, got %v", "two", got)
+	}
+
+	res := result.And(result.Err[int](ErrNotFound), result.Ok("two"))
+	if !errors.Is(res.Err(), ErrNotFound) {
+		t.Fatalf("expected %v, got %v", ErrNotFound, res.Err())
+	}
+
+	res = result.And(result.Ok(1), result.Err[string](ErrTimeout))
+	if !errors.Is(res.Err(), ErrTimeout) {
+		t.Fatalf("expected %v, got %v", ErrTimeout, res.Err())
+	}
+}
+
+func TestZip(t *testing.T) {
+	name, age := result.Zip(result.Ok("ali"), result.Ok(42)).Unwrap().Unpack()
+	if name != "ali" || age != 42 {
+		t.Fatalf("expected %v, got %v", "ali 42", fmt.Sprint(name, " ", age))
+	}
+
+	res := result.Zip(result.Err[string](ErrNotFound), result.Err[int](ErrTimeout))
+	if !errors.Is(res.Err(), ErrNotFound) {
+		t.Fatalf("expected %v, got %v", ErrNotFound, res.Err())
+	}
+}
+
+func TestAllN(t *testing.T) {
+	a, b, c := result.All3(result.Ok(1), result.Ok("two"), result.Ok(3.0)).Unwrap().Unpack()
+	if a != 1 || b != "two" || c != 3.0 {
+		t.Fatalf("expected %v, got %v", "1 two 3", fmt.Sprint(a, b, c))
+	}
+	triple := result.All3(result.Ok(1), result.Err[string](ErrCacheMiss), result.Err[float64](ErrTimeout))
+	if !errors.Is(triple.Err(), ErrCacheMiss) {
+		t.Fatalf("expected %v, got %v", ErrCacheMiss, triple.Err())
+	}
+
+	quad := result.All4(result.Ok(1), result.Ok(2), result.Err[int](ErrCacheMiss), result.Err[int](ErrTimeout))
+	if !errors.Is(quad.Err(), ErrCacheMiss) {
+		t.Fatalf("expected %v, got %v", ErrCacheMiss, quad.Err())
+	}
+
+	quint := result.All5(result.Ok(1), result.Ok(2), result.Ok(3), result.Ok(4), result.Ok(5)).Unwrap()
+	if quint.Fifth != 5 {
+		t.Fatalf("expected %v, got %v", 5, quint.Fifth)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	got := result.Collect([]result.Result[int]{result.Ok(1), result.Ok(2), result.Ok(3)}).Unwrap()
+	if fmt.Sprint(got) != "[1 2 3]" {
+		t.Fatalf("expected %v, got %v", "[1 2 3]", got)
+	}
+
+	if empty := result.Collect[int](nil).Unwrap(); empty == nil || len(empty) != 0 {
+		t.Fatalf("expected empty non-nil slice, got %#v", empty)
+	}
+
+	res := result.Collect([]result.Result[int]{result.Ok(1), result.Err[int](ErrNotFound), result.Err[int](ErrTimeout)})
+	if !errors.Is(res.Err(), ErrNotFound) || errors.Is(res.Err(), ErrTimeout) {
+		t.Fatalf("expected only %v, got %v", ErrNotFound, res.Err())
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	res := result.CollectAll([]result.Result[int]{result.Err[int](ErrNotFound), result.Ok(1), result.Err[int](ErrTimeout)})
+	if !errors.Is(res.Err(), ErrNotFound) || !errors.Is(res.Err(), ErrTimeout) {
+		t.Fatalf("expected both %v and %v, got %v", ErrNotFound, ErrTimeout, res.Err())
+	}
+
+	got := result.CollectAll([]result.Result[string]{result.Ok("a"), result.Ok("b")}).Unwrap()
+	if fmt.Sprint(got) != "[a b]" {
+		t.Fatalf("expected %v, got %v", "[a b]", got)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	values, errs := result.Partition([]result.Result[int]{
+		result.Ok(1), result.Err[int](ErrNotFound), result.Ok(2), result.Err[int](ErrTimeout),
+	})
+	if fmt.Sprint(values) != "[1 2]" {
+		t.Fatalf("expected %v, got %v", "[1 2]", values)
+	}
+	if len(errs) != 2 || !errors.Is(errs[0], ErrNotFound) || !errors.Is(errs[1], ErrTimeout) {
+		t.Fatalf("expected %v, got %v", []error{ErrNotFound, ErrTimeout}, errs)
+	}
+
+	if values, errs := result.Partition[int](nil); values != nil || errs != nil {
+		t.Fatalf("expected nil slices, got %v %v", values, errs)
+	}
+}
+
+func TestCatchInto(t *testing.T) {
+	var sums []result.Result[int]
+	for _, divisor := range []int{2, 0} {
+		sums = append(sums, result.CatchInto(func() int {
+			return result.Wrap(divide(10, divisor)).BubbleUp() + 1
+		}))
+	}
+	if sums[0].Unwrap() != 6 {
+		t.Fatalf("expected %v, got %v", 6, sums[0].Unwrap())
+	}
+	if sums[1].IsOk() {
+		t.Fatal("expected BubbleUp to become Err")
+	}
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Fatalf("expected %v, got %v", "boom", r)
+		}
+	}()
+	_ = result.CatchInto(func() int { panic("boom") })
+}
+
+func TestSafeGo(t *testing.T) {
+	ok := <-result.SafeGo(func() result.Result[int] { return result.Ok(1) })
+	if ok.Unwrap() != 1 {
+		t.Fatalf("expected %v, got %v", 1, ok.Unwrap())
+	}
+
+	bubbled := <-result.SafeGo(func() result.Result[int] {
+		return result.Ok(result.Err[int](ErrDatabaseDown).BubbleUp())
+	})
+	if !errors.Is(bubbled.Err(), ErrDatabaseDown) {
+		t.Fatalf("expected %v, got %v", ErrDatabaseDown, bubbled.Err())
+	}
+
+	ch := result.SafeGo(func() result.Result[int] { panic(ErrTimeout) })
+	panicked := <-ch
+	if !errors.Is(panicked.Err(), result.ErrPanic) || !errors.Is(panicked.Err(), ErrTimeout) {
+		t.Fatalf("expected ErrPanic wrapping %v, got %v", ErrTimeout, panicked.Err())
+	}
+	if _, open := <-ch; open {
+		t.Fatal("expected channel to be closed after the result")
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	fast := result.WithTimeout(time.Second, func(context.Context) result.Result[int] { return result.Ok(1) })
+	if fast.Unwrap() != 1 {
+		t.Fatalf("expected %v, got %v", 1, fast.Unwrap())
+	}
+
+	slow := result.WithTimeout(10*time.Millisecond, func(ctx context.Context) result.Result[int] {
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+		return result.Ok(2)
+	})
+	if !errors.Is(slow.Err(), result.ErrTimeout) || !errors.Is(slow.Err(), context.DeadlineExceeded) {
+		t.Fatalf("expected %v, got %v", result.ErrTimeout, slow.Err())
+	}
+
+	cooperative := result.WithTimeout(10*time.Millisecond, func(ctx context.Context) result.Result[int] {
+		<-ctx.Done()
+		return result.Err[int](ctx.Err())
+	})
+	if !errors.Is(cooperative.Err(), result.ErrTimeout) {
+		t.Fatalf("expected %v, got %v", result.ErrTimeout, cooperative.Err())
+	}
+
+	failed := result.WithTimeout(time.Second, func(context.Context) result.Result[int] {
+		return result.Err[int](ErrNotFound)
+	})
+	if !errors.Is(failed.Err(), ErrNotFound) || errors.Is(failed.Err(), result.ErrTimeout) {
+		t.Fatalf("expected %v, got %v", ErrNotFound, failed.Err())
+	}
+}
+
+func TestWrapErr(t *testing.T) {
+	res := result.Err[int](ErrNotFound).WrapErr("load user %d", 42)
+	if !errors.Is(res.Err(), ErrNotFound) || res.Err().Error() != "load user 42: resource not found" {
+		t.Fatalf("expected %v, got %v", "load user 42: resource not found", res.Err())
+	}
+	if got := result.Ok(1).WrapErr("unused %d", 1); got.Unwrap() != 1 {
+		t.Fatalf("expected %v, got %v", 1, got.Unwrap())
+	}
+}
+
+func TestDefer(t *testing.T) {
+	calls := 0
+	lazy := result.Defer(func() result.Result[int] {
+		calls++
+		return result.Ok(calls)
+	})
+	if calls != 0 {
+		t.Fatal("expected computation to be deferred")
+	}
+	copied := lazy
+	if !lazy.IsOk() || lazy.Unwrap() != 1 || copied.Unwrap() != 1 || calls != 1 {
+		t.Fatalf("expected a single evaluation, got %v calls", calls)
+	}
+
+	failing := result.Defer(func() result.Result[int] { return result.Err[int](ErrNotFound) })
+	if !failing.IsErr() || !errors.Is(failing.Err(), ErrNotFound) || failing.UnwrapOr(7) != 7 {
+		t.Fatalf("expected %v, got %v", ErrNotFound, failing.Err())
+	}
+	bubbled := result.CatchInto(failing.BubbleUp)
+	if !errors.Is(bubbled.Err(), ErrNotFound) {
+		t.Fatalf("expected %v, got %v", ErrNotFound, bubbled.Err())
+	}
+}
+
+func TestIterate(t *testing.T) {
+	seq, err := result.Iterate(1, func(n int) result.Result[int] { return result.OkIf(n < 8, n*2, ErrInvalidInput) })
+	if err() != nil {
+		t.Fatalf("expected %v before iterating, got %v", nil, err())
+	}
+	var got []int
+	for n := range seq {
+		got = append(got, n)
+	}
+	if fmt.Sprint(got) != "[1 2 4 8]" || !errors.Is(err(), ErrInvalidInput) {
+		t.Fatalf("expected %v then %v, got %v then %v", "[1 2 4 8]", ErrInvalidInput, got, err())
+	}
+
+	taken := 0
+	counter, err := result.Iterate(0, func(n int) result.Result[int] { return result.Ok(n + 1) })
+	for n := range counter {
+		if taken++; taken == 3 {
+			if n != 2 {
+				t.Fatalf("expected %v, got %v", 2, n)
+			}
+			break
+		}
+	}
+	if err() != nil {
+		t.Fatalf("expected %v after stopping early, got %v", nil, err())
+	}
+}
+
+func TestOnErrDefer(t *testing.T) {
+	var undone []error
+	register := func(fail bool) (res result.Result[int]) {
+		defer result.Catch(&res)
+		id := result.Ok(7).BubbleUp()
+		defer result.OnErrDefer(&res, func(err error) { undone = append(undone, err) })
+		if fail {
+			result.Err[int](ErrDatabaseDown).BubbleUp()
+		}
+		return result.Ok(id)
+	}
+
+	if got := register(false); got.Unwrap() != 7 || len(undone) != 0 {
+		t.Fatalf("expected Ok without cleanup, got %v and %v", got, undone)
+	}
+	if got := register(true); !errors.Is(got.Err(), ErrDatabaseDown) || len(undone) != 1 || undone[0] != ErrDatabaseDown {
+		t.Fatalf("expected cleanup with %v, got %v", ErrDatabaseDown, undone)
+	}
+}
+
 // -------------------------------------------- Benchmark Tests --------------------------------------------
 
 // Test result:
@@ -616,3 +966,25 @@ func BenchmarkResultWithAndThen(b *testing.B) {
 		_ = compute()
 	}
 }
+
+// Test result:
+//
+//	BenchmarkResultMultiLayerFallback    	  711128	      1986 ns/op	      80 B/op	       5 allocs/op
+func BenchmarkResultMultiLayerFallback(b *testing.B) {
+	compute := func() (res result.Result[int]) {
+		defer result.Catch(&res)
+		defer result.Fallback(&res, 3, ErrTimeout)
+		defer result.CatchWith(&res, func(error) int {
+			return result.Err[int](ErrTimeout).BubbleUp()
+		}, ErrCacheMiss)
+		defer result.CatchWith(&res, func(error) int {
+			return result.Err[int](ErrCacheMiss).BubbleUp()
+		}, ErrDatabaseDown)
+		return result.Ok(result.Err[int](ErrDatabaseDown).BubbleUp())
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = compute()
+	}
+}
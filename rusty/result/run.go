@@ -0,0 +1,113 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package result. run provides execution wrappers that run a Result-returning function under extra
+// guarantees, such as recovering panics in a spawned goroutine or enforcing a deadline.
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrPanic is wrapped by the Err produced when a function run by SafeGo panics with something other
+// than a BubbleUp. If the panic value is an error, it is wrapped too.
+var ErrPanic = errors.New("result: goroutine panicked")
+
+// ErrTimeout is wrapped by the Err produced when a function run by WithTimeout misses its deadline.
+// The error also wraps context.DeadlineExceeded.
+var ErrTimeout = errors.New("result: operation timed out")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// SafeGo runs fn in a new goroutine and delivers its Result on the returned channel, which receives
+// exactly one value and is then closed. Any panic in fn is recovered: a BubbleUp becomes its Err, and any
+// other panic becomes an Err wrapping ErrPanic, so a stray panic cannot crash the process.
+//
+// When to use:
+//   - When fanning work out to goroutines that call BubbleUp
+//   - When running third-party code that may panic in the background
+//
+// Example - Concurrent lookups:
+//
+//	userCh := result.SafeGo(func() Result[User] { return repo.FindUser(id) })
+//	ordersCh := result.SafeGo(func() Result[[]Order] { return repo.FindOrders(id) })
+//	page := result.Zip(<-userCh, <-ordersCh)
+func SafeGo[T any](fn func() Result[T]) <-chan Result[T] {
+	ch := make(chan Result[T], 1)
+	go func() {
+		defer close(ch)
+		ch <- safeCall(fn)
+	}()
+	return ch
+}
+
+// WithTimeout runs fn with a context that expires after d and returns its Result. If the deadline passes
+// first, WithTimeout returns an Err wrapping ErrTimeout without waiting further; fn keeps running in the
+// background until it observes the cancelled context, and its late Result is discarded. An Err from fn
+// caused by the deadline itself is reported as ErrTimeout too. Panics in fn are recovered as in SafeGo.
+//
+// When to use:
+//   - When a single step of a pipeline needs its own deadline
+//   - When calling a dependency that may hang
+//
+// Example - Bounding a remote call:
+//
+//	quote := result.WithTimeout(2*time.Second, func(ctx context.Context) Result[Quote] {
+//	    return pricing.Fetch(ctx, sku)
+//	})
+//	if errors.Is(quote.Err(), result.ErrTimeout) {
+//	    return cachedQuote(sku)
+//	}
+func WithTimeout[T any](d time.Duration, fn func(context.Context) Result[T]) Result[T] {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+
+	ch := make(chan Result[T], 1)
+	go func() {
+		ch <- safeCall(func() Result[T] { return fn(ctx) })
+	}()
+
+	select {
+	case res := <-ch:
+		if res.IsErr() && errors.Is(res.Err(), context.DeadlineExceeded) && ctx.Err() != nil {
+			return timeoutErr[T](d, res.Err())
+		}
+		return res
+	case <-ctx.Done():
+		return timeoutErr[T](d, ctx.Err())
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// safeCall runs fn, converting any panic into an Err Result.
+func safeCall[T any](fn func() Result[T]) (res Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = panicResult[T](r)
+		}
+	}()
+	return fn()
+}
+
+// panicResult converts a recovered panic value into an Err Result.
+func panicResult[T any](r any) Result[T] {
+	switch p := r.(type) {
+	case tryError:
+		return propagate[T](p.error)
+	case error:
+		return Err[T](fmt.Errorf("%w: %w", ErrPanic, p))
+	default:
+		return Err[T](fmt.Errorf("%w: %v", ErrPanic, p))
+	}
+}
+
+// timeoutErr builds the Err returned by WithTimeout when the deadline d passed, wrapping cause.
+func timeoutErr[T any](d time.Duration, cause error) Result[T] {
+	return Err[T](fmt.Errorf("%w after %v: %w", ErrTimeout, d, cause))
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package result. sql implements database/sql's Scanner on Result and adapts Results to driver.Valuer,
+// so nullable or fallible columns scan straight into a Result[T] and are written back without manual Wrap calls.
+package result
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// sqlValuer adapts a Result to driver.Valuer; Result cannot implement it directly because its Value
+// method already returns an Option.
+type sqlValuer[T any] struct {
+	res Result[T]
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrNull is the error of a Result scanned from a SQL NULL. SQLValue writes such a Result back as NULL.
+var ErrNull = errors.New("result: NULL value")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Scan implements sql.Scanner. A NULL column becomes Err(ErrNull); any other value is converted to T
+// with database/sql's own conversion rules (so int64 columns scan into int, []byte into string, and so on),
+// and a failed conversion is returned as the Scan error, leaving r unchanged.
+//
+// Example - A nullable column in a row struct:
+//
+//	type User struct {
+//	    ID       int                   `db:"id"`
+//	    Nickname result.Result[string] `db:"nickname"`
+//	}
+//	user := dbx.QueryOne[User](ctx, db, "SELECT id, nickname FROM users WHERE id = $1", id).BubbleUp()
+//	name := user.Nickname.UnwrapOr(user.Email)
+func (r *Result[T]) Scan(src any) error {
+	if src == nil {
+		*r = propagate[T](ErrNull)
+		return nil
+	}
+	var value sql.Null[T]
+	if err := value.Scan(src); err != nil {
+		return err
+	}
+	*r = Ok(value.V)
+	return nil
+}
+
+// SQLValue returns r as a driver.Valuer for use as a query argument. An Ok Result is written as its value,
+// converted by the driver's default rules; Err(ErrNull) is written as NULL. Any other error is returned from
+// the query, so a failed computation is never silently stored as NULL.
+//
+// Example:
+//
+//	_, err := db.ExecContext(ctx, "UPDATE users SET nickname = $1 WHERE id = $2", user.Nickname.SQLValue(), user.ID)
+func (r Result[T]) SQLValue() driver.Valuer {
+	return sqlValuer[T]{res: r}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// Value implements driver.Valuer.
+func (v sqlValuer[T]) Value() (driver.Value, error) {
+	if v.res.IsErr() {
+		if errors.Is(v.res.Err(), ErrNull) {
+			return nil, nil
+		}
+		return nil, v.res.Err()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(v.res.Unwrap())
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package result_test. sql_test verifies scanning columns into Results and writing them back as driver values.
+package result_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+func TestResult_Scan(t *testing.T) {
+	var name result.Result[string]
+	if err := name.Scan([]byte("ali")); err != nil || name.Unwrap() != "ali" {
+		t.Fatalf("expected Ok(ali), got %v (%v)", name, err)
+	}
+
+	var age result.Result[int]
+	if err := age.Scan(int64(42)); err != nil || age.Unwrap() != 42 {
+		t.Fatalf("expected Ok(42), got %v (%v)", age, err)
+	}
+
+	if err := age.Scan(nil); err != nil || !errors.Is(age.Err(), result.ErrNull) {
+		t.Fatalf("expected Err(%v), got %v (%v)", result.ErrNull, age, err)
+	}
+
+	age = result.Ok(1)
+	if err := age.Scan("not a number"); err == nil || age.Unwrap() != 1 {
+		t.Fatalf("expected a conversion error leaving Ok(1), got %v (%v)", age, err)
+	}
+}
+
+func TestResult_SQLValue(t *testing.T) {
+	v, err := result.Ok(42).SQLValue().Value()
+	if err != nil || v != int64(42) {
+		t.Fatalf("expected %v, got %v (%v)", int64(42), v, err)
+	}
+
+	now := time.Now()
+	if v, _ := result.Ok(now).SQLValue().Value(); v != now {
+		t.Fatalf("expected %v, got %v", now, v)
+	}
+
+	if v, err := result.Err[string](result.ErrNull).SQLValue().Value(); v != nil || err != nil {
+		t.Fatalf("expected NULL, got %v (%v)", v, err)
+	}
+
+	if _, err := result.Err[string](ErrDatabaseDown).SQLValue().Value(); !errors.Is(err, ErrDatabaseDown) {
+		t.Fatalf("expected %v, got %v", ErrDatabaseDown, err)
+	}
+}
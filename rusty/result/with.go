@@ -0,0 +1,56 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package result. with provides the scoped-resource pattern for Results: acquire, use, and always release,
+// so resources such as files, transactions and semaphore permits cannot leak on early returns or BubbleUp.
+package result
+
+import (
+	"errors"
+	"io"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// With runs fn with the resource held by acquire and closes it afterwards, even if fn panics
+// (for example through BubbleUp). If acquire is Err, fn is not called and the error is returned.
+// A Close error turns an Ok into Err; if fn also failed, both errors are joined.
+//
+// When to use:
+//   - Whenever a resource obtained as a Result must be released on every path
+//   - Instead of pairing an acquisition with a manual defer Close
+//
+// Example - Reading a file:
+//
+//	func ReadConfig(path string) Result[Config] {
+//	    return result.With(result.Wrap(os.Open(path)), func(f *os.File) Result[Config] {
+//	        return parseConfig(f)
+//	    })
+//	}
+//
+// Example - Bounding concurrency with a semaphore permit:
+//
+//	res := result.With(sem.Acquire(ctx), func(p syncx.Permit) Result[Report] {
+//	    return buildReport(ctx)
+//	})
+func With[R io.Closer, T any](acquire Result[R], fn func(R) Result[T]) (res Result[T]) {
+	if acquire.IsErr() {
+		return propagate[T](acquire.Err())
+	}
+
+	resource := acquire.Unwrap()
+	completed := false
+	defer func() {
+		if !completed {
+			_ = resource.Close() // fn panicked; release and let the panic continue
+			return
+		}
+		if err := resource.Close(); err != nil {
+			res = Err[T](errors.Join(res.Err(), err))
+		}
+	}()
+
+	res = fn(resource)
+	completed = true
+	return res
+}
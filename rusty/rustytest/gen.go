@@ -0,0 +1,92 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package rustytest. gen provides seeded generators of arbitrary values, Results and Options for
+// property-based tests of code built on the rusty types.
+//
+// Example:
+//
+//	users := rustytest.ResultOf(rustytest.OneOf(alice, bob), ErrNotFound, ErrTimeout)
+//	r := rand.New(rand.NewPCG(1, 2))
+//	for range 100 {
+//	    res := users(r) // Ok(alice), Ok(bob), Err(ErrNotFound) or Err(ErrTimeout)
+//	    ...
+//	}
+package rustytest
+
+import (
+	"errors"
+	"math/rand/v2"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Gen [T] produces an arbitrary T from r. Generators must draw all randomness from r so that a run can
+// be reproduced from its seed.
+type Gen[T any] func(r *rand.Rand) T
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrArbitrary is the error carried by generated Err Results when ResultOf is given no errors.
+var ErrArbitrary = errors.New("rustytest: arbitrary error")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Ints generates integers in [-1000, 1000].
+func Ints() Gen[int] {
+	return func(r *rand.Rand) int {
+		return r.IntN(2001) - 1000
+	}
+}
+
+// Strings generates lowercase ASCII strings of up to 8 characters, including the empty string.
+func Strings() Gen[string] {
+	return func(r *rand.Rand) string {
+		b := make([]byte, r.IntN(9))
+		for i := range b {
+			b[i] = byte('a' + r.IntN(26))
+		}
+		return string(b)
+	}
+}
+
+// OneOf generates one of values, chosen uniformly. It panics if values is empty.
+func OneOf[T any](values ...T) Gen[T] {
+	if len(values) == 0 {
+		panic("rustytest: OneOf requires at least one value")
+	}
+	return func(r *rand.Rand) T {
+		return values[r.IntN(len(values))]
+	}
+}
+
+// ResultOf generates Ok Results holding values from values about two thirds of the time, and Err Results
+// holding one of errs (or ErrArbitrary if none are given) otherwise.
+//
+// Example:
+//
+//	gen := rustytest.ResultOf(rustytest.Ints(), ErrDatabaseDown)
+func ResultOf[T any](values Gen[T], errs ...error) Gen[result.Result[T]] {
+	if len(errs) == 0 {
+		errs = []error{ErrArbitrary}
+	}
+	return func(r *rand.Rand) result.Result[T] {
+		if r.IntN(3) == 0 {
+			return result.Err[T](errs[r.IntN(len(errs))])
+		}
+		return result.Ok(values(r))
+	}
+}
+
+// OptionOf generates Some Options holding values from values about two thirds of the time, and None otherwise.
+func OptionOf[T any](values Gen[T]) Gen[option.Option[T]] {
+	return func(r *rand.Rand) option.Option[T] {
+		if r.IntN(3) == 0 {
+			return option.None[T]()
+		}
+		return option.Some(values(r))
+	}
+}
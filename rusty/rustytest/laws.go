@@ -0,0 +1,244 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package rustytest. laws provides reusable checks of the functor and monad laws for Result and Option,
+// so that wrappers around Map and AndThen (instrumented, traced, retried, ...) can verify they still
+// behave like the originals.
+//
+// Example - Verifying a traced Map keeps Result semantics:
+//
+//	func TestTracedMap_Laws(t *testing.T) {
+//	    ops := rustytest.ResultDefaults[int]()
+//	    ops.Map = func(r result.Result[int], fn func(int) int) result.Result[int] {
+//	        return tracing.Map(ctx, r, fn)
+//	    }
+//	    rustytest.CheckResultLaws(t, ops, rustytest.Ints(),
+//	        func(x int) int { return x + 1 },
+//	        func(x int) int { return x * 2 },
+//	    )
+//	}
+package rustytest
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// ResultOps [T] are the Result operations under test.
+type ResultOps[T any] struct {
+	Map     func(result.Result[T], func(T) T) result.Result[T]
+	AndThen func(result.Result[T], func(T) result.Result[T]) result.Result[T]
+}
+
+// OptionOps [T] are the Option operations under test.
+type OptionOps[T any] struct {
+	Map     func(option.Option[T], func(T) T) option.Option[T]
+	FlatMap func(option.Option[T], func(T) option.Option[T]) option.Option[T]
+}
+
+// Option configures a law check.
+type Option func(*config)
+
+// config holds the settings applied by Option.
+type config struct {
+	iterations int
+	seed       uint64
+}
+
+// law is a single named equation over a generated container m and a generated value a.
+type law[M, T any] struct {
+	name      string
+	got, want func(m M, a T) M
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Iterations sets how many generated inputs each law is checked against (default 100).
+func Iterations(n int) Option {
+	return func(c *config) { c.iterations = max(n, 1) }
+}
+
+// Seed fixes the random seed, e.g. to reproduce a failure reported by a previous run.
+// By default a fresh seed is chosen and included in failure messages.
+func Seed(seed uint64) Option {
+	return func(c *config) { c.seed = seed }
+}
+
+// ResultDefaults returns the package's own result.Map and result.AndThen, as a baseline to override.
+func ResultDefaults[T any]() ResultOps[T] {
+	return ResultOps[T]{
+		Map:     result.Map[T, T],
+		AndThen: result.AndThen[T, T],
+	}
+}
+
+// OptionDefaults returns the package's own option.Map and option.FlatMap, as a baseline to override.
+func OptionDefaults[T any]() OptionOps[T] {
+	return OptionOps[T]{
+		Map:     option.Map[T, T],
+		FlatMap: option.FlatMap[T, T],
+	}
+}
+
+// CheckResultLaws checks that ops satisfy the functor and monad laws on Results generated from values,
+// using f and g as the mapped functions and their Ok-returning and failing lifts as the chained ones:
+//   - Functor identity: Map(m, id) == m
+//   - Functor composition: Map(m, g∘f) == Map(Map(m, f), g)
+//   - Left identity: AndThen(Ok(a), k) == k(a)
+//   - Right identity: AndThen(m, Ok) == m
+//   - Associativity: AndThen(AndThen(m, k), h) == AndThen(m, x => AndThen(k(x), h))
+//   - Map/AndThen agreement: Map(m, f) == AndThen(m, x => Ok(f(x)))
+//
+// Err Results are equal when errors.Is matches their errors. Each violated law is reported once.
+func CheckResultLaws[T comparable](t testing.TB, ops ResultOps[T], values Gen[T], f, g func(T) T, opts ...Option) {
+	t.Helper()
+
+	ok := result.Ok[T]
+	fail := func(T) result.Result[T] { return result.Err[T](ErrArbitrary) }
+	k := func(x T) result.Result[T] { return ok(f(x)) }
+	h := func(x T) result.Result[T] { return ok(g(x)) }
+	assoc := func(k, h func(T) result.Result[T]) (func(result.Result[T], T) result.Result[T], func(result.Result[T], T) result.Result[T]) {
+		return func(m result.Result[T], _ T) result.Result[T] { return ops.AndThen(ops.AndThen(m, k), h) },
+			func(m result.Result[T], _ T) result.Result[T] {
+				return ops.AndThen(m, func(x T) result.Result[T] { return ops.AndThen(k(x), h) })
+			}
+	}
+	okAssocGot, okAssocWant := assoc(k, h)
+	errAssocGot, errAssocWant := assoc(fail, h)
+	laws := []law[result.Result[T], T]{
+		{"functor identity",
+			func(m result.Result[T], _ T) result.Result[T] { return ops.Map(m, func(x T) T { return x }) },
+			func(m result.Result[T], _ T) result.Result[T] { return m }},
+		{"functor composition",
+			func(m result.Result[T], _ T) result.Result[T] { return ops.Map(m, func(x T) T { return g(f(x)) }) },
+			func(m result.Result[T], _ T) result.Result[T] { return ops.Map(ops.Map(m, f), g) }},
+		{"left identity",
+			func(_ result.Result[T], a T) result.Result[T] { return ops.AndThen(ok(a), k) },
+			func(_ result.Result[T], a T) result.Result[T] { return k(a) }},
+		{"left identity (failing)",
+			func(_ result.Result[T], a T) result.Result[T] { return ops.AndThen(ok(a), fail) },
+			func(_ result.Result[T], a T) result.Result[T] { return fail(a) }},
+		{"right identity",
+			func(m result.Result[T], _ T) result.Result[T] { return ops.AndThen(m, ok) },
+			func(m result.Result[T], _ T) result.Result[T] { return m }},
+		{"associativity", okAssocGot, okAssocWant},
+		{"associativity (failing)", errAssocGot, errAssocWant},
+		{"map/and-then agreement",
+			func(m result.Result[T], _ T) result.Result[T] { return ops.Map(m, f) },
+			func(m result.Result[T], _ T) result.Result[T] { return ops.AndThen(m, k) }},
+	}
+
+	checkLaws(t, laws, ResultOf(values), values, resultsEqual[T], describeResult[T], newConfig(opts))
+}
+
+// CheckOptionLaws checks that ops satisfy the functor and monad laws on Options generated from values.
+// The laws are those of CheckResultLaws with Some for Ok, None for Err and FlatMap for AndThen.
+func CheckOptionLaws[T comparable](t testing.TB, ops OptionOps[T], values Gen[T], f, g func(T) T, opts ...Option) {
+	t.Helper()
+
+	some := option.Some[T]
+	none := func(T) option.Option[T] { return option.None[T]() }
+	k := func(x T) option.Option[T] { return some(f(x)) }
+	h := func(x T) option.Option[T] { return some(g(x)) }
+	assoc := func(k, h func(T) option.Option[T]) (func(option.Option[T], T) option.Option[T], func(option.Option[T], T) option.Option[T]) {
+		return func(m option.Option[T], _ T) option.Option[T] { return ops.FlatMap(ops.FlatMap(m, k), h) },
+			func(m option.Option[T], _ T) option.Option[T] {
+				return ops.FlatMap(m, func(x T) option.Option[T] { return ops.FlatMap(k(x), h) })
+			}
+	}
+	someAssocGot, someAssocWant := assoc(k, h)
+	noneAssocGot, noneAssocWant := assoc(none, h)
+	laws := []law[option.Option[T], T]{
+		{"functor identity",
+			func(m option.Option[T], _ T) option.Option[T] { return ops.Map(m, func(x T) T { return x }) },
+			func(m option.Option[T], _ T) option.Option[T] { return m }},
+		{"functor composition",
+			func(m option.Option[T], _ T) option.Option[T] { return ops.Map(m, func(x T) T { return g(f(x)) }) },
+			func(m option.Option[T], _ T) option.Option[T] { return ops.Map(ops.Map(m, f), g) }},
+		{"left identity",
+			func(_ option.Option[T], a T) option.Option[T] { return ops.FlatMap(some(a), k) },
+			func(_ option.Option[T], a T) option.Option[T] { return k(a) }},
+		{"left identity (none)",
+			func(_ option.Option[T], a T) option.Option[T] { return ops.FlatMap(some(a), none) },
+			func(_ option.Option[T], a T) option.Option[T] { return none(a) }},
+		{"right identity",
+			func(m option.Option[T], _ T) option.Option[T] { return ops.FlatMap(m, some) },
+			func(m option.Option[T], _ T) option.Option[T] { return m }},
+		{"associativity", someAssocGot, someAssocWant},
+		{"associativity (none)", noneAssocGot, noneAssocWant},
+		{"map/flat-map agreement",
+			func(m option.Option[T], _ T) option.Option[T] { return ops.Map(m, f) },
+			func(m option.Option[T], _ T) option.Option[T] { return ops.FlatMap(m, k) }},
+	}
+
+	checkLaws(t, laws, OptionOf(values), values, optionsEqual[T], describeOption[T], newConfig(opts))
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// newConfig applies opts over the defaults.
+func newConfig(opts []Option) config {
+	cfg := config{iterations: 100, seed: uint64(time.Now().UnixNano())}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// checkLaws evaluates every law on cfg.iterations generated inputs, reporting the first violation of each.
+// Every law sees the same input sequence, so a failure is reproducible with Seed alone.
+func checkLaws[M, T any](t testing.TB, laws []law[M, T], inputs Gen[M], values Gen[T],
+	equal func(M, M) bool, describe func(M) string, cfg config) {
+	t.Helper()
+	for _, l := range laws {
+		r := rand.New(rand.NewPCG(cfg.seed, cfg.seed))
+		for range cfg.iterations {
+			m, a := inputs(r), values(r)
+			if got, want := l.got(m, a), l.want(m, a); !equal(got, want) {
+				t.Errorf("rustytest: %s law violated for m = %s, a = %#v (seed %d)\n  got:  %s\n  want: %s",
+					l.name, describe(m), a, cfg.seed, describe(got), describe(want))
+				break
+			}
+		}
+	}
+}
+
+// resultsEqual reports whether a and b are both Ok with equal values, or both Err with matching errors.
+func resultsEqual[T comparable](a, b result.Result[T]) bool {
+	if a.IsErr() || b.IsErr() {
+		return a.IsErr() && b.IsErr() && errors.Is(a.Err(), b.Err())
+	}
+	return a.Unwrap() == b.Unwrap()
+}
+
+// optionsEqual reports whether a and b are both None, or both Some with equal values.
+func optionsEqual[T comparable](a, b option.Option[T]) bool {
+	if a.IsNone() || b.IsNone() {
+		return a.IsNone() && b.IsNone()
+	}
+	return a.Unwrap() == b.Unwrap()
+}
+
+// describeResult formats r as Ok(value) or Err(error) for failure messages.
+func describeResult[T any](r result.Result[T]) string {
+	if r.IsErr() {
+		return fmt.Sprintf("Err(%v)", r.Err())
+	}
+	return fmt.Sprintf("Ok(%#v)", r.Unwrap())
+}
+
+// describeOption formats o as Some(value) or None for failure messages.
+func describeOption[T any](o option.Option[T]) string {
+	if o.IsNone() {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%#v)", o.Unwrap())
+}
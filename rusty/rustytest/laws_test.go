@@ -0,0 +1,97 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package rustytest_test. laws_test verifies that the package's own operations pass the law checks and
+// that a wrapper breaking Result semantics is caught.
+package rustytest_test
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/rustytest"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+// recorder captures failures instead of failing the enclosing test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatal(args ...any) {
+	r.failures = append(r.failures, fmt.Sprint(args...))
+	runtime.Goexit()
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+// capture runs fn against a recorder on its own goroutine, so Fatal can stop fn without stopping the test.
+func capture(t *testing.T, fn func(tb testing.TB)) []string {
+	rec := &recorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(rec)
+	}()
+	<-done
+	return rec.failures
+}
+
+func inc(x int) int    { return x + 1 }
+func double(x int) int { return x * 2 }
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestCheckResultLaws_Defaults(t *testing.T) {
+	rustytest.CheckResultLaws(t, rustytest.ResultDefaults[int](), rustytest.Ints(), inc, double)
+	rustytest.CheckResultLaws(t, rustytest.ResultDefaults[string](), rustytest.Strings(),
+		strings.ToUpper, func(s string) string { return s + "!" })
+}
+
+func TestCheckOptionLaws_Defaults(t *testing.T) {
+	rustytest.CheckOptionLaws(t, rustytest.OptionDefaults[int](), rustytest.Ints(), inc, double)
+}
+
+func TestCheckResultLaws_BrokenMap(t *testing.T) {
+	ops := rustytest.ResultDefaults[int]()
+	ops.Map = func(r result.Result[int], fn func(int) int) result.Result[int] {
+		return result.Ok(fn(r.UnwrapOr(0))) // swallows errors
+	}
+
+	rec := &recorder{TB: t}
+	rustytest.CheckResultLaws(rec, ops, rustytest.Ints(), inc, double, rustytest.Seed(42))
+	if len(rec.failures) == 0 {
+		t.Fatal("expected law violations for a Map that swallows errors")
+	}
+	if !strings.Contains(rec.failures[0], "functor identity") || !strings.Contains(rec.failures[0], "seed 42") {
+		t.Fatalf("expected failure naming the law and seed, got %q", rec.failures[0])
+	}
+}
+
+func TestCheckOptionLaws_BrokenFlatMap(t *testing.T) {
+	ops := rustytest.OptionDefaults[int]()
+	ops.FlatMap = func(o option.Option[int], fn func(int) option.Option[int]) option.Option[int] {
+		return o // ignores fn
+	}
+
+	rec := &recorder{TB: t}
+	rustytest.CheckOptionLaws(rec, ops, rustytest.Ints(), inc, double, rustytest.Iterations(10))
+	if len(rec.failures) == 0 {
+		t.Fatal("expected law violations for a FlatMap that ignores its function")
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package rustytest. require provides test assertions for Result and Option that stop the test on failure
+// and report both sides in a readable form, replacing hand-written IsErr checks and Fatalf formatting.
+//
+// Example - Traditional vs require helpers:
+//
+//	// Traditional Go
+//	res := svc.FindUser(ctx, 42)
+//	if res.IsErr() {
+//	    t.Fatalf("expected Ok, got %v", res.Err())
+//	}
+//	user := res.Unwrap()
+//
+//	// With rustytest
+//	user := rustytest.RequireOk(t, svc.FindUser(ctx, 42))
+package rustytest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// RequireOk returns the value of res, failing the test immediately if res is Err.
+func RequireOk[T any](t testing.TB, res result.Result[T]) T {
+	t.Helper()
+	if res.IsErr() {
+		t.Fatalf("expected Ok, got Err\n  error: %v\n  chain: %s", res.Err(), errorChain(res.Err()))
+	}
+	return res.Unwrap()
+}
+
+// RequireErr returns the error of res, failing the test immediately if res is Ok.
+func RequireErr[T any](t testing.TB, res result.Result[T]) error {
+	t.Helper()
+	if res.IsOk() {
+		t.Fatalf("expected Err, got %s", describeResult(res))
+	}
+	return res.Err()
+}
+
+// RequireErrIs fails the test immediately unless res is Err with an error matching target via errors.Is.
+//
+// Example:
+//
+//	rustytest.RequireErrIs(t, repo.Find(ctx, "missing"), ErrNotFound)
+func RequireErrIs[T any](t testing.TB, res result.Result[T], target error) {
+	t.Helper()
+	if res.IsOk() {
+		t.Fatalf("expected Err matching %v\n  got:  %s", target, describeResult(res))
+	}
+	if !errors.Is(res.Err(), target) {
+		t.Fatalf("expected Err matching %v\n  got:   Err(%v)\n  chain: %s", target, res.Err(), errorChain(res.Err()))
+	}
+}
+
+// RequireSome returns the value of opt, failing the test immediately if opt is None.
+func RequireSome[T any](t testing.TB, opt option.Option[T]) T {
+	t.Helper()
+	if opt.IsNone() {
+		t.Fatal("expected Some, got None")
+	}
+	return opt.Unwrap()
+}
+
+// RequireNone fails the test immediately if opt is Some.
+func RequireNone[T any](t testing.TB, opt option.Option[T]) {
+	t.Helper()
+	if opt.IsSome() {
+		t.Fatalf("expected None, got %s", describeOption(opt))
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// errorChain renders the errors reachable from err through Unwrap, outermost first, with their types,
+// so a mismatched sentinel is visible without a debugger.
+func errorChain(err error) string {
+	var parts []string
+	var walk func(error)
+	walk = func(err error) {
+		if err == nil {
+			return
+		}
+		parts = append(parts, fmt.Sprintf("%T(%q)", err, err.Error()))
+		switch e := err.(type) {
+		case interface{ Unwrap() error }:
+			walk(e.Unwrap())
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				walk(inner)
+			}
+		}
+	}
+	walk(err)
+	return strings.Join(parts, " -> ")
+}
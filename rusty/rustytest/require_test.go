@@ -0,0 +1,72 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package rustytest_test. require_test verifies the Require helpers pass through values and stop on mismatch.
+package rustytest_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/rustytest"
+)
+
+var ErrNotFound = errors.New("not found")
+
+func TestRequire_Passing(t *testing.T) {
+	if got := rustytest.RequireOk(t, result.Ok(42)); got != 42 {
+		t.Fatalf("expected %v, got %v", 42, got)
+	}
+	if got := rustytest.RequireSome(t, option.Some("x")); got != "x" {
+		t.Fatalf("expected %v, got %v", "x", got)
+	}
+	wrapped := fmt.Errorf("find user: %w", ErrNotFound)
+	if got := rustytest.RequireErr(t, result.Err[int](wrapped)); got != wrapped {
+		t.Fatalf("expected %v, got %v", wrapped, got)
+	}
+	rustytest.RequireErrIs(t, result.Err[int](wrapped), ErrNotFound)
+	rustytest.RequireNone(t, option.None[int]())
+}
+
+func TestRequireOk_FailsWithChain(t *testing.T) {
+	reached := false
+	failures := capture(t, func(tb testing.TB) {
+		rustytest.RequireOk(tb, result.Err[int](fmt.Errorf("find user: %w", ErrNotFound)))
+		reached = true
+	})
+	if reached {
+		t.Fatal("expected RequireOk to stop the test")
+	}
+	if len(failures) != 1 || !strings.Contains(failures[0], `*errors.errorString("not found")`) {
+		t.Fatalf("expected failure showing the error chain, got %q", failures)
+	}
+}
+
+func TestRequireErrIs_Mismatch(t *testing.T) {
+	failures := capture(t, func(tb testing.TB) {
+		rustytest.RequireErrIs(tb, result.Ok(7), ErrNotFound)
+	})
+	if len(failures) != 1 || !strings.Contains(failures[0], "Ok(7)") {
+		t.Fatalf("expected failure showing the Ok value, got %q", failures)
+	}
+
+	failures = capture(t, func(tb testing.TB) {
+		rustytest.RequireErrIs(tb, result.Err[int](errors.New("timeout")), ErrNotFound)
+	})
+	if len(failures) != 1 || !strings.Contains(failures[0], "expected Err matching not found") {
+		t.Fatalf("expected failure naming the target, got %q", failures)
+	}
+}
+
+func TestRequireSome_None(t *testing.T) {
+	failures := capture(t, func(tb testing.TB) {
+		rustytest.RequireSome(tb, option.None[int]())
+	})
+	if len(failures) != 1 {
+		t.Fatalf("expected %v, got %v", 1, len(failures))
+	}
+}
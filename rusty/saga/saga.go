@@ -0,0 +1,152 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package saga. saga provides Run, which executes a sequence of steps that each pair an action with a
+// compensator, undoing the completed steps in reverse order when a later one fails. It is the
+// transactional-workflow pattern for operations spanning services, where a database transaction cannot help.
+//
+// Example - Traditional vs saga:
+//
+//	// Traditional Go
+//	if err := inventory.Reserve(ctx, order); err != nil {
+//	    return err
+//	}
+//	if err := payments.Charge(ctx, order); err != nil {
+//	    inventory.Release(ctx, order) // error ignored, and easy to forget in the next step
+//	    return err
+//	}
+//
+//	// With saga
+//	return saga.Run(ctx,
+//	    saga.Step{Name: "reserve", Action: reserve, Compensate: release},
+//	    saga.Step{Name: "charge", Action: charge, Compensate: refund},
+//	    saga.Step{Name: "ship", Action: ship},
+//	)
+package saga
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Step is one stage of a saga. Steps share data through the variables their functions close over.
+type Step struct {
+	Name string // Used in errors
+	// Action performs the step. A BubbleUp inside it becomes its Err.
+	Action func(context.Context) result.Result[types.Unit]
+	// Compensate undoes a completed Action when a later step fails. nil means nothing needs undoing.
+	Compensate func(context.Context) result.Result[types.Unit]
+}
+
+// Error is returned when a step fails. It unwraps to the step's error and to every compensation error,
+// so errors.Is matches any of them.
+type Error struct {
+	Step             string  // Name of the step whose action failed
+	Err              error   // Error of the failed action
+	CompensationErrs []error // Errors of compensators that failed, in the order they ran
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Run executes the actions of steps in order. If an action fails, or ctx is done before a step starts, the
+// compensators of the steps completed so far run in reverse order and Run returns Err(*Error). Compensators
+// run with a context that is not cancelled along with ctx, and a failing compensator does not stop the
+// remaining ones. If an action panics with something other than a BubbleUp, the completed steps are
+// compensated and the panic is re-raised.
+//
+// When to use:
+//   - Workflows across services or stores that must not be left half-applied
+//   - Instead of hand-written cleanup calls after every failing step
+//
+// Example:
+//
+//	var charge payments.Charge
+//	res := saga.Run(ctx,
+//	    saga.Step{
+//	        Name: "charge",
+//	        Action: func(ctx context.Context) result.Result[types.Unit] {
+//	            charge = payments.Charge(ctx, order).BubbleUp()
+//	            return result.Ok(types.Unit{})
+//	        },
+//	        Compensate: func(ctx context.Context) result.Result[types.Unit] {
+//	            return payments.Refund(ctx, charge.ID)
+//	        },
+//	    },
+//	    saga.Step{Name: "notify", Action: notify},
+//	)
+func Run(ctx context.Context, steps ...Step) result.Result[types.Unit] {
+	for i, step := range steps {
+		if err := ctx.Err(); err != nil {
+			return fail(ctx, steps[:i], step.Name, err)
+		}
+		if res := act(ctx, steps[:i], step.Action); res.IsErr() {
+			return fail(ctx, steps[:i], step.Name, res.Err())
+		}
+	}
+	return result.Ok(types.Unit{})
+}
+
+// -------------------------------------------- Error Methods --------------------------------------------
+
+// Error describes the failed step and any compensation failures.
+func (e *Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "saga: step %q failed: %v", e.Step, e.Err)
+	for _, err := range e.CompensationErrs {
+		fmt.Fprintf(&b, "; %v", err)
+	}
+	return b.String()
+}
+
+// Unwrap returns the action error followed by the compensation errors.
+func (e *Error) Unwrap() []error {
+	return append([]error{e.Err}, e.CompensationErrs...)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// act runs action, compensating completed before re-raising a panic that is not a BubbleUp.
+func act(ctx context.Context, completed []Step, action func(context.Context) result.Result[types.Unit]) result.Result[types.Unit] {
+	returned := false
+	defer func() {
+		if !returned {
+			compensate(ctx, completed)
+		}
+	}()
+	res := call(ctx, action)
+	returned = true
+	return res
+}
+
+// fail compensates completed and returns the *Error for the step that failed with err.
+func fail(ctx context.Context, completed []Step, step string, err error) result.Result[types.Unit] {
+	return result.Err[types.Unit](&Error{Step: step, Err: err, CompensationErrs: compensate(ctx, completed)})
+}
+
+// compensate runs the compensators of completed in reverse order and returns their errors.
+func compensate(ctx context.Context, completed []Step) []error {
+	ctx = context.WithoutCancel(ctx)
+	var errs []error
+	for i := len(completed) - 1; i >= 0; i-- {
+		step := completed[i]
+		if step.Compensate == nil {
+			continue
+		}
+		if res := call(ctx, step.Compensate); res.IsErr() {
+			errs = append(errs, fmt.Errorf("saga: compensate %q: %w", step.Name, res.Err()))
+		}
+	}
+	return errs
+}
+
+// call runs fn, turning a BubbleUp panic into an Err Result.
+func call(ctx context.Context, fn func(context.Context) result.Result[types.Unit]) (res result.Result[types.Unit]) {
+	defer result.Catch(&res)
+	return fn(ctx)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package saga_test. saga_test verifies reverse-order compensation, error aggregation and panics.
+package saga_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/saga"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+var (
+	ErrDeclined = errors.New("card declined")
+	ErrRelease  = errors.New("release failed")
+)
+
+// recorder builds steps that log their actions and compensations.
+type recorder struct{ log []string }
+
+func (r *recorder) step(name string, actionErr, compensateErr error) saga.Step {
+	return saga.Step{
+		Name: name,
+		Action: func(context.Context) result.Result[types.Unit] {
+			r.log = append(r.log, "do "+name)
+			if actionErr != nil {
+				return result.Err[types.Unit](actionErr)
+			}
+			return result.Ok(types.Unit{})
+		},
+		Compensate: func(context.Context) result.Result[types.Unit] {
+			r.log = append(r.log, "undo "+name)
+			if compensateErr != nil {
+				return result.Err[types.Unit](compensateErr)
+			}
+			return result.Ok(types.Unit{})
+		},
+	}
+}
+
+func TestRun_Success(t *testing.T) {
+	var r recorder
+	if res := saga.Run(context.Background(), r.step("a", nil, nil), r.step("b", nil, nil)); res.IsErr() {
+		t.Fatalf("expected Ok, got %v", res.Err())
+	}
+	if !slices.Equal(r.log, []string{"do a", "do b"}) {
+		t.Fatalf("unexpected log: %v", r.log)
+	}
+}
+
+func TestRun_CompensatesInReverse(t *testing.T) {
+	var r recorder
+	res := saga.Run(context.Background(),
+		r.step("reserve", nil, ErrRelease),
+		saga.Step{Name: "audit", Action: func(context.Context) result.Result[types.Unit] { return result.Ok(types.Unit{}) }},
+		r.step("hold", nil, nil),
+		r.step("charge", ErrDeclined, nil),
+		r.step("ship", nil, nil),
+	)
+
+	want := []string{"do reserve", "do hold", "do charge", "undo hold", "undo reserve"}
+	if !slices.Equal(r.log, want) {
+		t.Fatalf("expected %v, got %v", want, r.log)
+	}
+	var sagaErr *saga.Error
+	if !errors.As(res.Err(), &sagaErr) || sagaErr.Step != "charge" || len(sagaErr.CompensationErrs) != 1 {
+		t.Fatalf("unexpected error: %v", res.Err())
+	}
+	if !errors.Is(res.Err(), ErrDeclined) || !errors.Is(res.Err(), ErrRelease) {
+		t.Fatalf("expected the error to match both failures, got %v", res.Err())
+	}
+}
+
+func TestRun_BubbleUpAndPanic(t *testing.T) {
+	var r recorder
+	//goxide:bubbles
+	bubbling := saga.Step{Name: "bubble", Action: func(context.Context) result.Result[types.Unit] {
+		result.Err[int](ErrDeclined).BubbleUp()
+		return result.Ok(types.Unit{})
+	}}
+	if res := saga.Run(context.Background(), r.step("a", nil, nil), bubbling); !errors.Is(res.Err(), ErrDeclined) {
+		t.Fatalf("expected %v, got %v", ErrDeclined, res.Err())
+	}
+
+	r.log = nil
+	defer func() {
+		if p := recover(); p != "boom" || !slices.Equal(r.log, []string{"do a", "undo a"}) {
+			t.Fatalf("expected compensation before the panic, got %v with log %v", p, r.log)
+		}
+	}()
+	_ = saga.Run(context.Background(), r.step("a", nil, nil), saga.Step{Name: "panic", Action: func(context.Context) result.Result[types.Unit] {
+		panic("boom")
+	}})
+}
+
+func TestRun_CancelledContext(t *testing.T) {
+	var r recorder
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelling := saga.Step{Name: "cancel", Action: func(context.Context) result.Result[types.Unit] {
+		cancel()
+		return result.Ok(types.Unit{})
+	}, Compensate: func(ctx context.Context) result.Result[types.Unit] {
+		if ctx.Err() != nil {
+			t.Error("expected compensators to run with an uncancelled context")
+		}
+		return result.Ok(types.Unit{})
+	}}
+
+	res := saga.Run(ctx, cancelling, r.step("late", nil, nil))
+	if !errors.Is(res.Err(), context.Canceled) || len(r.log) != 0 {
+		t.Fatalf("expected the step after cancellation not to run, got %v with log %v", res.Err(), r.log)
+	}
+}
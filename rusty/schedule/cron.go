@@ -0,0 +1,188 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package schedule. cron provides Cron, which parses standard five-field cron expressions into a Schedule.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// cronSchedule is a parsed cron expression; each field is a bitset of the allowed values.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool // whether the day fields start with "*", for the day-of-month/day-of-week rule
+}
+
+// cronField describes the range of one field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrInvalidCron is wrapped by the Err of Cron for expressions it cannot parse.
+var ErrInvalidCron = errors.New("schedule: invalid cron expression")
+
+var cronFields = [5]cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7}, // 0 and 7 are both Sunday
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// maxCronYears bounds the search for the next matching time, so impossible dates such as "0 0 30 2 *"
+// end the Schedule instead of looping forever.
+const maxCronYears = 5
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Cron parses a five-field cron expression (minute, hour, day of month, month, day of week) into a Schedule
+// evaluated in the time zone of the times it is given. Fields accept "*", values, ranges "a-b", lists
+// "a,b" and steps "*/n" or "a-b/n"; day of week runs from 0 (Sunday) to 7 (Sunday again). As in classic
+// cron, when both day fields are restricted a day matching either one fires. The macros @yearly, @monthly,
+// @weekly, @daily and @hourly are accepted too.
+//
+// Example:
+//
+//	weekdays := schedule.Cron("30 9 * * 1-5").BubbleUp() // 09:30, Monday to Friday
+func Cron(expr string) result.Result[Schedule] {
+	spec := strings.TrimSpace(expr)
+	if macro, ok := cronMacros[spec]; ok {
+		spec = macro
+	}
+	parts := strings.Fields(spec)
+	if len(parts) != len(cronFields) {
+		return result.Err[Schedule](fmt.Errorf("%w %q: expected %d fields, got %d", ErrInvalidCron, expr, len(cronFields), len(parts)))
+	}
+
+	var bits [5]uint64
+	for i, part := range parts {
+		set, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return result.Err[Schedule](fmt.Errorf("%w %q: %w", ErrInvalidCron, expr, err))
+		}
+		bits[i] = set
+	}
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1 // 7 is Sunday
+	}
+	return result.Ok[Schedule](&cronSchedule{
+		minute: bits[0], hour: bits[1], dom: bits[2], month: bits[3], dow: bits[4],
+		domAny: strings.HasPrefix(parts[2], "*"), dowAny: strings.HasPrefix(parts[4], "*"),
+	})
+}
+
+// -------------------------------------------- cronSchedule Methods --------------------------------------------
+
+// Next returns the first minute strictly after after that matches the expression.
+func (c *cronSchedule) Next(after time.Time) time.Time {
+	// Truncate works on absolute time, so steps are built with time.Date to stay aligned with wall-clock
+	// minutes and hours in zones with fractional offsets (e.g. Asia/Tehran, +03:30).
+	t := time.Date(after.Year(), after.Month(), after.Day(), after.Hour(), after.Minute()+1, 0, 0, after.Location())
+	limit := t.AddDate(maxCronYears, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !has(c.month, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !has(c.hour, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !has(c.minute, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// dayMatches applies the cron day rule: both day fields must match unless both are restricted, in which
+// case either may.
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	dom, dow := has(c.dom, t.Day()), has(c.dow, int(t.Weekday()))
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// parseCronField parses one comma-separated field into a bitset of the values it allows.
+func parseCronField(field string, f cronField) (uint64, error) {
+	var set uint64
+	for item := range strings.SplitSeq(field, ",") {
+		lo, hi, step := f.min, f.max, 1
+		rng, stepText, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("%s: invalid step %q", f.name, stepText)
+			}
+			step = n
+		}
+
+		if rng != "*" {
+			loText, hiText, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = cronValue(loText, f); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = cronValue(hiText, f); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				hi = f.max // "a/n" means from a to the end of the range
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("%s: empty range %q", f.name, rng)
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+// cronValue parses a single value of field f.
+func cronValue(text string, f cronField) (int, error) {
+	v, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid value %q", f.name, text)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s: %d out of range %d-%d", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// has reports whether bit v is set.
+func has(set uint64, v int) bool {
+	return set&(1<<v) != 0
+}
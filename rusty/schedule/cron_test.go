@@ -0,0 +1,71 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package schedule_test. cron_test verifies cron parsing and next-run computation.
+package schedule_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/schedule"
+)
+
+func TestCron_Next(t *testing.T) {
+	// Wednesday, 15 January 2025
+	from := time.Date(2025, time.January, 15, 10, 7, 30, 0, time.UTC)
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2025, 1, 15, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, 1, 15, 10, 15, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2025, 1, 16, 3, 0, 0, 0, time.UTC)},
+		{"30 9 * * 1-5", time.Date(2025, 1, 16, 9, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1,15 * *", time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 1", time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)}, // either day field matches
+		{"@monthly", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, tt := range tests {
+		got := schedule.Cron(tt.expr).Unwrap().Next(from)
+		if !got.Equal(tt.want) {
+			t.Fatalf("%q: expected %v, got %v", tt.expr, tt.want, got)
+		}
+	}
+}
+
+func TestCron_NextHalfHourOffset(t *testing.T) {
+	// Truncating to the hour in absolute time is off by 30 minutes in these zones.
+	for _, loc := range []*time.Location{
+		time.FixedZone("Asia/Tehran", 3*3600+30*60),
+		time.FixedZone("Asia/Kolkata", 5*3600+30*60),
+	} {
+		from := time.Date(2025, time.January, 15, 8, 15, 0, 0, loc)
+		tests := []struct {
+			expr string
+			want time.Time
+		}{
+			{"0 9 * * *", time.Date(2025, 1, 15, 9, 0, 0, 0, loc)},
+			{"30 8 * * *", time.Date(2025, 1, 15, 8, 30, 0, 0, loc)},
+			{"0 8 * * *", time.Date(2025, 1, 16, 8, 0, 0, 0, loc)},
+		}
+		for _, tt := range tests {
+			got := schedule.Cron(tt.expr).Unwrap().Next(from)
+			if !got.Equal(tt.want) {
+				t.Fatalf("%s %q: expected %v, got %v", loc, tt.expr, tt.want, got)
+			}
+		}
+	}
+}
+
+func TestCron_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		if err := schedule.Cron(expr).Err(); !errors.Is(err, schedule.ErrInvalidCron) {
+			t.Fatalf("%q: expected %v, got %v", expr, schedule.ErrInvalidCron, err)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package schedule. schedule provides Job[T], which runs a Result-returning function on a Schedule (a fixed
+// interval or a cron expression), capturing panics and reporting every run to a hook or channel, for
+// background maintenance in long-running services.
+//
+// Example - Nightly cleanup and a frequent health probe:
+//
+//	cleanup := schedule.On(schedule.Cron("0 3 * * *").BubbleUp(), func(ctx context.Context) result.Result[int] {
+//	    return store.PurgeExpired(ctx)
+//	}).OnRun(func(run schedule.Run[int]) {
+//	    if run.Result.IsErr() {
+//	        log.Printf("cleanup failed: %v", run.Result.Err())
+//	    }
+//	})
+//	go cleanup.Run(ctx)
+//
+//	go schedule.Every(30*time.Second, probe).Run(ctx)
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/clock"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Schedule decides when a Job runs next.
+type Schedule interface {
+	// Next returns the first run time strictly after after, or the zero Time when no run remains.
+	Next(after time.Time) time.Time
+}
+
+// ScheduleFunc adapts a function to the Schedule interface.
+type ScheduleFunc func(after time.Time) time.Time
+
+// Job [T] runs a function on a Schedule. Configure it with OnRun, ReportTo and WithClock before calling Run.
+type Job[T any] struct {
+	schedule Schedule
+	fn       func(context.Context) result.Result[T]
+	hooks    []func(Run[T])
+	reports  []chan<- Run[T]
+	clock    clock.Clock
+}
+
+// Run [T] is the outcome of one run of a Job.
+type Run[T any] struct {
+	Started  time.Time
+	Finished time.Time
+	Result   result.Result[T]
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Next calls fn.
+func (fn ScheduleFunc) Next(after time.Time) time.Time {
+	return fn(after)
+}
+
+// Interval returns a Schedule firing d after the previous run finished, so runs never overlap and a slow
+// run delays the next one. d below 1ns is treated as 1ns.
+func Interval(d time.Duration) Schedule {
+	d = max(d, time.Nanosecond)
+	return ScheduleFunc(func(after time.Time) time.Time { return after.Add(d) })
+}
+
+// Every creates a Job running fn every d, measured from the end of the previous run.
+//
+// Example:
+//
+//	go schedule.Every(time.Minute, func(ctx context.Context) result.Result[types.Unit] {
+//	    return cache.Refresh(ctx)
+//	}).Run(ctx)
+func Every[T any](d time.Duration, fn func(context.Context) result.Result[T]) *Job[T] {
+	return On(Interval(d), fn)
+}
+
+// On creates a Job running fn at the times given by s.
+func On[T any](s Schedule, fn func(context.Context) result.Result[T]) *Job[T] {
+	return &Job[T]{schedule: s, fn: fn, clock: clock.System}
+}
+
+// OnRun registers a hook called after every run with its outcome, in the Job's goroutine.
+func (j *Job[T]) OnRun(hook func(Run[T])) *Job[T] {
+	j.hooks = append(j.hooks, hook)
+	return j
+}
+
+// ReportTo sends the outcome of every run on ch. A run is dropped rather than delaying the Job when ch is
+// not ready to receive, so give ch a buffer if every run matters.
+func (j *Job[T]) ReportTo(ch chan<- Run[T]) *Job[T] {
+	j.reports = append(j.reports, ch)
+	return j
+}
+
+// WithClock sets the clock the Job waits on (default: clock.System), e.g. a clocktest.FakeClock to test
+// schedules without sleeping.
+func (j *Job[T]) WithClock(clk clock.Clock) *Job[T] {
+	j.clock = clk
+	return j
+}
+
+// Run runs the Job until ctx is done, returning ctx.Err(), or until the Schedule has no further run,
+// returning nil. Runs happen one at a time in the calling goroutine and receive ctx. A BubbleUp inside fn
+// becomes the run's Err and any other panic an Err wrapping result.ErrPanic, so a failing run never stops
+// the Job.
+func (j *Job[T]) Run(ctx context.Context) error {
+	for {
+		now := j.clock.Now()
+		next := j.schedule.Next(now)
+		if next.IsZero() {
+			return nil
+		}
+
+		timer := j.clock.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C():
+		}
+		j.report(j.runOnce(ctx))
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// runOnce calls fn and records its outcome.
+func (j *Job[T]) runOnce(ctx context.Context) Run[T] {
+	run := Run[T]{Started: j.clock.Now()}
+	run.Result = <-result.SafeGo(func() result.Result[T] { return j.fn(ctx) })
+	run.Finished = j.clock.Now()
+	return run
+}
+
+// report hands run to the hooks and report channels.
+func (j *Job[T]) report(run Run[T]) {
+	for _, hook := range j.hooks {
+		hook(run)
+	}
+	for _, ch := range j.reports {
+		select {
+		case ch <- run:
+		default:
+		}
+	}
+}
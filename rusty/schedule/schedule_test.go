@@ -0,0 +1,76 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package schedule_test. schedule_test verifies job timing, run reporting, panic capture and stopping.
+package schedule_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/clock/clocktest"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/schedule"
+)
+
+var ErrJob = errors.New("job failed")
+
+func TestJob_Every(t *testing.T) {
+	clk := clocktest.NewFakeClock(time.Now())
+	runs := make(chan schedule.Run[int], 3)
+	count := 0
+	job := schedule.Every(time.Minute, func(context.Context) result.Result[int] {
+		count++
+		switch count {
+		case 2:
+			return result.Err[int](ErrJob)
+		case 3:
+			panic("boom")
+		}
+		return result.Ok(count)
+	}).ReportTo(runs).WithClock(clk)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- job.Run(ctx) }()
+
+	var got []schedule.Run[int]
+	for range 3 {
+		clk.BlockUntil(1)
+		clk.Advance(time.Minute)
+		got = append(got, <-runs)
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+
+	if got[0].Result.Unwrap() != 1 || !errors.Is(got[1].Result.Err(), ErrJob) || !errors.Is(got[2].Result.Err(), result.ErrPanic) {
+		t.Fatalf("unexpected runs: %v", got)
+	}
+	if got[0].Started.IsZero() || got[0].Finished.Before(got[0].Started) {
+		t.Fatalf("unexpected run times: %v", got[0])
+	}
+}
+
+func TestJob_EndsWithSchedule(t *testing.T) {
+	fired := false
+	once := schedule.ScheduleFunc(func(after time.Time) time.Time {
+		if fired {
+			return time.Time{}
+		}
+		fired = true
+		return after
+	})
+
+	var runs []schedule.Run[string]
+	err := schedule.On(once, func(context.Context) result.Result[string] {
+		return result.Ok("done")
+	}).OnRun(func(run schedule.Run[string]) { runs = append(runs, run) }).Run(context.Background())
+
+	if err != nil || len(runs) != 1 || runs[0].Result.Unwrap() != "done" {
+		t.Fatalf("expected a single run and nil, got %v after %v", err, runs)
+	}
+}
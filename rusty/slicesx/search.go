@@ -0,0 +1,48 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package slicesx. search provides binary search over sorted slices that reports a missing element as None
+// instead of through the (index, found) pair of slices.BinarySearch.
+//
+// Example - Traditional vs slicesx:
+//
+//	// Traditional Go
+//	i, found := slices.BinarySearch(ids, id)
+//	if !found {
+//	    return option.None[User]()
+//	}
+//	return option.Some(users[i])
+//
+//	// With slicesx
+//	return option.Map(slicesx.BinarySearch(ids, id), func(i int) User { return users[i] })
+package slicesx
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// BinarySearch returns the index of v in s, which must be sorted in increasing order, or None if v is not
+// present. When v occurs more than once, the index of the first occurrence is returned.
+func BinarySearch[T cmp.Ordered](s []T, v T) option.Option[int] {
+	if i, found := slices.BinarySearch(s, v); found {
+		return option.Some(i)
+	}
+	return option.None[int]()
+}
+
+// PartitionPoint returns the index of the first element of s for which pred is false, assuming s is
+// partitioned so that pred holds for a prefix of s and not for the rest. It is len(s) if pred holds for
+// every element, and the insertion point that keeps s partitioned otherwise.
+//
+// Example - Counting entries before a cutoff:
+//
+//	recent := events[slicesx.PartitionPoint(events, func(e Event) bool { return e.At.Before(cutoff) }):]
+func PartitionPoint[T any](s []T, pred func(T) bool) int {
+	return sort.Search(len(s), func(i int) bool { return !pred(s[i]) })
+}
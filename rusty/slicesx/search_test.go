@@ -0,0 +1,43 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package slicesx_test. search_test verifies binary search and partition points.
+package slicesx_test
+
+import (
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/slicesx"
+)
+
+func TestBinarySearch(t *testing.T) {
+	s := []int{1, 3, 3, 5, 8}
+	if got := slicesx.BinarySearch(s, 3); got.Unwrap() != 1 {
+		t.Fatalf("expected %v, got %v", 1, got)
+	}
+	if got := slicesx.BinarySearch(s, 8); got.Unwrap() != 4 {
+		t.Fatalf("expected %v, got %v", 4, got)
+	}
+	for _, missing := range []int{0, 4, 9} {
+		if got := slicesx.BinarySearch(s, missing); got.IsSome() {
+			t.Fatalf("expected None for %v, got %v", missing, got)
+		}
+	}
+	if slicesx.BinarySearch([]string(nil), "a").IsSome() {
+		t.Fatal("expected None on an empty slice")
+	}
+}
+
+func TestPartitionPoint(t *testing.T) {
+	s := []int{2, 4, 6, 7, 9}
+	even := func(x int) bool { return x%2 == 0 }
+	if got := slicesx.PartitionPoint(s, even); got != 3 {
+		t.Fatalf("expected %v, got %v", 3, got)
+	}
+	if got := slicesx.PartitionPoint(s, func(int) bool { return true }); got != len(s) {
+		t.Fatalf("expected %v, got %v", len(s), got)
+	}
+	if got := slicesx.PartitionPoint(s, func(int) bool { return false }); got != 0 {
+		t.Fatalf("expected %v, got %v", 0, got)
+	}
+}
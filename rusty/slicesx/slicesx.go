@@ -0,0 +1,116 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package slicesx. slicesx provides free functions over plain slices with Rust's iterator vocabulary
+// (find, position, windows, chunks, dedup, group by, try-map), returning Option and Result instead of
+// sentinel indexes and (value, ok) pairs, for code that wants these helpers without a wrapper type such as
+// collections.Vec.
+//
+// Example - Traditional vs slicesx:
+//
+//	// Traditional Go
+//	i := slices.IndexFunc(users, func(u User) bool { return u.Email == email })
+//	if i < 0 {
+//	    return result.Err[User](ErrNotFound)
+//	}
+//	return result.Ok(users[i])
+//
+//	// With slicesx
+//	admin := slicesx.Find(users, func(u User) bool { return u.Email == email }) // Option[User]
+package slicesx
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Find returns the first element of s satisfying pred, or None.
+func Find[T any](s []T, pred func(T) bool) option.Option[T] {
+	if i := slices.IndexFunc(s, pred); i >= 0 {
+		return option.Some(s[i])
+	}
+	return option.None[T]()
+}
+
+// Position returns the index of the first element of s satisfying pred, or None.
+func Position[T any](s []T, pred func(T) bool) option.Option[int] {
+	if i := slices.IndexFunc(s, pred); i >= 0 {
+		return option.Some(i)
+	}
+	return option.None[int]()
+}
+
+// Windows returns an iterator over every contiguous subslice of s of length size, overlapping and in
+// order. It yields nothing if s is shorter than size. The subslices share s's backing array.
+// size below 1 is treated as 1.
+//
+// Example - Differences between consecutive readings:
+//
+//	for w := range slicesx.Windows(readings, 2) {
+//	    deltas = append(deltas, w[1]-w[0])
+//	}
+func Windows[T any](s []T, size int) iter.Seq[[]T] {
+	size = max(size, 1)
+	return func(yield func([]T) bool) {
+		for i := 0; i+size <= len(s); i++ {
+			if !yield(s[i : i+size : i+size]) {
+				return
+			}
+		}
+	}
+}
+
+// Chunks returns an iterator over consecutive, non-overlapping subslices of s of length size; the last may be
+// shorter. The subslices share s's backing array. size below 1 is treated as 1.
+//
+// Example - Inserting rows in batches:
+//
+//	for batch := range slicesx.Chunks(rows, 500) {
+//	    store.InsertMany(ctx, batch).BubbleUp()
+//	}
+func Chunks[T any](s []T, size int) iter.Seq[[]T] {
+	return slices.Chunk(s, max(size, 1))
+}
+
+// Dedup returns a copy of s with consecutive equal elements collapsed into one, as Rust's Vec::dedup does.
+// Sort s first to remove every duplicate. s is left unchanged.
+func Dedup[T comparable](s []T) []T {
+	return slices.Compact(slices.Clone(s))
+}
+
+// GroupBy groups the elements of s by the key fn returns for them, keeping their order within each group.
+//
+// Example:
+//
+//	byStatus := slicesx.GroupBy(orders, func(o Order) Status { return o.Status })
+func GroupBy[T any, K comparable](s []T, fn func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, x := range s {
+		k := fn(x)
+		groups[k] = append(groups[k], x)
+	}
+	return groups
+}
+
+// TryMap applies fn to every element of s in order and returns the mapped slice, or the first Err, in which
+// case fn is not called for the remaining elements.
+//
+// Example:
+//
+//	ids := slicesx.TryMap(r.URL.Query()["id"], parse.Int[int64]).BubbleUp()
+func TryMap[T, U any](s []T, fn func(T) result.Result[U]) result.Result[[]U] {
+	out := make([]U, 0, len(s))
+	for _, x := range s {
+		res := fn(x)
+		if res.IsErr() {
+			return result.Err[[]U](res.Err())
+		}
+		out = append(out, res.Unwrap())
+	}
+	return result.Ok(out)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package slicesx_test. slicesx_test verifies the Option- and Result-returning slice helpers.
+package slicesx_test
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/slicesx"
+)
+
+func TestFindAndPosition(t *testing.T) {
+	s := []string{"go", "rust", "zig"}
+	long := func(x string) bool { return len(x) > 2 }
+	if got := slicesx.Find(s, long); got.Unwrap() != "rust" {
+		t.Fatalf("expected %v, got %v", "rust", got)
+	}
+	if got := slicesx.Position(s, long); got.Unwrap() != 1 {
+		t.Fatalf("expected %v, got %v", 1, got)
+	}
+	none := func(x string) bool { return x == "c" }
+	if slicesx.Find(s, none).IsSome() || slicesx.Position(s, none).IsSome() {
+		t.Fatal("expected None when nothing matches")
+	}
+}
+
+func TestWindowsAndChunks(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	var windows [][]int
+	for w := range slicesx.Windows(s, 3) {
+		windows = append(windows, w)
+	}
+	if len(windows) != 3 || !slices.Equal(windows[0], []int{1, 2, 3}) || !slices.Equal(windows[2], []int{3, 4, 5}) {
+		t.Fatalf("unexpected windows: %v", windows)
+	}
+	for range slicesx.Windows(s, 6) {
+		t.Fatal("expected no window longer than the slice")
+	}
+
+	var chunks [][]int
+	for c := range slicesx.Chunks(s, 2) {
+		chunks = append(chunks, c)
+	}
+	if len(chunks) != 3 || !slices.Equal(chunks[2], []int{5}) {
+		t.Fatalf("unexpected chunks: %v", chunks)
+	}
+}
+
+func TestDedupAndGroupBy(t *testing.T) {
+	s := []int{1, 1, 2, 3, 3, 3, 1}
+	if got := slicesx.Dedup(s); !slices.Equal(got, []int{1, 2, 3, 1}) {
+		t.Fatalf("unexpected dedup: %v", got)
+	}
+	if !slices.Equal(s, []int{1, 1, 2, 3, 3, 3, 1}) {
+		t.Fatal("expected Dedup to leave its input unchanged")
+	}
+
+	groups := slicesx.GroupBy([]string{"ant", "bee", "ape", "cat"}, func(x string) byte { return x[0] })
+	if len(groups) != 3 || !slices.Equal(groups['a'], []string{"ant", "ape"}) {
+		t.Fatalf("unexpected groups: %v", groups)
+	}
+}
+
+func TestTryMap(t *testing.T) {
+	atoi := func(x string) result.Result[int] { return result.Wrap(strconv.Atoi(x)) }
+	if got := slicesx.TryMap([]string{"1", "2"}, atoi).Unwrap(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("unexpected values: %v", got)
+	}
+
+	calls := 0
+	failed := slicesx.TryMap([]string{"1", "x", "3"}, func(x string) result.Result[int] {
+		calls++
+		return atoi(x)
+	})
+	if !errors.Is(failed.Err(), strconv.ErrSyntax) || calls != 2 {
+		t.Fatalf("expected to stop at the first Err, got %v after %v calls", failed.Err(), calls)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package statemachine. statemachine provides Machine[S, E], a typed state machine whose transitions are
+// Result-returning functions guarded by predicates and surrounded by exit and entry hooks, giving status
+// flows such as orders or payments a single, declarative home.
+//
+// A Machine holds no current state: Fire computes the next state from a given one, so the state can live
+// wherever the entity is stored.
+//
+// Example - An order flow:
+//
+//	orders := statemachine.New[Status, Event]()
+//	orders.Permit(Pending, Cancel, Cancelled)
+//	orders.On(Pending, Pay, func(ctx context.Context, s Status, e Event) result.Result[Status] {
+//	    payments.Capture(ctx, order).BubbleUp()
+//	    return result.Ok(Paid)
+//	}, func(_ context.Context, _ Status, _ Event) bool { return order.Total > 0 })
+//	orders.OnEnter(Paid, func(ctx context.Context, from, to Status, e Event) result.Result[types.Unit] {
+//	    return mailer.SendReceipt(ctx, order)
+//	})
+//
+//	order.Status = orders.Fire(ctx, order.Status, Pay).BubbleUp()
+package statemachine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Transition [S, E] computes the state reached from a state on an event.
+type Transition[S, E comparable] func(ctx context.Context, state S, event E) result.Result[S]
+
+// Guard [S, E] reports whether a transition may be taken from state on event.
+type Guard[S, E comparable] func(ctx context.Context, state S, event E) bool
+
+// Hook [S, E] runs when a transition leaves or enters a state. An Err aborts the transition.
+type Hook[S, E comparable] func(ctx context.Context, from, to S, event E) result.Result[types.Unit]
+
+// Machine [S, E] maps (state, event) pairs to transitions. Configure it before use; Fire is then safe for
+// concurrent use.
+type Machine[S, E comparable] struct {
+	rules   map[trigger[S, E]]rule[S, E]
+	onExit  map[S][]Hook[S, E]
+	onEnter map[S][]Hook[S, E]
+}
+
+// trigger is a state paired with an event.
+type trigger[S, E comparable] struct {
+	state S
+	event E
+}
+
+// rule is a transition with its guards.
+type rule[S, E comparable] struct {
+	fn     Transition[S, E]
+	guards []Guard[S, E]
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrInvalidTransition is wrapped by the Err of Fire when no transition is defined for the state and event,
+// or when a guard rejects it.
+var ErrInvalidTransition = errors.New("statemachine: invalid transition")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// New creates a Machine without transitions.
+func New[S, E comparable]() *Machine[S, E] {
+	return &Machine[S, E]{
+		rules:   make(map[trigger[S, E]]rule[S, E]),
+		onExit:  make(map[S][]Hook[S, E]),
+		onEnter: make(map[S][]Hook[S, E]),
+	}
+}
+
+// On defines the transition taken from state on event, allowed only when every guard passes.
+// Defining the same state and event again replaces the previous transition.
+func (m *Machine[S, E]) On(state S, event E, fn Transition[S, E], guards ...Guard[S, E]) *Machine[S, E] {
+	m.rules[trigger[S, E]{state, event}] = rule[S, E]{fn: fn, guards: guards}
+	return m
+}
+
+// Permit defines a transition from state to target on event that needs no work beyond its hooks.
+//
+// Example:
+//
+//	orders.Permit(Pending, Cancel, Cancelled).Permit(Paid, Ship, Shipped)
+func (m *Machine[S, E]) Permit(state S, event E, target S, guards ...Guard[S, E]) *Machine[S, E] {
+	return m.On(state, event, func(context.Context, S, E) result.Result[S] { return result.Ok(target) }, guards...)
+}
+
+// OnExit registers a hook run, in registration order, when a transition leaves state for another state.
+func (m *Machine[S, E]) OnExit(state S, hook Hook[S, E]) *Machine[S, E] {
+	m.onExit[state] = append(m.onExit[state], hook)
+	return m
+}
+
+// OnEnter registers a hook run, in registration order, when a transition enters state from another state.
+func (m *Machine[S, E]) OnEnter(state S, hook Hook[S, E]) *Machine[S, E] {
+	m.onEnter[state] = append(m.onEnter[state], hook)
+	return m
+}
+
+// Can reports whether a transition is defined from state on event. Guards are not evaluated.
+func (m *Machine[S, E]) Can(state S, event E) bool {
+	_, ok := m.rules[trigger[S, E]{state, event}]
+	return ok
+}
+
+// Fire applies event to state and returns the new state. It checks the guards, runs the transition, then
+// the exit hooks of state and the entry hooks of the new state; hooks are skipped when the state does not
+// change. The first Err of any of them is returned, and a BubbleUp inside them becomes that Err. Fire
+// returns an Err wrapping ErrInvalidTransition if no transition is defined or a guard rejects it.
+//
+// Example:
+//
+//	next := orders.Fire(ctx, order.Status, Ship)
+//	if errors.Is(next.Err(), statemachine.ErrInvalidTransition) {
+//	    return result.Err[Order](ErrNotShippable)
+//	}
+func (m *Machine[S, E]) Fire(ctx context.Context, state S, event E) (res result.Result[S]) {
+	defer result.Catch(&res)
+
+	r, ok := m.rules[trigger[S, E]{state, event}]
+	if !ok {
+		return result.Err[S](fmt.Errorf("%w: no transition from %v on %v", ErrInvalidTransition, state, event))
+	}
+	for _, guard := range r.guards {
+		if !guard(ctx, state, event) {
+			return result.Err[S](fmt.Errorf("%w: transition from %v on %v rejected by guard", ErrInvalidTransition, state, event))
+		}
+	}
+
+	next := r.fn(ctx, state, event).BubbleUp()
+	if next != state {
+		for _, hook := range m.onExit[state] {
+			hook(ctx, state, next, event).BubbleUp()
+		}
+		for _, hook := range m.onEnter[next] {
+			hook(ctx, state, next, event).BubbleUp()
+		}
+	}
+	return result.Ok(next)
+}
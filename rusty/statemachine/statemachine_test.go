@@ -0,0 +1,96 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package statemachine_test. statemachine_test verifies transitions, guards, hooks and invalid transitions.
+package statemachine_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/statemachine"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+type (
+	Status string
+	Event  string
+)
+
+const (
+	Pending   Status = "pending"
+	Paid      Status = "paid"
+	Cancelled Status = "cancelled"
+
+	Pay    Event = "pay"
+	Cancel Event = "cancel"
+	Remind Event = "remind"
+)
+
+var ErrCaptureFailed = errors.New("capture failed")
+
+func TestMachine_TransitionsAndHooks(t *testing.T) {
+	var log []string
+	hook := func(name string) statemachine.Hook[Status, Event] {
+		return func(_ context.Context, from, to Status, e Event) result.Result[types.Unit] {
+			log = append(log, name+" "+string(from)+"->"+string(to))
+			return result.Ok(types.Unit{})
+		}
+	}
+	m := statemachine.New[Status, Event]().
+		Permit(Pending, Pay, Paid).
+		Permit(Pending, Remind, Pending).
+		OnExit(Pending, hook("exit")).
+		OnEnter(Paid, hook("enter"))
+	ctx := context.Background()
+
+	if got := m.Fire(ctx, Pending, Remind).Unwrap(); got != Pending || len(log) != 0 {
+		t.Fatalf("expected a self transition without hooks, got %v with log %v", got, log)
+	}
+	if got := m.Fire(ctx, Pending, Pay).Unwrap(); got != Paid {
+		t.Fatalf("expected %v, got %v", Paid, got)
+	}
+	if want := []string{"exit pending->paid", "enter pending->paid"}; !slices.Equal(log, want) {
+		t.Fatalf("expected %v, got %v", want, log)
+	}
+
+	if err := m.Fire(ctx, Paid, Pay).Err(); !errors.Is(err, statemachine.ErrInvalidTransition) {
+		t.Fatalf("expected %v, got %v", statemachine.ErrInvalidTransition, err)
+	}
+	if !m.Can(Pending, Pay) || m.Can(Paid, Cancel) {
+		t.Fatal("unexpected Can result")
+	}
+}
+
+func TestMachine_GuardsAndErrors(t *testing.T) {
+	allowed := false
+	m := statemachine.New[Status, Event]().
+		Permit(Pending, Cancel, Cancelled, func(context.Context, Status, Event) bool { return allowed }).
+		On(Pending, Pay, func(context.Context, Status, Event) result.Result[Status] {
+			result.Err[string](ErrCaptureFailed).BubbleUp()
+			return result.Ok(Paid)
+		})
+	ctx := context.Background()
+
+	if err := m.Fire(ctx, Pending, Cancel).Err(); !errors.Is(err, statemachine.ErrInvalidTransition) {
+		t.Fatalf("expected a guard rejection, got %v", err)
+	}
+	allowed = true
+	if got := m.Fire(ctx, Pending, Cancel).Unwrap(); got != Cancelled {
+		t.Fatalf("expected %v, got %v", Cancelled, got)
+	}
+
+	if err := m.Fire(ctx, Pending, Pay).Err(); !errors.Is(err, ErrCaptureFailed) {
+		t.Fatalf("expected %v, got %v", ErrCaptureFailed, err)
+	}
+
+	m.OnEnter(Cancelled, func(context.Context, Status, Status, Event) result.Result[types.Unit] {
+		return result.Err[types.Unit](ErrCaptureFailed)
+	})
+	if err := m.Fire(ctx, Pending, Cancel).Err(); !errors.Is(err, ErrCaptureFailed) {
+		t.Fatalf("expected a failing hook to abort the transition, got %v", err)
+	}
+}
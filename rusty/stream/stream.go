@@ -0,0 +1,320 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package stream. stream provides concurrent processing pipelines — Source → Map/Filter/Batch → Sink —
+// whose stages are connected by channels of Results. It is the concurrent counterpart to chain: an Err
+// emitted by any stage flows through later stages untouched, and sinks stop the whole pipeline on the
+// first Err or when the context is cancelled, without leaking goroutines.
+//
+// Example - Traditional vs stream:
+//
+//	// Traditional Go: hand-wired goroutines, channels, WaitGroups and an error channel
+//
+//	// With stream
+//	users := stream.From(ctx, slices.Values(ids))
+//	enriched := stream.Map(users, fetchUser, stream.Workers(8))
+//	active := stream.Filter(enriched, User.IsActive)
+//	res := stream.ForEach(stream.Batch(active, 100), func(batch []User) result.Result[types.Unit] {
+//	    return repo.UpsertAll(ctx, batch)
+//	})
+package stream
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Stream [T] is a running pipeline stage producing Results of T.
+// Every stage derived from the same source shares its cancellation.
+type Stream[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	ch     <-chan result.Result[T]
+}
+
+// Option configures a stage.
+type Option func(*stageConfig)
+
+// stageConfig holds the tunables of a single stage.
+type stageConfig struct {
+	workers   int
+	unordered bool
+	buffer    int
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Workers runs the stage function on n goroutines (default: 1).
+func Workers(n int) Option {
+	return func(c *stageConfig) {
+		c.workers = max(n, 1)
+	}
+}
+
+// Unordered lets a multi-worker stage emit results in completion order instead of input order,
+// trading ordering for throughput when items take uneven time.
+func Unordered() Option {
+	return func(c *stageConfig) {
+		c.unordered = true
+	}
+}
+
+// Buffer sets the capacity of the stage's output channel (default: 0).
+func Buffer(n int) Option {
+	return func(c *stageConfig) {
+		c.buffer = max(n, 0)
+	}
+}
+
+// From starts a Stream emitting every value of seq as Ok. Cancelling ctx stops the whole pipeline.
+func From[T any](ctx context.Context, seq iter.Seq[T]) Stream[T] {
+	return source(ctx, func(context.Context) iter.Seq[T] { return seq })
+}
+
+// FromChan starts a Stream emitting every value received from ch as Ok, until ch is closed.
+// The receiving goroutine exits as soon as the pipeline stops, even if ch is never closed.
+func FromChan[T any](ctx context.Context, ch <-chan T) Stream[T] {
+	return source(ctx, func(ctx context.Context) iter.Seq[T] {
+		return func(yield func(T) bool) {
+			for {
+				select {
+				case v, ok := <-ch:
+					if !ok || !yield(v) {
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	})
+}
+
+// Map applies fn to every Ok value of s. Errs from upstream are forwarded without calling fn.
+// With Workers(n) up to n calls run concurrently; output stays in input order unless Unordered is given.
+//
+// Example:
+//
+//	thumbs := stream.Map(images, func(ctx context.Context, img Image) result.Result[Thumb] {
+//	    return resize(ctx, img, 128)
+//	}, stream.Workers(runtime.NumCPU()), stream.Unordered())
+func Map[T, U any](s Stream[T], fn func(context.Context, T) result.Result[U], opts ...Option) Stream[U] {
+	cfg := stageConfig{workers: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	out := make(chan result.Result[U], cfg.buffer)
+	apply := func(r result.Result[T]) result.Result[U] {
+		if r.IsErr() {
+			return result.Err[U](r.Err())
+		}
+		if err := s.ctx.Err(); err != nil {
+			return result.Err[U](err)
+		}
+		return fn(s.ctx, r.Unwrap())
+	}
+
+	switch {
+	case cfg.workers == 1:
+		go func() {
+			defer close(out)
+			for r := range s.ch {
+				if !send(s.ctx, out, apply(r)) {
+					return
+				}
+			}
+		}()
+	case cfg.unordered:
+		go mapUnordered(s, out, cfg.workers, apply)
+	default:
+		go mapOrdered(s, out, cfg.workers, apply)
+	}
+	return Stream[U]{ctx: s.ctx, cancel: s.cancel, ch: out}
+}
+
+// Filter keeps the Ok values for which keep returns true. Errs are always forwarded.
+func Filter[T any](s Stream[T], keep func(T) bool) Stream[T] {
+	out := make(chan result.Result[T])
+	go func() {
+		defer close(out)
+		for r := range s.ch {
+			if r.IsOk() && !keep(r.Unwrap()) {
+				continue
+			}
+			if !send(s.ctx, out, r) {
+				return
+			}
+		}
+	}()
+	return Stream[T]{ctx: s.ctx, cancel: s.cancel, ch: out}
+}
+
+// Batch groups Ok values into slices of up to size elements; the last batch may be shorter.
+// An Err is forwarded as soon as it arrives, ahead of the batch being filled.
+func Batch[T any](s Stream[T], size int) Stream[[]T] {
+	size = max(size, 1)
+	out := make(chan result.Result[[]T])
+	go func() {
+		defer close(out)
+		batch := make([]T, 0, size)
+		for r := range s.ch {
+			if r.IsErr() {
+				if !send(s.ctx, out, result.Err[[]T](r.Err())) {
+					return
+				}
+				continue
+			}
+			batch = append(batch, r.Unwrap())
+			if len(batch) == size {
+				if !send(s.ctx, out, result.Ok(batch)) {
+					return
+				}
+				batch = make([]T, 0, size)
+			}
+		}
+		if len(batch) > 0 {
+			send(s.ctx, out, result.Ok(batch))
+		}
+	}()
+	return Stream[[]T]{ctx: s.ctx, cancel: s.cancel, ch: out}
+}
+
+// Collect drains s into a slice. It returns the first Err (stopping the pipeline), or Err(ctx.Err())
+// if the pipeline's context was cancelled before the source was exhausted.
+func Collect[T any](s Stream[T]) result.Result[[]T] {
+	var items []T
+	res := ForEach(s, func(v T) result.Result[types.Unit] {
+		items = append(items, v)
+		return result.Ok(types.Unit{})
+	})
+	if res.IsErr() {
+		return result.Err[[]T](res.Err())
+	}
+	return result.Ok(items)
+}
+
+// ForEach calls fn for every Ok value of s. It stops the pipeline and returns the first Err, whether
+// emitted upstream or returned by fn, or Err(ctx.Err()) if the pipeline's context was cancelled.
+//
+// Example:
+//
+//	res := stream.ForEach(events, func(e Event) result.Result[types.Unit] {
+//	    return publisher.Publish(ctx, e)
+//	})
+func ForEach[T any](s Stream[T], fn func(T) result.Result[types.Unit]) result.Result[types.Unit] {
+	defer s.cancel()
+	for r := range s.ch {
+		if r.IsErr() {
+			return result.Err[types.Unit](r.Err())
+		}
+		if res := fn(r.Unwrap()); res.IsErr() {
+			return res
+		}
+	}
+	if err := s.ctx.Err(); err != nil {
+		return result.Err[types.Unit](err)
+	}
+	return result.Ok(types.Unit{})
+}
+
+// Results returns an iterator over every Result of s, including Errs, for callers that want to handle
+// failures item by item. Breaking out of the loop stops the pipeline. If the pipeline's context is
+// cancelled, the final item is Err(ctx.Err()).
+func Results[T any](s Stream[T]) iter.Seq[result.Result[T]] {
+	return func(yield func(result.Result[T]) bool) {
+		defer s.cancel()
+		for r := range s.ch {
+			if !yield(r) {
+				return
+			}
+		}
+		if err := s.ctx.Err(); err != nil {
+			yield(result.Err[T](err))
+		}
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// source starts the producing goroutine of a Stream. seq receives the stream's own context, which is
+// cancelled when a sink stops early, so a source blocked outside of send can still observe it.
+func source[T any](ctx context.Context, seq func(context.Context) iter.Seq[T]) Stream[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	out := make(chan result.Result[T])
+	go func() {
+		defer close(out)
+		for v := range seq(ctx) {
+			if !send(ctx, out, result.Ok(v)) {
+				return
+			}
+		}
+	}()
+	return Stream[T]{ctx: ctx, cancel: cancel, ch: out}
+}
+
+// send delivers v on out unless ctx is done first, reporting whether it was delivered.
+func send[T any](ctx context.Context, out chan<- T, v T) bool {
+	select {
+	case out <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// mapUnordered runs apply on workers goroutines, emitting results as they complete.
+func mapUnordered[T, U any](s Stream[T], out chan<- result.Result[U], workers int, apply func(result.Result[T]) result.Result[U]) {
+	defer close(out)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for r := range s.ch {
+				if !send(s.ctx, out, apply(r)) {
+					return
+				}
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// mapOrdered runs apply on up to workers goroutines while emitting results in input order:
+// each item gets a single-use future, queued in input order and awaited by the emitter.
+func mapOrdered[T, U any](s Stream[T], out chan<- result.Result[U], workers int, apply func(result.Result[T]) result.Result[U]) {
+	defer close(out)
+	futures := make(chan chan result.Result[U], workers)
+	slots := make(chan struct{}, workers)
+
+	go func() {
+		defer close(futures)
+		for r := range s.ch {
+			future := make(chan result.Result[U], 1)
+			if !send(s.ctx, futures, future) || !send(s.ctx, slots, struct{}{}) {
+				return
+			}
+			go func() {
+				defer func() { <-slots }()
+				future <- apply(r)
+			}()
+		}
+	}()
+
+	for future := range futures {
+		var r result.Result[U]
+		select {
+		case r = <-future:
+		case <-s.ctx.Done():
+			return
+		}
+		if !send(s.ctx, out, r) {
+			return
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package stream_test. stream_test verifies stage wiring, ordering modes, error propagation and cancellation.
+package stream_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/stream"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+var ErrOdd = errors.New("odd value")
+
+func square(_ context.Context, n int) result.Result[int] {
+	time.Sleep(time.Duration(10-n%10) * time.Millisecond) // later items finish first
+	return result.Ok(n * n)
+}
+
+func isEven(n int) bool { return n%2 == 0 }
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestStream_OrderedParallelMap(t *testing.T) {
+	s := stream.From(context.Background(), slices.Values([]int{1, 2, 3, 4, 5, 6}))
+	got := stream.Collect(stream.Filter(stream.Map(s, square, stream.Workers(4)), isEven)).Unwrap()
+
+	if want := []int{4, 16, 36}; !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestStream_UnorderedMapKeepsEveryItem(t *testing.T) {
+	s := stream.From(context.Background(), slices.Values([]int{1, 2, 3, 4, 5, 6, 7, 8}))
+	got := stream.Collect(stream.Map(s, square, stream.Workers(8), stream.Unordered())).Unwrap()
+
+	slices.Sort(got)
+	if want := []int{1, 4, 9, 16, 25, 36, 49, 64}; !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestStream_ErrStopsPipeline(t *testing.T) {
+	var calls atomic.Int32
+	s := stream.From(context.Background(), slices.Values([]int{2, 4, 5, 6, 8}))
+	mapped := stream.Map(s, func(_ context.Context, n int) result.Result[int] {
+		calls.Add(1)
+		if n%2 == 1 {
+			return result.Err[int](ErrOdd)
+		}
+		return result.Ok(n)
+	})
+
+	res := stream.Collect(mapped)
+	if !errors.Is(res.Err(), ErrOdd) {
+		t.Fatalf("expected %v, got %v", ErrOdd, res.Err())
+	}
+	if n := calls.Load(); n > 4 {
+		t.Fatalf("expected pipeline to stop early, got %d calls", n)
+	}
+}
+
+func TestStream_BatchForwardsErrors(t *testing.T) {
+	s := stream.From(context.Background(), slices.Values([]int{1, 2, 3, 4, 5}))
+	var batches [][]int
+	for r := range stream.Results(stream.Batch(s, 2)) {
+		batches = append(batches, r.Unwrap())
+	}
+
+	if len(batches) != 3 || !slices.Equal(batches[2], []int{5}) {
+		t.Fatalf("unexpected batches: %v", batches)
+	}
+}
+
+func TestStream_Cancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	source := make(chan int)
+	go func() {
+		source <- 1
+		cancel()
+	}()
+
+	res := stream.Collect(stream.FromChan(ctx, source))
+	if !errors.Is(res.Err(), context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, res.Err())
+	}
+}
+
+func TestStream_FromChanStopsWhenSinkStopsEarly(t *testing.T) {
+	before := runtime.NumGoroutine()
+	source := make(chan int) // never closed
+	go func() { source <- 1 }()
+
+	for r := range stream.Results(stream.FromChan(context.Background(), source)) {
+		if r.Unwrap() != 1 {
+			t.Fatalf("expected %v, got %v", 1, r.Unwrap())
+		}
+		break
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the FromChan goroutine to exit, %d goroutines still running", runtime.NumGoroutine()-before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
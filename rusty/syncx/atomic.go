@@ -0,0 +1,151 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx. atomic provides Atomic[T], a typed atomic cell for comparable values such as small structs,
+// Results, Options and pointers, replacing atomic.Value and its unchecked type assertions, and AtomicOption[T]
+// for shared state that may not be set yet.
+//
+// Example - Traditional vs Atomic:
+//
+//	// Traditional Go
+//	var latest atomic.Value // holds result.Result[Quote], hopefully
+//	latest.Store(fetchQuote())
+//	q := latest.Load().(result.Result[Quote]) // panics if nothing was stored yet
+//
+//	// With Atomic
+//	var latest syncx.Atomic[result.Result[Quote]]
+//	latest.Store(fetchQuote())
+//	q := latest.Load()
+package syncx
+
+import (
+	"sync/atomic"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Atomic [T] holds a value of type T that may be read and written concurrently. Each Store allocates a
+// fresh copy, so Load never observes a torn write. The zero value holds the zero T and is ready to use.
+// An Atomic must not be copied after first use.
+type Atomic[T comparable] struct {
+	ptr atomic.Pointer[T]
+}
+
+// AtomicOption [T] holds an Option[T] that may be read and written concurrently, for "maybe initialized"
+// state such as the current leader or a cached token. T need not be comparable. The zero value holds None
+// and is ready to use. An AtomicOption must not be copied after first use.
+type AtomicOption[T any] struct {
+	ptr atomic.Pointer[T] // nil means None
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewAtomic creates an Atomic holding value.
+func NewAtomic[T comparable](value T) *Atomic[T] {
+	a := &Atomic[T]{}
+	a.Store(value)
+	return a
+}
+
+// Load returns the current value.
+func (a *Atomic[T]) Load() T {
+	return a.valueOf(a.ptr.Load())
+}
+
+// Store sets the current value.
+func (a *Atomic[T]) Store(value T) {
+	a.ptr.Store(&value)
+}
+
+// Swap sets the current value and returns the previous one.
+func (a *Atomic[T]) Swap(value T) T {
+	return a.valueOf(a.ptr.Swap(&value))
+}
+
+// CompareAndSwap sets the value to new if it currently equals old (by ==), reporting whether it did.
+// As with ==, comparing interface values that hold non-comparable dynamic types panics.
+//
+// Example:
+//
+//	var state syncx.Atomic[string]
+//	if state.CompareAndSwap("", "starting") {
+//	    go run() // only one caller gets here
+//	}
+func (a *Atomic[T]) CompareAndSwap(old, new T) bool {
+	for {
+		current := a.ptr.Load()
+		if a.valueOf(current) != old {
+			return false
+		}
+		if a.ptr.CompareAndSwap(current, &new) {
+			return true
+		}
+		// Another writer stored between Load and CompareAndSwap; re-check against the newer value.
+	}
+}
+
+// Load returns the current Option.
+func (a *AtomicOption[T]) Load() option.Option[T] {
+	return optionOf(a.ptr.Load())
+}
+
+// Store sets the current Option.
+func (a *AtomicOption[T]) Store(value option.Option[T]) {
+	a.ptr.Store(pointerOf(value))
+}
+
+// Swap sets the current Option and returns the previous one.
+func (a *AtomicOption[T]) Swap(value option.Option[T]) option.Option[T] {
+	return optionOf(a.ptr.Swap(pointerOf(value)))
+}
+
+// TakeIfSome atomically replaces a Some with None and returns it. If the cell already holds None it is left
+// untouched and None is returned, so among concurrent callers exactly one receives a given value.
+//
+// Example - Handing a cached token to a single refresher:
+//
+//	var token syncx.AtomicOption[Token]
+//	if stale := token.TakeIfSome(); stale.IsSome() {
+//	    token.Store(option.Some(refresh(stale.Unwrap())))
+//	}
+func (a *AtomicOption[T]) TakeIfSome() option.Option[T] {
+	for {
+		current := a.ptr.Load()
+		if current == nil {
+			return option.None[T]()
+		}
+		if a.ptr.CompareAndSwap(current, nil) {
+			return option.Some(*current)
+		}
+	}
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// valueOf dereferences p, treating nil as the zero value of an unset Atomic.
+func (a *Atomic[T]) valueOf(p *T) T {
+	if p != nil {
+		return *p
+	}
+	var zero T
+	return zero
+}
+
+// optionOf converts a stored pointer into an Option, treating nil as None.
+func optionOf[T any](p *T) option.Option[T] {
+	if p == nil {
+		return option.None[T]()
+	}
+	return option.Some(*p)
+}
+
+// pointerOf converts an Option into the pointer stored by AtomicOption, nil for None.
+func pointerOf[T any](o option.Option[T]) *T {
+	var value T
+	if !o.Some(&value) {
+		return nil
+	}
+	return &value
+}
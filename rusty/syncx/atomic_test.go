@@ -0,0 +1,109 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx_test. atomic_test verifies typed loads, stores and compare-and-swap under contention.
+package syncx_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/syncx"
+)
+
+func TestAtomic_ZeroValue(t *testing.T) {
+	var a syncx.Atomic[int]
+	if got := a.Load(); got != 0 {
+		t.Fatalf("expected %v, got %v", 0, got)
+	}
+	if !a.CompareAndSwap(0, 7) || a.Load() != 7 {
+		t.Fatalf("expected CompareAndSwap from zero to succeed, got %v", a.Load())
+	}
+}
+
+func TestAtomic_SwapAndCompareAndSwap(t *testing.T) {
+	a := syncx.NewAtomic("idle")
+	if prev := a.Swap("running"); prev != "idle" {
+		t.Fatalf("expected %v, got %v", "idle", prev)
+	}
+	if a.CompareAndSwap("idle", "stopped") {
+		t.Fatal("expected CompareAndSwap with stale old value to fail")
+	}
+	if !a.CompareAndSwap("running", "stopped") || a.Load() != "stopped" {
+		t.Fatalf("expected %v, got %v", "stopped", a.Load())
+	}
+}
+
+func TestAtomic_Option(t *testing.T) {
+	var a syncx.Atomic[option.Option[int]]
+	if a.Load().IsSome() {
+		t.Fatal("expected None before first store")
+	}
+	a.Store(option.Some(3))
+	if got := a.Load().Unwrap(); got != 3 {
+		t.Fatalf("expected %v, got %v", 3, got)
+	}
+	if !a.CompareAndSwap(option.Some(3), option.None[int]()) || a.Load().IsSome() {
+		t.Fatal("expected CompareAndSwap to match an equal Some value")
+	}
+}
+
+func TestAtomic_ConcurrentIncrement(t *testing.T) {
+	var (
+		a  syncx.Atomic[int]
+		wg sync.WaitGroup
+	)
+	for range 50 {
+		wg.Go(func() {
+			for range 100 {
+				for {
+					cur := a.Load()
+					if a.CompareAndSwap(cur, cur+1) {
+						break
+					}
+				}
+			}
+		})
+	}
+	wg.Wait()
+	if got := a.Load(); got != 5000 {
+		t.Fatalf("expected %v, got %v", 5000, got)
+	}
+}
+
+func TestAtomicOption(t *testing.T) {
+	var a syncx.AtomicOption[[]string]
+	if a.Load().IsSome() || a.TakeIfSome().IsSome() {
+		t.Fatal("expected None before first store")
+	}
+
+	a.Store(option.Some([]string{"leader-1"}))
+	if prev := a.Swap(option.Some([]string{"leader-2"})); prev.Unwrap()[0] != "leader-1" {
+		t.Fatalf("expected %v, got %v", "leader-1", prev.Unwrap())
+	}
+	if got := a.TakeIfSome(); got.Unwrap()[0] != "leader-2" || a.Load().IsSome() {
+		t.Fatalf("expected %v and None left behind, got %v", "leader-2", got)
+	}
+}
+
+func TestAtomicOption_TakeIfSomeOnce(t *testing.T) {
+	var (
+		a     syncx.AtomicOption[int]
+		wg    sync.WaitGroup
+		taken atomic.Int32
+	)
+	a.Store(option.Some(1))
+	for range 20 {
+		wg.Go(func() {
+			if a.TakeIfSome().IsSome() {
+				taken.Add(1)
+			}
+		})
+	}
+	wg.Wait()
+	if taken.Load() != 1 {
+		t.Fatalf("expected %v, got %v", 1, taken.Load())
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx. mutex provides Mutex[T], a mutex that owns the value it protects, modeled on Rust's
+// std::sync::Mutex<T>. The value is only reachable while the lock is held, which prevents the classic
+// "forgot to lock" bug around shared state.
+//
+// Example - Traditional vs typed mutex:
+//
+//	// Traditional Go: nothing stops code from touching counts without mu
+//	type Stats struct {
+//	    mu     sync.Mutex
+//	    counts map[string]int
+//	}
+//
+//	// With syncx.Mutex: counts is only reachable through the lock
+//	counts := syncx.NewMutex(map[string]int{})
+//	counts.WithLock(func(m *map[string]int) {
+//	    (*m)["requests"]++
+//	})
+package syncx
+
+import (
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Mutex [T] guards a value of type T. The zero value holds the zero T and is unlocked.
+// A Mutex must not be copied after first use.
+type Mutex[T any] struct {
+	mu    sync.Mutex
+	value T
+}
+
+// Guard [T] grants access to the value of a locked Mutex until Unlock is called.
+type Guard[T any] struct {
+	m        *Mutex[T]
+	released bool
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewMutex creates a Mutex protecting value.
+func NewMutex[T any](value T) *Mutex[T] {
+	return &Mutex[T]{value: value}
+}
+
+// WithLock calls fn with a pointer to the protected value while holding the lock.
+// The pointer must not be retained after fn returns.
+//
+// When to use:
+//   - For short critical sections; this is the preferred way to access the value
+//   - When the lock must be released even if fn panics
+//
+// Example:
+//
+//	balance.WithLock(func(b *int) {
+//	    *b -= amount
+//	})
+func (m *Mutex[T]) WithLock(fn func(*T)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fn(&m.value)
+}
+
+// Lock acquires the lock and returns a Guard giving access to the value.
+// Always pair it with a deferred Unlock.
+//
+// Example:
+//
+//	guard := cache.Lock()
+//	defer guard.Unlock()
+//	entries := guard.Get()
+//	(*entries)[key] = value
+func (m *Mutex[T]) Lock() *Guard[T] {
+	m.mu.Lock()
+	return &Guard[T]{m: m}
+}
+
+// TryLock attempts to acquire the lock without blocking, returning None if it is already held.
+func (m *Mutex[T]) TryLock() option.Option[*Guard[T]] {
+	if !m.mu.TryLock() {
+		return option.None[*Guard[T]]()
+	}
+	return option.Some(&Guard[T]{m: m})
+}
+
+// WithLockValue calls fn with the protected value while holding the lock and returns fn's result.
+// It is a function rather than a method because Go methods cannot declare type parameters.
+//
+// Example:
+//
+//	size := syncx.WithLockValue(sessions, func(s *map[string]Session) int {
+//	    return len(*s)
+//	})
+func WithLockValue[T, R any](m *Mutex[T], fn func(*T) R) R {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return fn(&m.value)
+}
+
+// -------------------------------------------- Guard Methods --------------------------------------------
+
+// Get returns a pointer to the protected value. It panics if the guard was already unlocked.
+func (g *Guard[T]) Get() *T {
+	if g.released {
+		panic("syncx: Guard used after Unlock")
+	}
+	return &g.m.value
+}
+
+// Unlock releases the lock. Calling Unlock more than once is a no-op.
+func (g *Guard[T]) Unlock() {
+	if g.released {
+		return
+	}
+	g.released = true
+	g.m.mu.Unlock()
+}
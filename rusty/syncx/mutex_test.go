@@ -0,0 +1,62 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx_test. mutex_test verifies the value-owning mutex.
+package syncx_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/syncx"
+)
+
+func TestMutex_WithLockConcurrent(t *testing.T) {
+	counter := syncx.NewMutex(0)
+
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Go(func() {
+			counter.WithLock(func(c *int) { *c++ })
+		})
+	}
+	wg.Wait()
+
+	if got := syncx.WithLockValue(counter, func(c *int) int { return *c }); got != 100 {
+		t.Fatalf("expected 100, got %d", got)
+	}
+}
+
+func TestMutex_GuardAndTryLock(t *testing.T) {
+	m := syncx.NewMutex([]string{})
+
+	guard := m.Lock()
+	*guard.Get() = append(*guard.Get(), "a")
+
+	if m.TryLock().IsSome() {
+		t.Fatal("expected TryLock to fail while locked")
+	}
+	guard.Unlock()
+	guard.Unlock() // idempotent
+
+	second := m.TryLock()
+	if second.IsNone() {
+		t.Fatal("expected TryLock to succeed after Unlock")
+	}
+	defer second.Unwrap().Unlock()
+	if got := *second.Unwrap().Get(); len(got) != 1 || got[0] != "a" {
+		t.Fatalf("unexpected value: %v", got)
+	}
+}
+
+func TestGuard_GetAfterUnlockPanics(t *testing.T) {
+	guard := syncx.NewMutex(1).Lock()
+	guard.Unlock()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic when using guard after Unlock")
+		}
+	}()
+	guard.Get()
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx. once provides OnceCell[T] and LazyLock[T], typed replacements for the
+// sync.Once + package variable pattern.
+//
+// Example - Traditional vs LazyLock:
+//
+//	// Traditional Go
+//	var (
+//	    configOnce sync.Once
+//	    config     Config
+//	)
+//	func GetConfig() Config {
+//	    configOnce.Do(func() { config = loadConfig() })
+//	    return config
+//	}
+//
+//	// With LazyLock
+//	var config = syncx.NewLazyLock(loadConfig)
+//	func GetConfig() Config { return config.Get() }
+package syncx
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// OnceCell [T] is a cell that can be written at most once. The zero value is an empty cell ready to use.
+// A OnceCell must not be copied after first use.
+type OnceCell[T any] struct {
+	mu    sync.Mutex
+	done  atomic.Bool
+	value T
+}
+
+// LazyLock [T] is a value computed on first access by an initializer function.
+// Concurrent first accesses block until the single initialization completes.
+type LazyLock[T any] struct {
+	once  sync.Once
+	init  func() T
+	value T
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Get returns the cell's value, or None if it has not been set yet.
+func (c *OnceCell[T]) Get() option.Option[T] {
+	if c.done.Load() {
+		return option.Some(c.value)
+	}
+	return option.None[T]()
+}
+
+// Set stores value if the cell is empty, reporting whether this call set it.
+func (c *OnceCell[T]) Set(value T) bool {
+	if c.done.Load() {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.done.Load() {
+		return false
+	}
+	c.value = value
+	c.done.Store(true)
+	return true
+}
+
+// GetOrInit returns the cell's value, initializing it with fn if empty.
+// fn runs at most once even under concurrent calls.
+//
+// Example:
+//
+//	var client syncx.OnceCell[*http.Client]
+//	c := client.GetOrInit(func() *http.Client {
+//	    return &http.Client{Timeout: 5 * time.Second}
+//	})
+func (c *OnceCell[T]) GetOrInit(fn func() T) T {
+	if c.done.Load() {
+		return c.value
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.done.Load() {
+		c.value = fn()
+		c.done.Store(true)
+	}
+	return c.value
+}
+
+// GetOrTryInit returns the cell's value, initializing it with fn if empty.
+// If fn returns Err, the cell stays empty and the error is returned, so a later call can retry.
+//
+// When to use:
+//   - When initialization can fail (connecting, loading files) and should be retried on next use
+//   - When you want the failure as a Result instead of a panic or a stored zero value
+//
+// Example:
+//
+//	var db syncx.OnceCell[*sql.DB]
+//
+//	func DB() result.Result[*sql.DB] {
+//	    return db.GetOrTryInit(func() result.Result[*sql.DB] {
+//	        return result.Wrap(sql.Open("postgres", dsn))
+//	    })
+//	}
+func (c *OnceCell[T]) GetOrTryInit(fn func() result.Result[T]) result.Result[T] {
+	if c.done.Load() {
+		return result.Ok(c.value)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.done.Load() {
+		return result.Ok(c.value)
+	}
+	res := fn()
+	if res.IsOk() {
+		c.value = res.Unwrap()
+		c.done.Store(true)
+	}
+	return res
+}
+
+// NewLazyLock creates a LazyLock that computes its value with init on first access.
+func NewLazyLock[T any](init func() T) *LazyLock[T] {
+	return &LazyLock[T]{init: init}
+}
+
+// Get returns the value, computing it on the first call.
+func (l *LazyLock[T]) Get() T {
+	l.once.Do(func() {
+		l.value = l.init()
+		l.init = nil // release captured state
+	})
+	return l.value
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx_test. once_test verifies OnceCell and LazyLock.
+package syncx_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/syncx"
+)
+
+var ErrInitFailed = errors.New("init failed")
+
+func TestOnceCell_SetGet(t *testing.T) {
+	var cell syncx.OnceCell[string]
+
+	if cell.Get().IsSome() {
+		t.Fatal("expected empty cell")
+	}
+	if !cell.Set("first") || cell.Set("second") {
+		t.Fatal("expected only the first Set to succeed")
+	}
+	if got := cell.Get().Unwrap(); got != "first" {
+		t.Fatalf("expected %q, got %q", "first", got)
+	}
+}
+
+func TestOnceCell_GetOrInitRunsOnce(t *testing.T) {
+	var cell syncx.OnceCell[int]
+	var calls atomic.Int32
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Go(func() {
+			cell.GetOrInit(func() int { calls.Add(1); return 7 })
+		})
+	}
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected init to run once, ran %d times", calls.Load())
+	}
+}
+
+func TestOnceCell_GetOrTryInitRetriesAfterErr(t *testing.T) {
+	var cell syncx.OnceCell[int]
+
+	res := cell.GetOrTryInit(func() result.Result[int] { return result.Err[int](ErrInitFailed) })
+	if !errors.Is(res.Err(), ErrInitFailed) {
+		t.Fatalf("expected ErrInitFailed, got %v", res.Err())
+	}
+	if cell.Get().IsSome() {
+		t.Fatal("expected cell to stay empty after failed init")
+	}
+
+	res = cell.GetOrTryInit(func() result.Result[int] { return result.Ok(3) })
+	if res.Unwrap() != 3 || cell.Get().Unwrap() != 3 {
+		t.Fatal("expected retry to initialize the cell")
+	}
+}
+
+func TestLazyLock(t *testing.T) {
+	calls := 0
+	lazy := syncx.NewLazyLock(func() []string { calls++; return []string{"a"} })
+
+	if calls != 0 {
+		t.Fatal("expected initializer not to run before Get")
+	}
+	lazy.Get()
+	if got := lazy.Get(); len(got) != 1 || calls != 1 {
+		t.Fatalf("expected single initialization, calls=%d value=%v", calls, got)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx. pool provides Pool[T], a bounded object pool (connections, clients, large buffers) whose
+// checkouts are Results and whose leases return themselves through result.With, with idle expiry and
+// health checks applied at checkout.
+//
+// Example - Pooling connections:
+//
+//	pool := syncx.NewPool(dial, syncx.PoolConfig[*Conn]{
+//	    MaxSize:     8,
+//	    IdleTimeout: time.Minute,
+//	    HealthCheck: func(ctx context.Context, c *Conn) error { return c.Ping(ctx) },
+//	})
+//	defer pool.Close()
+//
+//	return result.With(pool.Get(ctx), func(lease syncx.Lease[*Conn]) result.Result[Reply] {
+//	    return send(ctx, lease.Value(), req)
+//	})
+package syncx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/clock"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// PoolConfig [T] configures a Pool. The zero value is an unbounded pool without idle expiry or health checks.
+type PoolConfig[T any] struct {
+	// MaxSize bounds the number of objects leased at once, and so the number of objects the pool creates.
+	// Get waits for a lease to be returned when the limit is reached. 0 means unbounded.
+	MaxSize int
+	// IdleTimeout discards idle objects older than this at checkout instead of handing them out. 0 keeps them.
+	IdleTimeout time.Duration
+	// HealthCheck is run on an idle object before it is handed out; an error discards it and Get tries the
+	// next one. Freshly created objects are not checked.
+	HealthCheck func(context.Context, T) error
+	// Destroy releases a discarded object. By default objects implementing io.Closer are closed.
+	Destroy func(T)
+	// Clock measures idle time (default: clock.System).
+	Clock clock.Clock
+}
+
+// Pool [T] hands out reusable objects created on demand. It is safe for concurrent use.
+type Pool[T any] struct {
+	create func(context.Context) result.Result[T]
+	cfg    PoolConfig[T]
+	slots  chan struct{} // one token per leased object; nil when unbounded
+
+	mu     sync.Mutex
+	idle   []idleObject[T] // most recently returned last
+	closed bool
+}
+
+// Lease [T] is an object checked out of a Pool, held until Release, Discard or Close is called.
+// Ending a lease is idempotent, so a Lease may be released both explicitly and by result.With.
+// Ending the zero Lease is a no-op.
+type Lease[T any] struct {
+	pool  *Pool[T]
+	value T
+	done  *atomic.Bool
+}
+
+// idleObject is an object waiting in the pool, with the time it was returned.
+type idleObject[T any] struct {
+	value T
+	since time.Time
+}
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrPoolClosed is returned by Get after the Pool has been closed.
+var ErrPoolClosed = errors.New("syncx: pool closed")
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewPool creates a Pool that creates objects with create when no idle one is available.
+func NewPool[T any](create func(context.Context) result.Result[T], cfg PoolConfig[T]) *Pool[T] {
+	if cfg.Clock == nil {
+		cfg.Clock = clock.System
+	}
+	p := &Pool[T]{create: create, cfg: cfg}
+	if cfg.MaxSize > 0 {
+		p.slots = make(chan struct{}, cfg.MaxSize)
+	}
+	return p
+}
+
+// Get checks out an object: the most recently returned idle one that has not expired and passes the health
+// check, or a new one from create. It waits while MaxSize objects are leased, returning Err(ctx.Err()) if ctx
+// is done first, and returns the Err of create or ErrPoolClosed as is.
+//
+// Example:
+//
+//	lease := pool.Get(ctx).BubbleUp()
+//	defer lease.Release()
+func (p *Pool[T]) Get(ctx context.Context) result.Result[Lease[T]] {
+	if err := ctx.Err(); err != nil {
+		return result.Err[Lease[T]](err)
+	}
+	if p.isClosed() {
+		return result.Err[Lease[T]](ErrPoolClosed)
+	}
+	if p.slots != nil {
+		select {
+		case p.slots <- struct{}{}:
+		case <-ctx.Done():
+			return result.Err[Lease[T]](ctx.Err())
+		}
+	}
+
+	for {
+		obj, ok, err := p.popIdle()
+		if err != nil {
+			p.freeSlot()
+			return result.Err[Lease[T]](err)
+		}
+		if !ok {
+			break
+		}
+		if p.usable(ctx, obj) {
+			return result.Ok(p.newLease(obj.value))
+		}
+		p.destroy(obj.value)
+	}
+
+	created := p.create(ctx)
+	if created.IsErr() {
+		p.freeSlot()
+		return result.Err[Lease[T]](created.Err())
+	}
+	return result.Ok(p.newLease(created.Unwrap()))
+}
+
+// Idle returns the number of objects waiting in the pool.
+func (p *Pool[T]) Idle() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.idle)
+}
+
+// Close destroys the idle objects and makes later Gets fail with ErrPoolClosed. Leases still held are
+// destroyed when they end. Close always returns nil.
+func (p *Pool[T]) Close() error {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle, p.closed = nil, true
+	p.mu.Unlock()
+
+	for _, obj := range idle {
+		p.destroy(obj.value)
+	}
+	return nil
+}
+
+// -------------------------------------------- Lease Methods --------------------------------------------
+
+// Value returns the leased object.
+func (l Lease[T]) Value() T {
+	return l.value
+}
+
+// Release returns the object to its Pool for reuse. Calls after the first end of the lease are no-ops.
+func (l Lease[T]) Release() {
+	if l.done != nil && l.done.CompareAndSwap(false, true) {
+		l.pool.put(l.value)
+	}
+}
+
+// Discard destroys the object instead of returning it, e.g. after it reported a broken connection.
+// Calls after the first end of the lease are no-ops.
+func (l Lease[T]) Discard() {
+	if l.done != nil && l.done.CompareAndSwap(false, true) {
+		l.pool.destroy(l.value)
+		l.pool.freeSlot()
+	}
+}
+
+// Close releases the lease and always returns nil. It makes Lease an io.Closer for result.With.
+func (l Lease[T]) Close() error {
+	l.Release()
+	return nil
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// newLease wraps an object that has just been checked out.
+func (p *Pool[T]) newLease(value T) Lease[T] {
+	return Lease[T]{pool: p, value: value, done: new(atomic.Bool)}
+}
+
+// isClosed reports whether Close has been called.
+func (p *Pool[T]) isClosed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.closed
+}
+
+// popIdle removes the most recently returned idle object, reporting ErrPoolClosed after Close.
+func (p *Pool[T]) popIdle() (idleObject[T], bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return idleObject[T]{}, false, ErrPoolClosed
+	}
+	if len(p.idle) == 0 {
+		return idleObject[T]{}, false, nil
+	}
+	obj := p.idle[len(p.idle)-1]
+	p.idle[len(p.idle)-1] = idleObject[T]{}
+	p.idle = p.idle[:len(p.idle)-1]
+	return obj, true, nil
+}
+
+// usable reports whether an idle object may be handed out: not expired and healthy.
+func (p *Pool[T]) usable(ctx context.Context, obj idleObject[T]) bool {
+	if p.cfg.IdleTimeout > 0 && p.cfg.Clock.Since(obj.since) > p.cfg.IdleTimeout {
+		return false
+	}
+	return p.cfg.HealthCheck == nil || p.cfg.HealthCheck(ctx, obj.value) == nil
+}
+
+// put returns a leased object to the idle list, or destroys it if the pool is closed.
+func (p *Pool[T]) put(value T) {
+	p.mu.Lock()
+	closed := p.closed
+	if !closed {
+		p.idle = append(p.idle, idleObject[T]{value: value, since: p.cfg.Clock.Now()})
+	}
+	p.mu.Unlock()
+
+	if closed {
+		p.destroy(value)
+	}
+	p.freeSlot()
+}
+
+// freeSlot gives back the token of an ended lease.
+func (p *Pool[T]) freeSlot() {
+	if p.slots != nil {
+		<-p.slots
+	}
+}
+
+// destroy releases a discarded object with the configured hook, or closes it if it is an io.Closer.
+func (p *Pool[T]) destroy(value T) {
+	if p.cfg.Destroy != nil {
+		p.cfg.Destroy(value)
+		return
+	}
+	if closer, ok := any(value).(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
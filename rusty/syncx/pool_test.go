@@ -0,0 +1,140 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx_test. pool_test verifies reuse, size limits, idle expiry, health checks and closing.
+package syncx_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/clock/clocktest"
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/syncx"
+)
+
+// conn is a pooled object that records whether it was closed.
+type conn struct {
+	id     int64
+	closed atomic.Bool
+	broken bool
+}
+
+func (c *conn) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+// dialer creates numbered conns.
+func dialer() (func(context.Context) result.Result[*conn], *atomic.Int64) {
+	var created atomic.Int64
+	return func(context.Context) result.Result[*conn] {
+		return result.Ok(&conn{id: created.Add(1)})
+	}, &created
+}
+
+func TestPool_Reuse(t *testing.T) {
+	dial, created := dialer()
+	pool := syncx.NewPool(dial, syncx.PoolConfig[*conn]{})
+	ctx := context.Background()
+
+	first := pool.Get(ctx).Unwrap()
+	first.Release()
+	first.Release() // idempotent
+	if pool.Idle() != 1 {
+		t.Fatalf("expected %v, got %v", 1, pool.Idle())
+	}
+
+	res := result.With(pool.Get(ctx), func(lease syncx.Lease[*conn]) result.Result[int64] {
+		return result.Ok(lease.Value().id)
+	})
+	if res.Unwrap() != 1 || created.Load() != 1 || pool.Idle() != 1 {
+		t.Fatalf("expected the first conn to be reused, got id %v after %v creations", res.Unwrap(), created.Load())
+	}
+}
+
+func TestPool_MaxSize(t *testing.T) {
+	dial, _ := dialer()
+	pool := syncx.NewPool(dial, syncx.PoolConfig[*conn]{MaxSize: 1})
+
+	held := pool.Get(context.Background()).Unwrap()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := pool.Get(ctx).Err(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	got := make(chan int64)
+	go func() { got <- pool.Get(context.Background()).Unwrap().Value().id }()
+	held.Release()
+	if id := <-got; id != 1 {
+		t.Fatalf("expected the waiter to receive conn %v, got %v", 1, id)
+	}
+}
+
+func TestPool_IdleTimeoutAndHealthCheck(t *testing.T) {
+	dial, created := dialer()
+	clk := clocktest.NewFakeClock(time.Now())
+	pool := syncx.NewPool(dial, syncx.PoolConfig[*conn]{
+		IdleTimeout: time.Minute,
+		HealthCheck: func(_ context.Context, c *conn) error {
+			if c.broken {
+				return errors.New("broken")
+			}
+			return nil
+		},
+		Clock: clk,
+	})
+	ctx := context.Background()
+
+	stale := pool.Get(ctx).Unwrap()
+	stale.Release()
+	clk.Advance(2 * time.Minute)
+	fresh := pool.Get(ctx).Unwrap()
+	if fresh.Value().id != 2 || !stale.Value().closed.Load() {
+		t.Fatal("expected the expired conn to be closed and replaced")
+	}
+
+	fresh.Value().broken = true
+	fresh.Release()
+	if next := pool.Get(ctx).Unwrap(); next.Value().id != 3 || !fresh.Value().closed.Load() || created.Load() != 3 {
+		t.Fatal("expected the unhealthy conn to be closed and replaced")
+	}
+}
+
+func TestPool_DiscardAndClose(t *testing.T) {
+	dial, _ := dialer()
+	pool := syncx.NewPool(dial, syncx.PoolConfig[*conn]{MaxSize: 1})
+	ctx := context.Background()
+
+	broken := pool.Get(ctx).Unwrap()
+	broken.Discard()
+	broken.Release() // no-op after Discard
+	if !broken.Value().closed.Load() || pool.Idle() != 0 {
+		t.Fatal("expected a discarded conn to be closed, not pooled")
+	}
+
+	idle := pool.Get(ctx).Unwrap()
+	idle.Release()
+	held := pool.Get(ctx).Unwrap()
+	_ = pool.Close()
+	if !errors.Is(pool.Get(ctx).Err(), syncx.ErrPoolClosed) {
+		t.Fatalf("expected %v, got %v", syncx.ErrPoolClosed, pool.Get(ctx).Err())
+	}
+	held.Release()
+	if !held.Value().closed.Load() {
+		t.Fatal("expected a lease returned after Close to be destroyed")
+	}
+}
+
+func TestLease_ZeroValue(t *testing.T) {
+	var lease syncx.Lease[*conn]
+	lease.Release()
+	lease.Discard()
+	if err := lease.Close(); err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+}
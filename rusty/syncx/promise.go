@@ -0,0 +1,111 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx. promise provides Promise[T]/Future[T]: a write-once Result shared between a producer
+// and any number of consumers, useful for request coalescing and pipelining.
+//
+// Example - Request coalescing:
+//
+//	promise, future := syncx.NewPromise[User]()
+//	go func() {
+//	    promise.Resolve(repo.FindUser(ctx, id))
+//	}()
+//
+//	// Any number of goroutines can wait on the same future
+//	user := future.Await(ctx) // Result[User], Err on producer failure or ctx cancellation
+package syncx
+
+import (
+	"context"
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Promise [T] is the producer side: it resolves the shared Result exactly once.
+type Promise[T any] struct {
+	state *futureState[T]
+}
+
+// Future [T] is the consumer side: it waits for the Result resolved by the matching Promise.
+type Future[T any] struct {
+	state *futureState[T]
+}
+
+// futureState is shared by a Promise and its Future.
+type futureState[T any] struct {
+	once sync.Once
+	done chan struct{}
+	res  result.Result[T]
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewPromise creates a connected Promise/Future pair.
+func NewPromise[T any]() (*Promise[T], *Future[T]) {
+	state := &futureState[T]{done: make(chan struct{})}
+	return &Promise[T]{state: state}, &Future[T]{state: state}
+}
+
+// Async runs fn in a new goroutine and returns a Future resolved with its Result. Panics in fn are
+// recovered as in result.SafeGo: a BubbleUp resolves the Future with its Err, and any other panic with
+// an Err wrapping result.ErrPanic.
+//
+// Example:
+//
+//	userF := syncx.Async(func() result.Result[User] { return repo.FindUser(ctx, id) })
+//	ordersF := syncx.Async(func() result.Result[[]Order] { return repo.FindOrders(ctx, id) })
+//	return result.Map2(userF.Await(ctx), ordersF.Await(ctx), buildDashboard)
+func Async[T any](fn func() result.Result[T]) *Future[T] {
+	promise, future := NewPromise[T]()
+	go func() {
+		promise.Resolve(<-result.SafeGo(fn))
+	}()
+	return future
+}
+
+// Resolve completes the promise with res, waking every waiting consumer.
+// Only the first call has an effect; it reports whether this call resolved the promise.
+func (p *Promise[T]) Resolve(res result.Result[T]) bool {
+	resolved := false
+	p.state.once.Do(func() {
+		p.state.res = res
+		close(p.state.done)
+		resolved = true
+	})
+	return resolved
+}
+
+// Future returns the Future connected to this promise.
+func (p *Promise[T]) Future() *Future[T] {
+	return &Future[T]{state: p.state}
+}
+
+// Await blocks until the promise is resolved or ctx is done.
+// Returns the resolved Result, or Err(ctx.Err()) if ctx ends first.
+func (f *Future[T]) Await(ctx context.Context) result.Result[T] {
+	select {
+	case <-f.state.done:
+		return f.state.res
+	case <-ctx.Done():
+		return result.Err[T](ctx.Err())
+	}
+}
+
+// TryGet returns the resolved Result without blocking, or None if the promise is still pending.
+func (f *Future[T]) TryGet() option.Option[result.Result[T]] {
+	select {
+	case <-f.state.done:
+		return option.Some(f.state.res)
+	default:
+		return option.None[result.Result[T]]()
+	}
+}
+
+// Done returns a channel that is closed once the promise is resolved, for use in select statements.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.state.done
+}
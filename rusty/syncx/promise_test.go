@@ -0,0 +1,68 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx_test. promise_test verifies Promise/Future resolution and cancellation.
+package syncx_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/syncx"
+)
+
+func TestPromise_ResolveOnce(t *testing.T) {
+	promise, future := syncx.NewPromise[int]()
+
+	if future.TryGet().IsSome() {
+		t.Fatal("expected pending future")
+	}
+	if !promise.Resolve(result.Ok(1)) || promise.Resolve(result.Ok(2)) {
+		t.Fatal("expected only the first Resolve to take effect")
+	}
+	if got := future.Await(context.Background()).Unwrap(); got != 1 {
+		t.Fatalf("expected 1, got %d", got)
+	}
+}
+
+func TestFuture_AwaitCancelled(t *testing.T) {
+	_, future := syncx.NewPromise[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	res := future.Await(ctx)
+	if !errors.Is(res.Err(), context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded, got %v", res.Err())
+	}
+}
+
+func TestAsync_MultipleConsumers(t *testing.T) {
+	future := syncx.Async(func() result.Result[string] {
+		return result.Err[string](ErrInitFailed)
+	})
+
+	for range 3 {
+		if res := future.Await(context.Background()); !errors.Is(res.Err(), ErrInitFailed) {
+			t.Fatalf("expected ErrInitFailed, got %v", res.Err())
+		}
+	}
+}
+
+func TestAsync_RecoversPanics(t *testing.T) {
+	panicked := syncx.Async(func() result.Result[int] {
+		panic("boom")
+	})
+	if res := panicked.Await(context.Background()); !errors.Is(res.Err(), result.ErrPanic) {
+		t.Fatalf("expected %v, got %v", result.ErrPanic, res.Err())
+	}
+
+	bubbled := syncx.Async(func() result.Result[int] {
+		return result.Ok(result.Err[int](ErrInitFailed).BubbleUp())
+	})
+	if res := bubbled.Await(context.Background()); !errors.Is(res.Err(), ErrInitFailed) {
+		t.Fatalf("expected %v, got %v", ErrInitFailed, res.Err())
+	}
+}
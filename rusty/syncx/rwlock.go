@@ -0,0 +1,141 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx. rwlock provides RwLock[T], a reader/writer lock that owns the value it protects,
+// complementing Mutex[T] for read-heavy state such as caches and configuration snapshots.
+//
+// Example:
+//
+//	routes := syncx.NewRwLock(map[string]string{})
+//
+//	// Many concurrent readers
+//	routes.Read(func(r map[string]string) {
+//	    target = r[path]
+//	})
+//
+//	// Exclusive writer
+//	routes.Write(func(r *map[string]string) {
+//	    (*r)[path] = target
+//	})
+package syncx
+
+import (
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// RwLock [T] guards a value of type T with a sync.RWMutex. The zero value holds the zero T and is unlocked.
+// An RwLock must not be copied after first use.
+type RwLock[T any] struct {
+	mu    sync.RWMutex
+	value T
+}
+
+// ReadGuard [T] grants shared read access to the value of an RwLock until Unlock is called.
+type ReadGuard[T any] struct {
+	l        *RwLock[T]
+	released bool
+}
+
+// WriteGuard [T] grants exclusive access to the value of an RwLock until Unlock is called.
+type WriteGuard[T any] struct {
+	l        *RwLock[T]
+	released bool
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewRwLock creates an RwLock protecting value.
+func NewRwLock[T any](value T) *RwLock[T] {
+	return &RwLock[T]{value: value}
+}
+
+// Read calls fn with the protected value while holding a read lock.
+// Reference types (maps, slices, pointers) must not be mutated or retained inside fn.
+func (l *RwLock[T]) Read(fn func(T)) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	fn(l.value)
+}
+
+// Write calls fn with a pointer to the protected value while holding the write lock.
+func (l *RwLock[T]) Write(fn func(*T)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fn(&l.value)
+}
+
+// RLock acquires a read lock and returns a guard. Always pair it with a deferred Unlock.
+func (l *RwLock[T]) RLock() *ReadGuard[T] {
+	l.mu.RLock()
+	return &ReadGuard[T]{l: l}
+}
+
+// Lock acquires the write lock and returns a guard. Always pair it with a deferred Unlock.
+func (l *RwLock[T]) Lock() *WriteGuard[T] {
+	l.mu.Lock()
+	return &WriteGuard[T]{l: l}
+}
+
+// TryRead attempts to acquire a read lock without blocking, returning None if a writer holds the lock.
+//
+// Example - Serve stale data rather than wait:
+//
+//	if guard := snapshot.TryRead(); guard.IsSome() {
+//	    defer guard.Unwrap().Unlock()
+//	    return guard.Unwrap().Get()
+//	}
+//	return lastKnown
+func (l *RwLock[T]) TryRead() option.Option[*ReadGuard[T]] {
+	if !l.mu.TryRLock() {
+		return option.None[*ReadGuard[T]]()
+	}
+	return option.Some(&ReadGuard[T]{l: l})
+}
+
+// TryWrite attempts to acquire the write lock without blocking, returning None if the lock is held.
+func (l *RwLock[T]) TryWrite() option.Option[*WriteGuard[T]] {
+	if !l.mu.TryLock() {
+		return option.None[*WriteGuard[T]]()
+	}
+	return option.Some(&WriteGuard[T]{l: l})
+}
+
+// -------------------------------------------- Guard Methods --------------------------------------------
+
+// Get returns the protected value. It panics if the guard was already unlocked.
+func (g *ReadGuard[T]) Get() T {
+	if g.released {
+		panic("syncx: ReadGuard used after Unlock")
+	}
+	return g.l.value
+}
+
+// Unlock releases the read lock. Calling Unlock more than once is a no-op.
+func (g *ReadGuard[T]) Unlock() {
+	if g.released {
+		return
+	}
+	g.released = true
+	g.l.mu.RUnlock()
+}
+
+// Get returns a pointer to the protected value. It panics if the guard was already unlocked.
+func (g *WriteGuard[T]) Get() *T {
+	if g.released {
+		panic("syncx: WriteGuard used after Unlock")
+	}
+	return &g.l.value
+}
+
+// Unlock releases the write lock. Calling Unlock more than once is a no-op.
+func (g *WriteGuard[T]) Unlock() {
+	if g.released {
+		return
+	}
+	g.released = true
+	g.l.mu.Unlock()
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx_test. rwlock_test verifies the value-owning reader/writer lock.
+package syncx_test
+
+import (
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/syncx"
+)
+
+func TestRwLock_ReadWrite(t *testing.T) {
+	l := syncx.NewRwLock(map[string]int{})
+
+	l.Write(func(m *map[string]int) { (*m)["a"] = 1 })
+
+	var got int
+	l.Read(func(m map[string]int) { got = m["a"] })
+	if got != 1 {
+		t.Fatalf("expected 1, got %d", got)
+	}
+}
+
+func TestRwLock_TryReadTryWrite(t *testing.T) {
+	l := syncx.NewRwLock(10)
+
+	reader := l.RLock()
+	second := l.TryRead()
+	if second.IsNone() {
+		t.Fatal("expected concurrent readers to be allowed")
+	}
+	if l.TryWrite().IsSome() {
+		t.Fatal("expected TryWrite to fail while readers hold the lock")
+	}
+	second.Unwrap().Unlock()
+	reader.Unlock()
+
+	writer := l.TryWrite()
+	if writer.IsNone() {
+		t.Fatal("expected TryWrite to succeed once readers released")
+	}
+	writer.Unwrap().Unlock()
+}
+
+func TestRwLock_WriterBlocksTryRead(t *testing.T) {
+	l := syncx.NewRwLock(10)
+
+	writer := l.Lock()
+	*writer.Get() = 20
+	if l.TryRead().IsSome() {
+		t.Fatal("expected TryRead to fail while writer holds the lock")
+	}
+	writer.Unlock()
+
+	guard := l.TryRead()
+	if guard.IsNone() {
+		t.Fatal("expected TryRead to succeed after writer released")
+	}
+	defer guard.Unwrap().Unlock()
+	if guard.Unwrap().Get() != 20 {
+		t.Fatalf("expected 20, got %d", guard.Unwrap().Get())
+	}
+}
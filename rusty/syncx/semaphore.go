@@ -0,0 +1,106 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx. semaphore provides a counting Semaphore whose acquisitions are Results and whose permits
+// release themselves through result.With, so a permit cannot be leaked on an early return.
+//
+// Example - Traditional vs Semaphore:
+//
+//	// Traditional Go
+//	select {
+//	case sem <- struct{}{}:
+//	case <-ctx.Done():
+//	    return ctx.Err()
+//	}
+//	defer func() { <-sem }()
+//
+//	// With Semaphore
+//	return result.With(sem.Acquire(ctx), func(syncx.Permit) result.Result[Page] {
+//	    return crawl(ctx, url)
+//	})
+package syncx
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/seyedali-dev/goxide/rusty/option"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Semaphore limits the number of concurrently held permits.
+type Semaphore struct {
+	slots chan struct{}
+}
+
+// Permit is one unit of a Semaphore's capacity, held until Release (or Close) is called.
+// Releasing is idempotent, so a Permit may be released both explicitly and by result.With.
+// Releasing the zero Permit is a no-op.
+type Permit struct {
+	sem      *Semaphore
+	released *atomic.Bool
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewSemaphore creates a Semaphore with n permits. n below 1 is treated as 1.
+func NewSemaphore(n int) *Semaphore {
+	return &Semaphore{slots: make(chan struct{}, max(n, 1))}
+}
+
+// Acquire waits for a permit, returning Err(ctx.Err()) if ctx is done first.
+//
+// Example:
+//
+//	permit := sem.Acquire(ctx).BubbleUp()
+//	defer permit.Release()
+func (s *Semaphore) Acquire(ctx context.Context) result.Result[Permit] {
+	if err := ctx.Err(); err != nil {
+		return result.Err[Permit](err)
+	}
+	select {
+	case s.slots <- struct{}{}:
+		return result.Ok(s.newPermit())
+	case <-ctx.Done():
+		return result.Err[Permit](ctx.Err())
+	}
+}
+
+// TryAcquire returns a permit if one is immediately available, otherwise None.
+func (s *Semaphore) TryAcquire() option.Option[Permit] {
+	select {
+	case s.slots <- struct{}{}:
+		return option.Some(s.newPermit())
+	default:
+		return option.None[Permit]()
+	}
+}
+
+// Available returns the number of permits not currently held.
+func (s *Semaphore) Available() int {
+	return cap(s.slots) - len(s.slots)
+}
+
+// -------------------------------------------- Permit Methods --------------------------------------------
+
+// Release returns the permit to its Semaphore. Calls after the first are no-ops.
+func (p Permit) Release() {
+	if p.released != nil && p.released.CompareAndSwap(false, true) {
+		<-p.sem.slots
+	}
+}
+
+// Close releases the permit and always returns nil. It makes Permit an io.Closer for result.With.
+func (p Permit) Close() error {
+	p.Release()
+	return nil
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// newPermit wraps a slot that has just been taken.
+func (s *Semaphore) newPermit() Permit {
+	return Permit{sem: s, released: new(atomic.Bool)}
+}
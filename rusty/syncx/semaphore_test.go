@@ -0,0 +1,64 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx_test. semaphore_test verifies permit accounting, cancellation and scoped release.
+package syncx_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/syncx"
+)
+
+func TestSemaphore_AcquireRelease(t *testing.T) {
+	sem := syncx.NewSemaphore(2)
+	first := sem.Acquire(context.Background()).Unwrap()
+	sem.TryAcquire().Unwrap()
+
+	if sem.TryAcquire().IsSome() || sem.Available() != 0 {
+		t.Fatal("expected semaphore to be exhausted")
+	}
+
+	first.Release()
+	first.Release() // idempotent
+	if sem.Available() != 1 {
+		t.Fatalf("expected %v, got %v", 1, sem.Available())
+	}
+}
+
+func TestSemaphore_AcquireCancelled(t *testing.T) {
+	sem := syncx.NewSemaphore(1)
+	sem.TryAcquire().Unwrap()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if res := sem.Acquire(ctx); !errors.Is(res.Err(), context.DeadlineExceeded) {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, res.Err())
+	}
+}
+
+func TestSemaphore_WithReleasesPermit(t *testing.T) {
+	sem := syncx.NewSemaphore(1)
+	res := result.With(sem.Acquire(context.Background()), func(syncx.Permit) result.Result[int] {
+		if sem.Available() != 0 {
+			t.Error("expected permit to be held inside With")
+		}
+		return result.Ok(42)
+	})
+
+	if res.Unwrap() != 42 || sem.Available() != 1 {
+		t.Fatalf("expected permit to be released, available %d", sem.Available())
+	}
+}
+
+func TestPermit_ZeroValue(t *testing.T) {
+	var permit syncx.Permit
+	permit.Release()
+	if err := permit.Close(); err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx. singleflight provides Singleflight[K, V], which collapses concurrent calls for the same key
+// into one execution whose Result every caller shares, preventing stampedes on cache misses.
+//
+// Example - Deduplicating loads:
+//
+//	var loads syncx.Singleflight[int, User]
+//
+//	func (s *UserService) Find(id int) result.Result[User] {
+//	    return loads.Do(id, func() result.Result[User] {
+//	        return s.repo.FindUser(id) // runs once per id, however many callers are waiting
+//	    })
+//	}
+package syncx
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Singleflight [K, V] deduplicates concurrent calls by key. The zero value is ready to use.
+// A Singleflight must not be copied after first use.
+type Singleflight[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*flight[V]
+}
+
+// flight is an in-progress call whose Result is published when done is closed.
+type flight[V any] struct {
+	done chan struct{}
+	res  result.Result[V]
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Do runs fn for key unless a call for key is already in progress, in which case it waits for that call and
+// returns its Result. Results are not cached: once a call finishes, the next Do for key runs fn again.
+// A BubbleUp inside fn becomes its Err. If fn panics otherwise, the panic is re-raised in the caller that
+// ran fn and the waiting callers receive an Err wrapping result.ErrPanic.
+func (s *Singleflight[K, V]) Do(key K, fn func() result.Result[V]) result.Result[V] {
+	s.mu.Lock()
+	if f, ok := s.calls[key]; ok {
+		s.mu.Unlock()
+		<-f.done
+		return f.res
+	}
+	f := &flight[V]{done: make(chan struct{})}
+	if s.calls == nil {
+		s.calls = make(map[K]*flight[V])
+	}
+	s.calls[key] = f
+	s.mu.Unlock()
+
+	defer func() {
+		if r := recover(); r != nil {
+			f.res = result.Err[V](fmt.Errorf("%w: %v", result.ErrPanic, r))
+			s.finish(key, f)
+			panic(r)
+		}
+		s.finish(key, f)
+	}()
+	f.res = result.CatchInto(func() V { return fn().BubbleUp() })
+	return f.res
+}
+
+// Forget makes the next Do for key run fn even if a call for key is in progress. Callers already waiting
+// still receive the in-progress Result.
+func (s *Singleflight[K, V]) Forget(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.calls, key)
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// finish publishes f's Result and removes it, unless Forget already replaced it.
+func (s *Singleflight[K, V]) finish(key K, f *flight[V]) {
+	s.mu.Lock()
+	if s.calls[key] == f {
+		delete(s.calls, key)
+	}
+	s.mu.Unlock()
+	close(f.done)
+}
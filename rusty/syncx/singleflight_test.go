@@ -0,0 +1,79 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package syncx_test. singleflight_test verifies call deduplication, error sharing and panics.
+package syncx_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/seyedali-dev/goxide/rusty/result"
+	"github.com/seyedali-dev/goxide/rusty/syncx"
+)
+
+func TestSingleflight_Deduplicates(t *testing.T) {
+	var (
+		group   syncx.Singleflight[string, int]
+		calls   atomic.Int32
+		entered = make(chan struct{})
+		release = make(chan struct{})
+		wg      sync.WaitGroup
+	)
+	load := func() result.Result[int] {
+		if calls.Add(1) == 1 {
+			close(entered)
+		}
+		<-release
+		return result.Ok(42)
+	}
+
+	results := make([]result.Result[int], 10)
+	wg.Go(func() { results[0] = group.Do("answer", load) })
+	<-entered
+	for i := 1; i < len(results); i++ {
+		wg.Go(func() { results[i] = group.Do("answer", load) })
+	}
+	time.Sleep(20 * time.Millisecond) // let the waiters join the call in flight
+	close(release)
+	wg.Wait()
+
+	for _, res := range results {
+		if res.Unwrap() != 42 {
+			t.Fatalf("expected %v, got %v", 42, res.Unwrap())
+		}
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected %v call, got %v", 1, calls.Load())
+	}
+	if group.Do("answer", func() result.Result[int] { return result.Ok(7) }).Unwrap() != 7 {
+		t.Fatal("expected a finished call not to be cached")
+	}
+}
+
+func TestSingleflight_ErrorsAndPanics(t *testing.T) {
+	var group syncx.Singleflight[int, int]
+	errBoom := errors.New("boom")
+
+	bubbled := group.Do(1, func() result.Result[int] {
+		return result.Ok(result.Err[int](errBoom).BubbleUp())
+	})
+	if !errors.Is(bubbled.Err(), errBoom) {
+		t.Fatalf("expected %v, got %v", errBoom, bubbled.Err())
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != "kaboom" {
+				t.Fatalf("expected %v, got %v", "kaboom", r)
+			}
+		}()
+		_ = group.Do(2, func() result.Result[int] { panic("kaboom") })
+	}()
+	if got := group.Do(2, func() result.Result[int] { return result.Ok(2) }); got.Unwrap() != 2 {
+		t.Fatalf("expected the key to be usable after a panic, got %v", got)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package types. cow provides Cow[T], a copy-on-write container modeled on Rust's Cow: a value is shared
+// read-only between handles until one of them asks to write, at which point that handle deep-copies it
+// (with the same machinery as reflect.DeepCopy) and owns the copy.
+//
+// Example - Config snapshots handed to many goroutines:
+//
+//	base := types.NewCow(loadConfig())
+//	for _, tenant := range tenants {
+//	    cfg := base.Share() // no copy yet
+//	    go func() {
+//	        if tenant.Overrides != nil {
+//	            cfg.Write(func(c *Config) { c.Limits["uploads"] = tenant.Overrides.Uploads }) // copies once
+//	        }
+//	        serve(tenant, cfg.Get())
+//	    }()
+//	}
+package types
+
+import "github.com/seyedali-dev/goxide/internal/deepcopy"
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Cow [T] is a handle to a value that is either borrowed (shared, read-only) or owned (private to this
+// handle). Handles are created with NewCow and Share and must not be copied; a single handle is not safe
+// for concurrent writes, but any number of borrowed handles may read the shared value concurrently.
+type Cow[T any] struct {
+	value *T
+	owned bool
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// NewCow creates a borrowed handle to v. v must not be mutated through other references afterwards.
+func NewCow[T any](v T) *Cow[T] {
+	return &Cow[T]{value: &v}
+}
+
+// Get returns the current value. The result must be treated as read-only: nested maps, slices and
+// pointers may be shared with other handles.
+func (c *Cow[T]) Get() T {
+	return *c.value
+}
+
+// IsOwned reports whether this handle has made its private copy.
+func (c *Cow[T]) IsOwned() bool {
+	return c.owned
+}
+
+// ToMut returns a pointer to a value private to this handle, deep-copying the shared value on first use.
+func (c *Cow[T]) ToMut() *T {
+	if !c.owned {
+		copied := deepcopy.Copy(*c.value)
+		c.value, c.owned = &copied, true
+	}
+	return c.value
+}
+
+// Write calls fn with a pointer to a value private to this handle, deep-copying the shared value
+// on first use.
+//
+// Example:
+//
+//	cfg.Write(func(c *Config) {
+//	    c.Timeout = 2 * time.Second
+//	})
+func (c *Cow[T]) Write(fn func(*T)) {
+	fn(c.ToMut())
+}
+
+// Share returns a new borrowed handle to the current value, without copying. Writes through either
+// handle afterwards copy first, so neither observes the other's changes.
+func (c *Cow[T]) Share() *Cow[T] {
+	c.owned = false // the value is now shared; the next write through c must copy too
+	return &Cow[T]{value: c.value}
+}
+
+// IntoOwned consumes the handle, returning the owned value or a deep copy of the shared one.
+// The handle must not be used afterwards.
+func (c *Cow[T]) IntoOwned() T {
+	return *c.ToMut()
+}
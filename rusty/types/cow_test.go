@@ -0,0 +1,83 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package types_test. cow_test verifies copy-on-write isolation between shared handles and ownership tracking.
+package types_test
+
+import (
+	"testing"
+
+	"github.com/seyedali-dev/goxide/rusty/types"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+type Config struct {
+	Name   string
+	Limits map[string]int
+}
+
+func newConfig() Config {
+	return Config{Name: "base", Limits: map[string]int{"uploads": 10}}
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestCow_ShareThenWrite(t *testing.T) {
+	original := types.NewCow(newConfig())
+	shared := original.Share()
+
+	shared.Write(func(c *Config) { c.Limits["uploads"] = 20 })
+	if got := original.Get().Limits["uploads"]; got != 10 {
+		t.Fatalf("expected %v, got %v", 10, got)
+	}
+
+	original.Write(func(c *Config) { c.Name = "changed" })
+	if got := shared.Get(); got.Name != "base" || got.Limits["uploads"] != 20 {
+		t.Fatalf("expected shared handle to keep its own copy, got %+v", got)
+	}
+	if got := original.Get(); got.Name != "changed" || got.Limits["uploads"] != 10 {
+		t.Fatalf("expected original handle to keep its own copy, got %+v", got)
+	}
+}
+
+func TestCow_IsOwned(t *testing.T) {
+	c := types.NewCow(newConfig())
+	if c.IsOwned() {
+		t.Fatal("expected a new handle to be borrowed")
+	}
+
+	first := c.ToMut()
+	if !c.IsOwned() || c.ToMut() != first {
+		t.Fatal("expected the first write to copy once and own the copy")
+	}
+}
+
+func TestCow_ShareResetsOwner(t *testing.T) {
+	c := types.NewCow(newConfig())
+	c.Write(func(cfg *Config) { cfg.Limits["uploads"] = 20 })
+
+	shared := c.Share()
+	if c.IsOwned() || shared.IsOwned() {
+		t.Fatal("expected both handles to be borrowed after Share")
+	}
+
+	c.Write(func(cfg *Config) { cfg.Limits["uploads"] = 30 })
+	if !c.IsOwned() {
+		t.Fatal("expected the next write to copy again")
+	}
+	if got := shared.Get().Limits["uploads"]; got != 20 {
+		t.Fatalf("expected %v, got %v", 20, got)
+	}
+}
+
+func TestCow_IntoOwned(t *testing.T) {
+	c := types.NewCow(newConfig())
+	shared := c.Share()
+
+	owned := shared.IntoOwned()
+	owned.Limits["uploads"] = 99
+	if got := c.Get().Limits["uploads"]; got != 10 {
+		t.Fatalf("expected IntoOwned on a borrowed handle to deep-copy, got %v", got)
+	}
+}
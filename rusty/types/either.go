@@ -0,0 +1,113 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package types. either provides sum types holding exactly one of several differently typed values,
+// the counterpart of the tuples in tuple.go.
+package types
+
+// ------------------------------------- Types -------------------------------------
+
+// Either [L, R] holds either a left value of type L or a right value of type R, never both.
+// The zero value is Left holding the zero L.
+//
+// Example:
+//
+//	e := types.Right[string, int](42)
+//	if n, ok := e.Right(); ok {
+//	    fmt.Println(n)
+//	}
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// OneOf3 [A, B, C] holds exactly one of three values of possibly different types.
+// The zero value holds the zero A.
+type OneOf3[A, B, C any] struct {
+	first  A
+	second B
+	third  C
+	index  int
+}
+
+// ------------------------------------- Public Functions -------------------------------------
+
+// Left creates an Either holding the left value v.
+func Left[L, R any](v L) Either[L, R] {
+	return Either[L, R]{left: v}
+}
+
+// Right creates an Either holding the right value v.
+func Right[L, R any](v R) Either[L, R] {
+	return Either[L, R]{right: v, isRight: true}
+}
+
+// IsLeft reports whether e holds a left value.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight reports whether e holds a right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// Left returns the left value and true, or the zero L and false if e holds a right value.
+func (e Either[L, R]) Left() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// Right returns the right value and true, or the zero R and false if e holds a left value.
+func (e Either[L, R]) Right() (R, bool) {
+	return e.right, e.isRight
+}
+
+// OneOfFirst creates a OneOf3 holding the first value v.
+func OneOfFirst[A, B, C any](v A) OneOf3[A, B, C] {
+	return OneOf3[A, B, C]{first: v}
+}
+
+// OneOfSecond creates a OneOf3 holding the second value v.
+func OneOfSecond[A, B, C any](v B) OneOf3[A, B, C] {
+	return OneOf3[A, B, C]{second: v, index: 1}
+}
+
+// OneOfThird creates a OneOf3 holding the third value v.
+func OneOfThird[A, B, C any](v C) OneOf3[A, B, C] {
+	return OneOf3[A, B, C]{third: v, index: 2}
+}
+
+// Index returns which value o holds: 0 for the first, 1 for the second, 2 for the third.
+//
+// Example:
+//
+//	switch o.Index() {
+//	case 0:
+//	    a, _ := o.First()
+//	    handleA(a)
+//	case 1:
+//	    b, _ := o.Second()
+//	    handleB(b)
+//	default:
+//	    c, _ := o.Third()
+//	    handleC(c)
+//	}
+func (o OneOf3[A, B, C]) Index() int {
+	return o.index
+}
+
+// First returns the first value and true, or the zero A and false if o holds another value.
+func (o OneOf3[A, B, C]) First() (A, bool) {
+	return o.first, o.index == 0
+}
+
+// Second returns the second value and true, or the zero B and false if o holds another value.
+func (o OneOf3[A, B, C]) Second() (B, bool) {
+	return o.second, o.index == 1
+}
+
+// Third returns the third value and true, or the zero C and false if o holds another value.
+func (o OneOf3[A, B, C]) Third() (C, bool) {
+	return o.third, o.index == 2
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package types. tuple provides small generic tuples for carrying several values through a single type
+// parameter, such as the T of a Result produced by combining independent Results.
+package types
+
+// ------------------------------------- Types -------------------------------------
+
+// Pair [A, B] holds two values of possibly different types.
+//
+// Example:
+//
+//	p := types.Pair[string, int]{First: "ali", Second: 42}
+//	name, age := p.Unpack()
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple [A, B, C] holds three values of possibly different types.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Quad [A, B, C, D] holds four values of possibly different types.
+type Quad[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Quint [A, B, C, D, E] holds five values of possibly different types.
+type Quint[A, B, C, D, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+// ------------------------------------- Public Functions -------------------------------------
+
+// NewPair creates a Pair from a and b.
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+// Unpack returns the values of p, for destructuring assignment.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Unpack returns the values of t, for destructuring assignment.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// Unpack returns the values of q, for destructuring assignment.
+func (q Quad[A, B, C, D]) Unpack() (A, B, C, D) {
+	return q.First, q.Second, q.Third, q.Fourth
+}
+
+// Unpack returns the values of q, for destructuring assignment.
+func (q Quint[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return q.First, q.Second, q.Third, q.Fourth, q.Fifth
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package types. unit provides the Unit type, Go's equivalent of Rust's () for operations
+// that succeed without producing a value.
+package types
+
+// ------------------------------------- Types -------------------------------------
+
+// Unit is the type with a single value, used as T in Result[T] for operations that only succeed or fail.
+//
+// Example:
+//
+//	func Send(msg Message) result.Result[types.Unit] {
+//	    if err := queue.Publish(msg); err != nil {
+//	        return result.Err[types.Unit](err)
+//	    }
+//	    return result.Ok(types.Unit{})
+//	}
+type Unit struct{}
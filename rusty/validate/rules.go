@@ -0,0 +1,164 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package validate. rules provides the typed, chainable rule builders for strings, numbers and slices.
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// StringRules accumulates rules for a string value. Lengths are counted in runes.
+type StringRules struct {
+	value string
+	rules
+}
+
+// NumberRules [N] accumulates rules for a numeric value.
+type NumberRules[N number] struct {
+	value N
+	rules
+}
+
+// SliceRules [T] accumulates rules for a slice value.
+type SliceRules[T any] struct {
+	value []T
+	rules
+}
+
+// number is the set of types accepted by Number.
+type number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// rules collects the violations of a builder.
+type rules struct {
+	violations []Violation
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// String starts a rule chain for v.
+func String(v string) *StringRules {
+	return &StringRules{value: v}
+}
+
+// Number starts a rule chain for v.
+func Number[N number](v N) *NumberRules[N] {
+	return &NumberRules[N]{value: v}
+}
+
+// Slice starts a rule chain for v.
+func Slice[T any](v []T) *SliceRules[T] {
+	return &SliceRules[T]{value: v}
+}
+
+// Violations returns every violation recorded so far.
+func (r *rules) Violations() []Violation {
+	return slices.Clone(r.violations)
+}
+
+// -------------------------------------------- StringRules Methods --------------------------------------------
+
+// NonEmpty requires a non-empty string.
+func (s *StringRules) NonEmpty() *StringRules {
+	return s.check(s.value != "", "must not be empty")
+}
+
+// MinLen requires at least n characters.
+func (s *StringRules) MinLen(n int) *StringRules {
+	return s.check(utf8.RuneCountInString(s.value) >= n, fmt.Sprintf("must be at least %d characters", n))
+}
+
+// MaxLen requires at most n characters.
+func (s *StringRules) MaxLen(n int) *StringRules {
+	return s.check(utf8.RuneCountInString(s.value) <= n, fmt.Sprintf("must be at most %d characters", n))
+}
+
+// Len requires exactly n characters.
+func (s *StringRules) Len(n int) *StringRules {
+	return s.check(utf8.RuneCountInString(s.value) == n, fmt.Sprintf("must be exactly %d characters", n))
+}
+
+// Matches requires the string to match re.
+func (s *StringRules) Matches(re *regexp.Regexp) *StringRules {
+	return s.check(re.MatchString(s.value), "must match "+re.String())
+}
+
+// OneOf requires the string to equal one of allowed.
+func (s *StringRules) OneOf(allowed ...string) *StringRules {
+	return s.check(slices.Contains(allowed, s.value), fmt.Sprintf("must be one of %v", allowed))
+}
+
+// check records message when ok is false.
+func (s *StringRules) check(ok bool, message string) *StringRules {
+	s.add(ok, message)
+	return s
+}
+
+// -------------------------------------------- NumberRules Methods --------------------------------------------
+
+// Min requires a value of at least lo.
+func (n *NumberRules[N]) Min(lo N) *NumberRules[N] {
+	return n.check(n.value >= lo, fmt.Sprintf("must be at least %v", lo))
+}
+
+// Max requires a value of at most hi.
+func (n *NumberRules[N]) Max(hi N) *NumberRules[N] {
+	return n.check(n.value <= hi, fmt.Sprintf("must be at most %v", hi))
+}
+
+// Between requires lo <= value <= hi.
+func (n *NumberRules[N]) Between(lo, hi N) *NumberRules[N] {
+	return n.check(n.value >= lo && n.value <= hi, fmt.Sprintf("must be between %v and %v", lo, hi))
+}
+
+// Positive requires a value greater than zero.
+func (n *NumberRules[N]) Positive() *NumberRules[N] {
+	return n.check(n.value > 0, "must be positive")
+}
+
+// check records message when ok is false.
+func (n *NumberRules[N]) check(ok bool, message string) *NumberRules[N] {
+	n.add(ok, message)
+	return n
+}
+
+// -------------------------------------------- SliceRules Methods --------------------------------------------
+
+// NonEmpty requires at least one element.
+func (s *SliceRules[T]) NonEmpty() *SliceRules[T] {
+	return s.check(len(s.value) > 0, "must not be empty")
+}
+
+// MinLen requires at least n elements.
+func (s *SliceRules[T]) MinLen(n int) *SliceRules[T] {
+	return s.check(len(s.value) >= n, fmt.Sprintf("must contain at least %d items", n))
+}
+
+// MaxLen requires at most n elements.
+func (s *SliceRules[T]) MaxLen(n int) *SliceRules[T] {
+	return s.check(len(s.value) <= n, fmt.Sprintf("must contain at most %d items", n))
+}
+
+// check records message when ok is false.
+func (s *SliceRules[T]) check(ok bool, message string) *SliceRules[T] {
+	s.add(ok, message)
+	return s
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// add records a violation with no field path when ok is false.
+func (r *rules) add(ok bool, message string) {
+	if !ok {
+		r.violations = append(r.violations, Violation{Message: message})
+	}
+}
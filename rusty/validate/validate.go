@@ -0,0 +1,201 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package validate. validate provides composable, code-first validators whose violations are collected
+// (not short-circuited) with field paths and returned as a single Result error.
+//
+// Example - Traditional vs validate:
+//
+//	// Traditional Go: stops at the first problem, paths built by hand
+//	if req.Name == "" {
+//	    return fmt.Errorf("name: must not be empty")
+//	}
+//	if len(req.Name) > 50 {
+//	    return fmt.Errorf("name: must be at most 50 characters")
+//	}
+//
+//	// With validate: every violation reported at once
+//	res := validate.Check(req,
+//	    validate.Field("name", validate.String(req.Name).NonEmpty().MaxLen(50)),
+//	    validate.Field("age", validate.Number(req.Age).Min(18)),
+//	)
+package validate
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/result"
+)
+
+// -------------------------------------------- Types --------------------------------------------
+
+// Validator is anything that can report violations.
+type Validator interface {
+	Violations() []Violation
+}
+
+// ValidatorFunc adapts a function to the Validator interface.
+type ValidatorFunc func() []Violation
+
+// Violation is a single failed rule. Field is a dotted path ("address.city", "items[2].sku"),
+// empty for a value validated without a Field wrapper.
+type Violation struct {
+	Field   string
+	Message string
+}
+
+// Errors is the error returned by Check; it lists every violation in order.
+// It matches ErrInvalid with errors.Is.
+type Errors []Violation
+
+// -------------------------------------------- Constants --------------------------------------------
+
+// ErrInvalid matches every validation error produced by this package.
+var ErrInvalid = errors.New("validation failed")
+
+func init() {
+	goxerrors.Register(ErrInvalid, goxerrors.Meta{Code: "validation_failed", HTTPStatus: 422})
+}
+
+// -------------------------------------------- Public Functions --------------------------------------------
+
+// Violations calls fn.
+func (fn ValidatorFunc) Violations() []Violation {
+	return fn()
+}
+
+// Check runs validators and returns Ok(value) if none reports a violation, otherwise Err(Errors).
+//
+// When to use:
+//   - At API boundaries, to reject a request with every problem listed
+//   - Inside BubbleUp flows: validate.Check(req, ...).BubbleUp()
+//
+// Example:
+//
+//	func CreateUser(req CreateUserRequest) (res result.Result[User]) {
+//	    defer result.Catch(&res)
+//
+//	    req = validate.Check(req,
+//	        validate.Field("email", validate.String(req.Email).NonEmpty().Matches(emailRe)),
+//	        validate.Field("roles", validate.Slice(req.Roles).NonEmpty()),
+//	    ).BubbleUp()
+//	    return repo.Insert(req)
+//	}
+func Check[T any](value T, validators ...Validator) result.Result[T] {
+	if violations := All(validators...).Violations(); len(violations) > 0 {
+		return result.Err[T](Errors(violations))
+	}
+	return result.Ok(value)
+}
+
+// All combines validators into one that reports the violations of each, in order.
+func All(validators ...Validator) Validator {
+	return ValidatorFunc(func() []Violation {
+		var violations []Violation
+		for _, v := range validators {
+			violations = append(violations, v.Violations()...)
+		}
+		return violations
+	})
+}
+
+// Field prefixes the paths of every violation reported by validators with path.
+//
+// Example - Nested structs:
+//
+//	validate.Field("address", validate.All(
+//	    validate.Field("city", validate.String(addr.City).NonEmpty()), // reported as "address.city"
+//	    validate.Field("zip", validate.String(addr.Zip).Len(5)),       // reported as "address.zip"
+//	))
+func Field(path string, validators ...Validator) Validator {
+	return ValidatorFunc(func() []Violation {
+		violations := All(validators...).Violations()
+		for i := range violations {
+			violations[i].Field = joinPath(path, violations[i].Field)
+		}
+		return violations
+	})
+}
+
+// Each validates every element of items with fn, prefixing paths with the element index.
+//
+// Example:
+//
+//	validate.Field("items", validate.Each(order.Items, func(item Item) validate.Validator {
+//	    return validate.Field("qty", validate.Number(item.Qty).Min(1)) // "items[3].qty"
+//	}))
+func Each[T any](items []T, fn func(T) Validator) Validator {
+	return ValidatorFunc(func() []Violation {
+		var violations []Violation
+		for i, item := range items {
+			violations = append(violations, Field("["+strconv.Itoa(i)+"]", fn(item)).Violations()...)
+		}
+		return violations
+	})
+}
+
+// Custom reports message when ok is false, for rules not covered by the typed builders.
+//
+// Example:
+//
+//	validate.Field("end", validate.Custom(req.End.After(req.Start), "must be after start"))
+func Custom(ok bool, message string) Validator {
+	return ValidatorFunc(func() []Violation {
+		if ok {
+			return nil
+		}
+		return []Violation{{Message: message}}
+	})
+}
+
+// ViolationsOf returns the violations carried by err, or nil if err is not a validation error.
+func ViolationsOf(err error) []Violation {
+	var verrs Errors
+	if errors.As(err, &verrs) {
+		return verrs
+	}
+	return nil
+}
+
+// -------------------------------------------- Errors Methods --------------------------------------------
+
+// Error lists every violation as "field: message", separated by semicolons.
+func (e Errors) Error() string {
+	parts := make([]string, len(e))
+	for i, v := range e {
+		parts[i] = v.String()
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Is reports whether target is ErrInvalid.
+func (e Errors) Is(target error) bool {
+	return target == ErrInvalid
+}
+
+// String formats the violation as "field: message", or just the message when Field is empty.
+func (v Violation) String() string {
+	if v.Field == "" {
+		return v.Message
+	}
+	return v.Field + ": " + v.Message
+}
+
+// -------------------------------------------- Private Helper Functions --------------------------------------------
+
+// joinPath joins a parent path and a child path, keeping index segments attached ("items" + "[0]").
+func joinPath(parent, child string) string {
+	switch {
+	case child == "":
+		return parent
+	case parent == "":
+		return child
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	default:
+		return parent + "." + child
+	}
+}
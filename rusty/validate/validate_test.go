@@ -0,0 +1,82 @@
+// Copyright (c) 2025 SeyedAli
+// Licensed under the MIT License. See LICENSE file in the project root for details.
+
+// Package validate_test. validate_test verifies rule builders, path composition and error aggregation.
+package validate_test
+
+import (
+	"errors"
+	"testing"
+
+	goxerrors "github.com/seyedali-dev/goxide/rusty/errors"
+	"github.com/seyedali-dev/goxide/rusty/validate"
+)
+
+// -------------------------------------------- Test Data --------------------------------------------
+
+type Item struct {
+	SKU string
+	Qty int
+}
+
+type Order struct {
+	Customer string
+	Items    []Item
+}
+
+func validateOrder(o Order) validate.Validator {
+	return validate.All(
+		validate.Field("customer", validate.String(o.Customer).NonEmpty().MaxLen(10)),
+		validate.Field("items", validate.Slice(o.Items).NonEmpty(), validate.Each(o.Items, func(it Item) validate.Validator {
+			return validate.All(
+				validate.Field("sku", validate.String(it.SKU).Len(4)),
+				validate.Field("qty", validate.Number(it.Qty).Between(1, 99)),
+			)
+		})),
+	)
+}
+
+// -------------------------------------------- Tests --------------------------------------------
+
+func TestCheck_Valid(t *testing.T) {
+	order := Order{Customer: "ali", Items: []Item{{SKU: "A-01", Qty: 2}}}
+	res := validate.Check(order, validateOrder(order))
+	if res.IsErr() {
+		t.Fatalf("expected Ok, got %v", res.Err())
+	}
+}
+
+func TestCheck_CollectsEveryViolationWithPaths(t *testing.T) {
+	order := Order{Customer: "", Items: []Item{{SKU: "A-01", Qty: 1}, {SKU: "B", Qty: 0}}}
+	res := validate.Check(order, validateOrder(order))
+
+	if !errors.Is(res.Err(), validate.ErrInvalid) {
+		t.Fatalf("expected ErrInvalid, got %v", res.Err())
+	}
+
+	want := []string{
+		"customer: must not be empty",
+		"items[1].sku: must be exactly 4 characters",
+		"items[1].qty: must be between 1 and 99",
+	}
+	got := validate.ViolationsOf(res.Err())
+	if len(got) != len(want) {
+		t.Fatalf("expected %d violations, got %v", len(want), got)
+	}
+	for i, v := range got {
+		if v.String() != want[i] {
+			t.Errorf("violation %d: expected %q, got %q", i, want[i], v.String())
+		}
+	}
+}
+
+func TestCheck_CustomAndRegistryIntegration(t *testing.T) {
+	res := validate.Check(5, validate.Field("end", validate.Custom(false, "must be after start")))
+
+	if res.Err().Error() != "end: must be after start" {
+		t.Fatalf("unexpected message: %v", res.Err())
+	}
+	if status := goxerrors.HTTPStatusOf(res.Err()); status != 422 {
+		t.Fatalf("expected %v, got %v", 422, status)
+	}
+}